@@ -282,6 +282,49 @@ func (e *ArrayExpression) MarshalJSON() ([]byte, error) {
 	})
 }
 
+// TupleExpression
+
+type TupleExpression struct {
+	Elements []Expression
+	Range
+}
+
+func (*TupleExpression) isExpression() {}
+
+func (*TupleExpression) isIfStatementTest() {}
+
+func (e *TupleExpression) Accept(visitor Visitor) Repr {
+	return e.AcceptExp(visitor)
+}
+
+func (e *TupleExpression) AcceptExp(visitor ExpressionVisitor) Repr {
+	return visitor.VisitTupleExpression(e)
+}
+
+func (e *TupleExpression) String() string {
+	var builder strings.Builder
+	builder.WriteString("(")
+	for i, element := range e.Elements {
+		if i > 0 {
+			builder.WriteString(", ")
+		}
+		builder.WriteString(element.String())
+	}
+	builder.WriteString(")")
+	return builder.String()
+}
+
+func (e *TupleExpression) MarshalJSON() ([]byte, error) {
+	type Alias TupleExpression
+	return json.Marshal(&struct {
+		Type string
+		*Alias
+	}{
+		Type:  "TupleExpression",
+		Alias: (*Alias)(e),
+	})
+}
+
 // DictionaryExpression
 
 type DictionaryExpression struct {
@@ -625,6 +668,80 @@ func (e *ConditionalExpression) MarshalJSON() ([]byte, error) {
 	})
 }
 
+// SwitchExpression
+
+type SwitchExpression struct {
+	Expression Expression
+	Cases      []*SwitchExpressionCase
+	Range
+}
+
+func (*SwitchExpression) isExpression() {}
+
+func (*SwitchExpression) isIfStatementTest() {}
+
+func (e *SwitchExpression) Accept(visitor Visitor) Repr {
+	return e.AcceptExp(visitor)
+}
+
+func (e *SwitchExpression) AcceptExp(visitor ExpressionVisitor) Repr {
+	return visitor.VisitSwitchExpression(e)
+}
+
+func (e *SwitchExpression) String() string {
+	var builder strings.Builder
+	builder.WriteString("switch ")
+	builder.WriteString(e.Expression.String())
+	builder.WriteString(" { ")
+	for i, switchCase := range e.Cases {
+		if i > 0 {
+			builder.WriteString("; ")
+		}
+		builder.WriteString(switchCase.String())
+	}
+	builder.WriteString(" }")
+	return builder.String()
+}
+
+func (e *SwitchExpression) MarshalJSON() ([]byte, error) {
+	type Alias SwitchExpression
+	return json.Marshal(&struct {
+		Type string
+		*Alias
+	}{
+		Type:  "SwitchExpression",
+		Alias: (*Alias)(e),
+	})
+}
+
+// SwitchExpressionCase
+//
+// The expression of a default case is nil.
+//
+type SwitchExpressionCase struct {
+	Expression Expression
+	Result     Expression
+	Range
+}
+
+func (c *SwitchExpressionCase) String() string {
+	if c.Expression == nil {
+		return fmt.Sprintf("default: %s", c.Result)
+	}
+	return fmt.Sprintf("case %s: %s", c.Expression, c.Result)
+}
+
+func (c *SwitchExpressionCase) MarshalJSON() ([]byte, error) {
+	type Alias SwitchExpressionCase
+	return json.Marshal(&struct {
+		Type string
+		*Alias
+	}{
+		Type:  "SwitchExpressionCase",
+		Alias: (*Alias)(c),
+	})
+}
+
 // UnaryExpression
 
 type UnaryExpression struct {
@@ -83,6 +83,32 @@ func ExpressionAsType(expression Expression) Type {
 			},
 		}
 
+	case *TupleExpression:
+		elementTypeAnnotations := make([]*TypeAnnotation, 0, len(expression.Elements))
+
+		for _, element := range expression.Elements {
+			elementType := ExpressionAsType(element)
+			if elementType == nil {
+				return nil
+			}
+
+			elementTypeAnnotations = append(
+				elementTypeAnnotations,
+				&TypeAnnotation{
+					Type:     elementType,
+					StartPos: elementType.StartPosition(),
+				},
+			)
+		}
+
+		return &TupleType{
+			ElementTypeAnnotations: elementTypeAnnotations,
+			Range: Range{
+				StartPos: expression.StartPos,
+				EndPos:   expression.EndPos,
+			},
+		}
+
 	default:
 		return nil
 	}
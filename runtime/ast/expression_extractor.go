@@ -48,6 +48,10 @@ type ArrayExtractor interface {
 	ExtractArray(extractor *ExpressionExtractor, expression *ArrayExpression) ExpressionExtraction
 }
 
+type TupleExtractor interface {
+	ExtractTuple(extractor *ExpressionExtractor, expression *TupleExpression) ExpressionExtraction
+}
+
 type DictionaryExtractor interface {
 	ExtractDictionary(extractor *ExpressionExtractor, expression *DictionaryExpression) ExpressionExtraction
 }
@@ -72,6 +76,10 @@ type ConditionalExtractor interface {
 	ExtractConditional(extractor *ExpressionExtractor, expression *ConditionalExpression) ExpressionExtraction
 }
 
+type SwitchExtractor interface {
+	ExtractSwitch(extractor *ExpressionExtractor, expression *SwitchExpression) ExpressionExtraction
+}
+
 type UnaryExtractor interface {
 	ExtractUnary(extractor *ExpressionExtractor, expression *UnaryExpression) ExpressionExtraction
 }
@@ -116,12 +124,14 @@ type ExpressionExtractor struct {
 	FixedPointExtractor  FixedPointExtractor
 	StringExtractor      StringExtractor
 	ArrayExtractor       ArrayExtractor
+	TupleExtractor       TupleExtractor
 	DictionaryExtractor  DictionaryExtractor
 	IdentifierExtractor  IdentifierExtractor
 	InvocationExtractor  InvocationExtractor
 	MemberExtractor      MemberExtractor
 	IndexExtractor       IndexExtractor
 	ConditionalExtractor ConditionalExtractor
+	SwitchExtractor      SwitchExtractor
 	UnaryExtractor       UnaryExtractor
 	BinaryExtractor      BinaryExtractor
 	FunctionExtractor    FunctionExtractor
@@ -297,6 +307,35 @@ func (extractor *ExpressionExtractor) ExtractArray(expression *ArrayExpression)
 	}
 }
 
+func (extractor *ExpressionExtractor) VisitTupleExpression(expression *TupleExpression) Repr {
+
+	// delegate to child extractor, if any,
+	// or call default implementation
+
+	if extractor.TupleExtractor != nil {
+		return extractor.TupleExtractor.ExtractTuple(extractor, expression)
+	}
+	return extractor.ExtractTuple(expression)
+}
+
+func (extractor *ExpressionExtractor) ExtractTuple(expression *TupleExpression) ExpressionExtraction {
+
+	// copy the expression
+	newExpression := *expression
+
+	// rewrite all element expressions
+
+	rewrittenExpressions, extractedExpressions :=
+		extractor.VisitExpressions(expression.Elements)
+
+	newExpression.Elements = rewrittenExpressions
+
+	return ExpressionExtraction{
+		RewrittenExpression:  &newExpression,
+		ExtractedExpressions: extractedExpressions,
+	}
+}
+
 func (extractor *ExpressionExtractor) VisitExpressions(
 	expressions []Expression,
 ) (
@@ -545,6 +584,65 @@ func (extractor *ExpressionExtractor) ExtractConditional(expression *Conditional
 	}
 }
 
+func (extractor *ExpressionExtractor) VisitSwitchExpression(expression *SwitchExpression) Repr {
+
+	// delegate to child extractor, if any,
+	// or call default implementation
+
+	if extractor.SwitchExtractor != nil {
+		return extractor.SwitchExtractor.ExtractSwitch(extractor, expression)
+	}
+	return extractor.ExtractSwitch(expression)
+}
+
+func (extractor *ExpressionExtractor) ExtractSwitch(expression *SwitchExpression) ExpressionExtraction {
+
+	// copy the expression
+	newExpression := *expression
+
+	// rewrite the subject expression
+
+	subjectResult := extractor.Extract(expression.Expression)
+	newExpression.Expression = subjectResult.RewrittenExpression
+
+	extractedExpressions := subjectResult.ExtractedExpressions
+
+	// rewrite the case expressions and results
+
+	newCases := make([]*SwitchExpressionCase, len(expression.Cases))
+
+	for i, switchCase := range expression.Cases {
+
+		// copy the case
+		newCase := *switchCase
+
+		if switchCase.Expression != nil {
+			caseResult := extractor.Extract(switchCase.Expression)
+			newCase.Expression = caseResult.RewrittenExpression
+			extractedExpressions = append(
+				extractedExpressions,
+				caseResult.ExtractedExpressions...,
+			)
+		}
+
+		resultResult := extractor.Extract(switchCase.Result)
+		newCase.Result = resultResult.RewrittenExpression
+		extractedExpressions = append(
+			extractedExpressions,
+			resultResult.ExtractedExpressions...,
+		)
+
+		newCases[i] = &newCase
+	}
+
+	newExpression.Cases = newCases
+
+	return ExpressionExtraction{
+		RewrittenExpression:  &newExpression,
+		ExtractedExpressions: extractedExpressions,
+	}
+}
+
 func (extractor *ExpressionExtractor) VisitUnaryExpression(expression *UnaryExpression) Repr {
 
 	// delegate to child extractor, if any,
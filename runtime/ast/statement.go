@@ -54,6 +54,7 @@ func (s *ReturnStatement) MarshalJSON() ([]byte, error) {
 // BreakStatement
 
 type BreakStatement struct {
+	Label *Identifier `json:",omitempty"`
 	Range
 }
 
@@ -77,6 +78,7 @@ func (s *BreakStatement) MarshalJSON() ([]byte, error) {
 // ContinueStatement
 
 type ContinueStatement struct {
+	Label *Identifier `json:",omitempty"`
 	Range
 }
 
@@ -145,6 +147,7 @@ func (s *IfStatement) MarshalJSON() ([]byte, error) {
 // WhileStatement
 
 type WhileStatement struct {
+	Label    *Identifier `json:",omitempty"`
 	Test     Expression
 	Block    *Block
 	StartPos Position `json:"-"`
@@ -177,13 +180,56 @@ func (s *WhileStatement) MarshalJSON() ([]byte, error) {
 	})
 }
 
-// ForStatement
+// RepeatWhileStatement
+
+type RepeatWhileStatement struct {
+	Label    *Identifier `json:",omitempty"`
+	Block    *Block
+	Test     Expression
+	StartPos Position `json:"-"`
+}
+
+func (*RepeatWhileStatement) isStatement() {}
 
+func (s *RepeatWhileStatement) Accept(visitor Visitor) Repr {
+	return visitor.VisitRepeatWhileStatement(s)
+}
+
+func (s *RepeatWhileStatement) StartPosition() Position {
+	return s.StartPos
+}
+
+func (s *RepeatWhileStatement) EndPosition() Position {
+	return s.Test.EndPosition()
+}
+
+func (s *RepeatWhileStatement) MarshalJSON() ([]byte, error) {
+	type Alias RepeatWhileStatement
+	return json.Marshal(&struct {
+		Type string
+		Range
+		*Alias
+	}{
+		Type:  "RepeatWhileStatement",
+		Range: NewRangeFromPositioned(s),
+		Alias: (*Alias)(s),
+	})
+}
+
+// ForStatement
+//
+// If the statement has a value identifier, e.g. `for key, value in dictionary`
+// or `for index, element in array`, then the identifier is bound to the key or index,
+// and the value identifier to the value or element.
+// Otherwise, the identifier is bound to the element, e.g. `for element in array`.
+//
 type ForStatement struct {
-	Identifier Identifier
-	Value      Expression
-	Block      *Block
-	StartPos   Position `json:"-"`
+	Label           *Identifier `json:",omitempty"`
+	Identifier      Identifier
+	ValueIdentifier *Identifier `json:",omitempty"`
+	Value           Expression
+	Block           *Block
+	StartPos        Position `json:"-"`
 }
 
 func (*ForStatement) isStatement() {}
@@ -281,6 +281,43 @@ func (t *FunctionType) CheckEqual(other Type, checker TypeEqualityChecker) error
 	return checker.CheckFunctionTypeEquality(t, other)
 }
 
+// TupleType
+
+type TupleType struct {
+	ElementTypeAnnotations []*TypeAnnotation
+	Range
+}
+
+func (*TupleType) isType() {}
+
+func (t *TupleType) String() string {
+	var builder strings.Builder
+	builder.WriteRune('(')
+	for i, elementTypeAnnotation := range t.ElementTypeAnnotations {
+		if i > 0 {
+			builder.WriteString(", ")
+		}
+		builder.WriteString(elementTypeAnnotation.String())
+	}
+	builder.WriteRune(')')
+	return builder.String()
+}
+
+func (t *TupleType) MarshalJSON() ([]byte, error) {
+	type Alias TupleType
+	return json.Marshal(&struct {
+		Type string
+		*Alias
+	}{
+		Type:  "TupleType",
+		Alias: (*Alias)(t),
+	})
+}
+
+func (t *TupleType) CheckEqual(other Type, checker TypeEqualityChecker) error {
+	return checker.CheckTupleTypeEquality(t, other)
+}
+
 // ReferenceType
 
 type ReferenceType struct {
@@ -424,6 +461,7 @@ type TypeEqualityChecker interface {
 	CheckConstantSizedTypeEquality(*ConstantSizedType, Type) error
 	CheckDictionaryTypeEquality(*DictionaryType, Type) error
 	CheckFunctionTypeEquality(*FunctionType, Type) error
+	CheckTupleTypeEquality(*TupleType, Type) error
 	CheckReferenceTypeEquality(*ReferenceType, Type) error
 	CheckRestrictedTypeEquality(*RestrictedType, Type) error
 	CheckInstantiationTypeEquality(*InstantiationType, Type) error
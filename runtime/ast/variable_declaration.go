@@ -90,3 +90,49 @@ func (d *VariableDeclaration) MarshalJSON() ([]byte, error) {
 		Alias: (*Alias)(d),
 	})
 }
+
+// TupleVariableDeclaration declares a variable for each element of a tuple,
+// e.g. `let (a, b) = f()`
+
+type TupleVariableDeclaration struct {
+	IsConstant     bool
+	Identifiers    []Identifier
+	TypeAnnotation *TypeAnnotation
+	Value          Expression
+	Transfer       *Transfer
+	StartPos       Position `json:"-"`
+}
+
+func (d *TupleVariableDeclaration) StartPosition() Position {
+	return d.StartPos
+}
+
+func (d *TupleVariableDeclaration) EndPosition() Position {
+	return d.Value.EndPosition()
+}
+
+func (*TupleVariableDeclaration) isStatement() {}
+
+func (d *TupleVariableDeclaration) Accept(visitor Visitor) Repr {
+	return visitor.VisitTupleVariableDeclaration(d)
+}
+
+func (d *TupleVariableDeclaration) DeclarationKind() common.DeclarationKind {
+	if d.IsConstant {
+		return common.DeclarationKindConstant
+	}
+	return common.DeclarationKindVariable
+}
+
+func (d *TupleVariableDeclaration) MarshalJSON() ([]byte, error) {
+	type Alias TupleVariableDeclaration
+	return json.Marshal(&struct {
+		Type string
+		Range
+		*Alias
+	}{
+		Type:  "TupleVariableDeclaration",
+		Range: NewRangeFromPositioned(d),
+		Alias: (*Alias)(d),
+	})
+}
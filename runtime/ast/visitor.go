@@ -47,9 +47,11 @@ type StatementVisitor interface {
 	VisitIfStatement(*IfStatement) Repr
 	VisitSwitchStatement(*SwitchStatement) Repr
 	VisitWhileStatement(*WhileStatement) Repr
+	VisitRepeatWhileStatement(*RepeatWhileStatement) Repr
 	VisitForStatement(*ForStatement) Repr
 	VisitEmitStatement(*EmitStatement) Repr
 	VisitVariableDeclaration(*VariableDeclaration) Repr
+	VisitTupleVariableDeclaration(*TupleVariableDeclaration) Repr
 	VisitAssignmentStatement(*AssignmentStatement) Repr
 	VisitSwapStatement(*SwapStatement) Repr
 	VisitExpressionStatement(*ExpressionStatement) Repr
@@ -61,12 +63,14 @@ type ExpressionVisitor interface {
 	VisitIntegerExpression(*IntegerExpression) Repr
 	VisitFixedPointExpression(*FixedPointExpression) Repr
 	VisitArrayExpression(*ArrayExpression) Repr
+	VisitTupleExpression(*TupleExpression) Repr
 	VisitDictionaryExpression(*DictionaryExpression) Repr
 	VisitIdentifierExpression(*IdentifierExpression) Repr
 	VisitInvocationExpression(*InvocationExpression) Repr
 	VisitMemberExpression(*MemberExpression) Repr
 	VisitIndexExpression(*IndexExpression) Repr
 	VisitConditionalExpression(*ConditionalExpression) Repr
+	VisitSwitchExpression(*SwitchExpression) Repr
 	VisitUnaryExpression(*UnaryExpression) Repr
 	VisitBinaryExpression(*BinaryExpression) Repr
 	VisitFunctionExpression(*FunctionExpression) Repr
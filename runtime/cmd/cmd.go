@@ -21,6 +21,7 @@ package cmd
 import (
 	"fmt"
 	"io/ioutil"
+	"math/rand"
 	"os"
 
 	"github.com/onflow/cadence/runtime/ast"
@@ -148,6 +149,7 @@ func PrepareInterpreter(filename string) (*interpreter.Interpreter, *sema.Checke
 			defer func() { uuid++ }()
 			return uuid, nil
 		}),
+		interpreter.WithRandomSource(rand.Uint64),
 	)
 	must(err)
 
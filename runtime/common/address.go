@@ -69,6 +69,13 @@ func (a Address) Bytes() []byte {
 	return a[leadingZeros:]
 }
 
+// HexWithPrefix returns the canonical hex string representation of the address,
+// prefixed with `0x` and zero-padded to the full address length.
+//
+func (a Address) HexWithPrefix() string {
+	return fmt.Sprintf("0x%x", [AddressLength]byte(a))
+}
+
 func (a Address) ShortHexWithPrefix() string {
 	hexString := fmt.Sprintf("%x", [AddressLength]byte(a))
 	return fmt.Sprintf("0x%s", strings.TrimLeft(hexString, "0"))
@@ -83,6 +83,11 @@ func (compiler *Compiler) VisitWhileStatement(_ *ast.WhileStatement) ast.Repr {
 	panic(errors.NewUnreachableError())
 }
 
+func (compiler *Compiler) VisitRepeatWhileStatement(_ *ast.RepeatWhileStatement) ast.Repr {
+	// TODO
+	panic(errors.NewUnreachableError())
+}
+
 func (compiler *Compiler) VisitForStatement(_ *ast.ForStatement) ast.Repr {
 	// TODO
 	panic(errors.NewUnreachableError())
@@ -116,6 +121,11 @@ func (compiler *Compiler) VisitVariableDeclaration(declaration *ast.VariableDecl
 	}
 }
 
+func (compiler *Compiler) VisitTupleVariableDeclaration(_ *ast.TupleVariableDeclaration) ast.Repr {
+	// TODO
+	panic(errors.NewUnreachableError())
+}
+
 func (compiler *Compiler) VisitAssignmentStatement(_ *ast.AssignmentStatement) ast.Repr {
 	// TODO
 	panic(errors.NewUnreachableError())
@@ -171,6 +181,11 @@ func (compiler *Compiler) VisitArrayExpression(_ *ast.ArrayExpression) ast.Repr
 	panic(errors.NewUnreachableError())
 }
 
+func (compiler *Compiler) VisitTupleExpression(_ *ast.TupleExpression) ast.Repr {
+	// TODO
+	panic(errors.NewUnreachableError())
+}
+
 func (compiler *Compiler) VisitDictionaryExpression(_ *ast.DictionaryExpression) ast.Repr {
 	// TODO
 	panic(errors.NewUnreachableError())
@@ -205,6 +220,11 @@ func (compiler *Compiler) VisitConditionalExpression(_ *ast.ConditionalExpressio
 	panic(errors.NewUnreachableError())
 }
 
+func (compiler *Compiler) VisitSwitchExpression(_ *ast.SwitchExpression) ast.Repr {
+	// TODO
+	panic(errors.NewUnreachableError())
+}
+
 func (compiler *Compiler) VisitUnaryExpression(_ *ast.UnaryExpression) ast.Repr {
 	// TODO
 	panic(errors.NewUnreachableError())
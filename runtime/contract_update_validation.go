@@ -357,6 +357,23 @@ func (validator *ContractUpdateValidator) CheckFunctionTypeEquality(expected *as
 	return expected.ReturnTypeAnnotation.Type.CheckEqual(foundFuncType.ReturnTypeAnnotation.Type, validator)
 }
 
+func (validator *ContractUpdateValidator) CheckTupleTypeEquality(expected *ast.TupleType, found ast.Type) error {
+	foundTupleType, ok := found.(*ast.TupleType)
+	if !ok || len(expected.ElementTypeAnnotations) != len(foundTupleType.ElementTypeAnnotations) {
+		return getTypeMismatchError(expected, found)
+	}
+
+	for index, expectedElementType := range expected.ElementTypeAnnotations {
+		foundElementType := foundTupleType.ElementTypeAnnotations[index]
+		err := expectedElementType.Type.CheckEqual(foundElementType.Type, validator)
+		if err != nil {
+			return getTypeMismatchError(expected, found)
+		}
+	}
+
+	return nil
+}
+
 func (validator *ContractUpdateValidator) CheckReferenceTypeEquality(expected *ast.ReferenceType, found ast.Type) error {
 	refType, ok := found.(*ast.ReferenceType)
 	if !ok {
@@ -187,3 +187,113 @@ func TestRuntimeCrypto_hash(t *testing.T) {
 
 	assert.True(t, called)
 }
+
+func TestRuntimeRecoverECDSAPublicKey(t *testing.T) {
+
+	t.Parallel()
+
+	runtime := NewInterpreterRuntime()
+
+	script := []byte(`
+      pub fun main() {
+          let valid = recoverECDSAPublicKey(
+              signature: "01020304".decodeHex(),
+              message: "0506".decodeHex(),
+              hashAlgorithm: HashAlgorithm.SHA3_256,
+              signatureAlgorithm: SignatureAlgorithm.ECDSA_Secp256k1
+          )
+          log(valid!.publicKey)
+          log(valid!.signatureAlgorithm.rawValue)
+
+          let invalid = recoverECDSAPublicKey(
+              signature: "ff".decodeHex(),
+              message: "0506".decodeHex(),
+              hashAlgorithm: HashAlgorithm.SHA3_256,
+              signatureAlgorithm: SignatureAlgorithm.ECDSA_Secp256k1
+          )
+          log(invalid == nil)
+      }
+    `)
+
+	var loggedMessages []string
+
+	runtimeInterface := &testRuntimeInterface{
+		recoverPublicKey: func(
+			signature []byte,
+			message []byte,
+			signatureAlgorithm SignatureAlgorithm,
+			hashAlgorithm HashAlgorithm,
+		) ([]byte, error) {
+			assert.Equal(t, []byte{5, 6}, message)
+			assert.Equal(t, SignatureAlgorithmECDSA_Secp256k1, signatureAlgorithm)
+			assert.Equal(t, HashAlgorithmSHA3_256, hashAlgorithm)
+
+			if len(signature) != 4 {
+				return nil, nil
+			}
+			return []byte{7, 8}, nil
+		},
+		log: func(message string) {
+			loggedMessages = append(loggedMessages, message)
+		},
+	}
+
+	_, err := runtime.ExecuteScript(
+		Script{
+			Source: script,
+		},
+		Context{
+			Interface: runtimeInterface,
+			Location:  utils.TestLocation,
+		},
+	)
+	require.NoError(t, err)
+
+	assert.Equal(t,
+		[]string{
+			"[7, 8]",
+			"2",
+			"true",
+		},
+		loggedMessages,
+	)
+}
+
+func TestRuntimeRecoverECDSAPublicKeyUnsupported(t *testing.T) {
+
+	t.Parallel()
+
+	runtime := NewInterpreterRuntime()
+
+	script := []byte(`
+      pub fun main() {
+          recoverECDSAPublicKey(
+              signature: "01020304".decodeHex(),
+              message: "0506".decodeHex(),
+              hashAlgorithm: HashAlgorithm.SHA3_256,
+              signatureAlgorithm: SignatureAlgorithm.ECDSA_Secp256k1
+          )
+      }
+    `)
+
+	// Only promote the methods of Interface,
+	// so the runtime interface does not implement PublicKeyRecoverer
+
+	runtimeInterface := struct {
+		Interface
+	}{
+		Interface: &testRuntimeInterface{},
+	}
+
+	_, err := runtime.ExecuteScript(
+		Script{
+			Source: script,
+		},
+		Context{
+			Interface: runtimeInterface,
+			Location:  utils.TestLocation,
+		},
+	)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "public key recovery is not supported")
+}
@@ -123,6 +123,23 @@ type HighLevelStorage interface {
 	SetCadenceValue(owner Address, key string, value cadence.Value) (err error)
 }
 
+// PublicKeyRecoverer is an optional interface which may be implemented by a runtime interface
+// to support the recovery of public keys from signatures
+//
+type PublicKeyRecoverer interface {
+	Interface
+
+	// RecoverPublicKey returns the public key which produced the given signature
+	// by signing the given message using the given signature algorithm and hash algorithm,
+	// or nil if no public key can be recovered from the signature.
+	RecoverPublicKey(
+		signature []byte,
+		message []byte,
+		signatureAlgorithm SignatureAlgorithm,
+		hashAlgorithm HashAlgorithm,
+	) ([]byte, error)
+}
+
 type Metrics interface {
 	ProgramParsed(location common.Location, duration time.Duration)
 	ProgramChecked(location common.Location, duration time.Duration)
@@ -22,6 +22,7 @@ import (
 	"math/big"
 
 	"github.com/onflow/cadence/runtime/errors"
+	"github.com/onflow/cadence/runtime/sema"
 )
 
 func SignedBigIntToBigEndianBytes(bigInt *big.Int) []byte {
@@ -73,3 +74,213 @@ func UnsignedBigIntToBigEndianBytes(bigInt *big.Int) []byte {
 		panic(errors.NewUnreachableError())
 	}
 }
+
+// SignedBigIntToSizedBigEndianBytes returns the two's complement big-endian representation
+// of the given integer, sign-extended to the given number of bytes
+//
+func SignedBigIntToSizedBigEndianBytes(bigInt *big.Int, sizeInBytes int) []byte {
+	bytes := SignedBigIntToBigEndianBytes(bigInt)
+
+	var padding byte
+	if bigInt.Sign() < 0 {
+		padding = 0xff
+	}
+
+	return padBigEndianBytes(bytes, sizeInBytes, padding)
+}
+
+// UnsignedBigIntToSizedBigEndianBytes returns the big-endian representation
+// of the given non-negative integer, zero-extended to the given number of bytes
+//
+func UnsignedBigIntToSizedBigEndianBytes(bigInt *big.Int, sizeInBytes int) []byte {
+	bytes := UnsignedBigIntToBigEndianBytes(bigInt)
+	return padBigEndianBytes(bytes, sizeInBytes, 0)
+}
+
+func padBigEndianBytes(bytes []byte, sizeInBytes int, padding byte) []byte {
+	length := len(bytes)
+	if length > sizeInBytes {
+		panic(errors.NewUnreachableError())
+	}
+
+	result := make([]byte, sizeInBytes)
+	for i := 0; i < sizeInBytes-length; i++ {
+		result[i] = padding
+	}
+	copy(result[sizeInBytes-length:], bytes)
+
+	return result
+}
+
+// BigEndianBytesToSignedBigInt returns the integer with the given
+// two's complement big-endian representation
+//
+func BigEndianBytesToSignedBigInt(bytes []byte) *big.Int {
+	result := new(big.Int).SetBytes(bytes)
+
+	// If the sign bit is set, the number is negative:
+	// subtract 2^(8 * length) to get the two's complement value
+
+	if len(bytes) > 0 && bytes[0]&0x80 != 0 {
+		offset := new(big.Int).Lsh(big.NewInt(1), uint(len(bytes)*8))
+		result.Sub(result, offset)
+	}
+
+	return result
+}
+
+// BigEndianBytesToUnsignedBigInt returns the non-negative integer
+// with the given big-endian representation
+//
+func BigEndianBytesToUnsignedBigInt(bytes []byte) *big.Int {
+	return new(big.Int).SetBytes(bytes)
+}
+
+func reverseBytes(bytes []byte) []byte {
+	length := len(bytes)
+	result := make([]byte, length)
+	for i, b := range bytes {
+		result[length-1-i] = b
+	}
+	return result
+}
+
+// IntegerValueToBigInt returns the arbitrary-precision representation of the given integer value
+//
+func IntegerValueToBigInt(value NumberValue) *big.Int {
+	switch value := value.(type) {
+	case BigNumberValue:
+		return value.ToBigInt()
+
+	case UInt64Value:
+		return new(big.Int).SetUint64(uint64(value))
+
+	case Word64Value:
+		return new(big.Int).SetUint64(uint64(value))
+
+	default:
+		return big.NewInt(int64(value.ToInt()))
+	}
+}
+
+// saturateBigInt clamps the given integer to the range of the given type
+//
+func saturateBigInt(value *big.Int, rangedType sema.IntegerRangedType) *big.Int {
+	if value.Cmp(rangedType.MaxInt()) > 0 {
+		return new(big.Int).Set(rangedType.MaxInt())
+	} else if value.Cmp(rangedType.MinInt()) < 0 {
+		return new(big.Int).Set(rangedType.MinInt())
+	}
+	return value
+}
+
+// getSaturatingArithmeticMember returns the saturating arithmetic function with the given name
+// for the given fixed-width integer value, or nil if there is no such function.
+//
+// The result of the operation is clamped to the range of the given type,
+// and then converted back to the value's type using the given conversion function
+//
+func getSaturatingArithmeticMember(
+	v NumberValue,
+	name string,
+	rangedType sema.IntegerRangedType,
+	convert func(Value) Value,
+) Value {
+
+	var operation func(z, x, y *big.Int) *big.Int
+
+	switch name {
+	case sema.SaturatingAddFunctionName:
+		operation = (*big.Int).Add
+
+	case sema.SaturatingSubtractFunctionName:
+		operation = (*big.Int).Sub
+
+	case sema.SaturatingMultiplyFunctionName:
+		operation = (*big.Int).Mul
+
+	default:
+		return nil
+	}
+
+	return NewHostFunctionValue(
+		func(invocation Invocation) Value {
+			other := invocation.Arguments[0].(NumberValue)
+
+			result := operation(
+				new(big.Int),
+				IntegerValueToBigInt(v),
+				IntegerValueToBigInt(other),
+			)
+
+			result = saturateBigInt(result, rangedType)
+
+			return convert(NewIntValueFromBigInt(result))
+		},
+	)
+}
+
+// fixedWidthIntegerByteSize returns the number of bytes
+// of the representation of the given fixed-width integer type
+//
+func fixedWidthIntegerByteSize(rangedType sema.IntegerRangedType) int {
+	return (rangedType.MaxInt().BitLen() + 7) / 8
+}
+
+// newFromBytesFunction returns a host function which decodes
+// the byte representation of a number of the given integer type.
+//
+// For fixed-width integer types the function returns nil
+// if the number of bytes does not match the size of the type.
+// The arbitrary-precision integer types accept any number of bytes
+//
+func newFromBytesFunction(numberType sema.Type, littleEndian bool) HostFunctionValue {
+
+	var signed bool
+	size := -1
+
+	switch numberType.(type) {
+	case *sema.IntType:
+		signed = true
+
+	case *sema.UIntType:
+		signed = false
+
+	default:
+		rangedType := numberType.(sema.IntegerRangedType)
+		size = fixedWidthIntegerByteSize(rangedType)
+		signed = rangedType.MinInt().Sign() < 0
+	}
+
+	return NewHostFunctionValue(
+		func(invocation Invocation) Value {
+			bytes, err := ByteArrayValueToByteSlice(invocation.Arguments[0])
+			if err != nil {
+				panic(err)
+			}
+
+			if size >= 0 && len(bytes) != size {
+				return NilValue{}
+			}
+
+			if littleEndian {
+				bytes = reverseBytes(bytes)
+			}
+
+			var result *big.Int
+			if signed {
+				result = BigEndianBytesToSignedBigInt(bytes)
+			} else {
+				result = BigEndianBytesToUnsignedBigInt(bytes)
+			}
+
+			value := invocation.Interpreter.convert(
+				NewIntValueFromBigInt(result),
+				&sema.IntType{},
+				numberType,
+			)
+
+			return NewSomeValueOwningNonCopying(value)
+		},
+	)
+}
@@ -0,0 +1,79 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2020 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package interpreter
+
+import (
+	"encoding/binary"
+)
+
+// MarshalBinary returns the binary representation of the given value,
+// e.g. for caching it outside of account storage.
+//
+// The representation starts with the version of the encoding (see CurrentEncodingVersion),
+// encoded like the version of stored values, followed by the CBOR-encoded value.
+//
+// Resources, and values containing resources, cannot be serialized,
+// as the serialized data could be used to duplicate them.
+//
+func MarshalBinary(interpreter *Interpreter, value Value) ([]byte, error) {
+
+	if ContainsResource(interpreter, value) {
+		return nil, NonSerializableResourceError{
+			Value: value,
+		}
+	}
+
+	encoded, _, err := EncodeValue(value, nil, false, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]byte, VersionEncodingLength+len(encoded))
+	binary.BigEndian.PutUint16(result[:VersionEncodingLength], CurrentEncodingVersion)
+	copy(result[VersionEncodingLength:], encoded)
+
+	return result, nil
+}
+
+// UnmarshalBinary returns the value for the given binary representation,
+// which was produced by MarshalBinary.
+//
+// Data produced with a previous version of the encoding is decoded according to that version.
+// Data with an unknown version is rejected,
+// as is data too short to contain both the version and a value.
+//
+func UnmarshalBinary(data []byte) (Value, error) {
+
+	// The version is followed by at least one byte of CBOR data
+
+	if len(data) < VersionEncodingLength+1 {
+		return nil, TruncatedBinaryDataError{
+			Length: len(data),
+		}
+	}
+
+	version := binary.BigEndian.Uint16(data[:VersionEncodingLength])
+	if version == 0 || version > CurrentEncodingVersion {
+		return nil, UnsupportedBinaryFormatVersionError{
+			Version: version,
+		}
+	}
+
+	return DecodeValue(data[VersionEncodingLength:], nil, nil, version, nil)
+}
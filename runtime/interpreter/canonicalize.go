@@ -0,0 +1,198 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2020 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package interpreter
+
+import (
+	"bytes"
+	"math/big"
+	"sort"
+	"strings"
+
+	"github.com/onflow/cadence/runtime/common"
+)
+
+// Canonicalize returns the canonical form of the given value:
+// the entries of dictionaries are ordered by their keys (see compareCanonicalKeys),
+// and nested values are canonicalized recursively.
+//
+// Values which only differ in the order in which dictionary entries were inserted
+// have the same canonical form, and hence the same encoding.
+// This allows e.g. order-independent content hashing.
+//
+// The given value is not modified, the result is a new, unowned value.
+// Contracts are not copied, they are part of the result as-is.
+//
+// Resources, and values containing resources, have no canonical form,
+// as the canonical form is a copy, which could be used to duplicate them.
+//
+func Canonicalize(interpreter *Interpreter, value Value) (Value, error) {
+
+	if ContainsResource(interpreter, value) {
+		return nil, NonSerializableResourceError{
+			Value: value,
+		}
+	}
+
+	return canonicalize(interpreter, value), nil
+}
+
+func canonicalize(interpreter *Interpreter, value Value) Value {
+	switch value := value.(type) {
+	case *DictionaryValue:
+		return canonicalizeDictionary(interpreter, value)
+
+	case *ArrayValue:
+		values := make([]Value, len(value.Values))
+		for i, element := range value.Values {
+			values[i] = canonicalize(interpreter, element)
+		}
+		return NewArrayValueUnownedNonCopying(values...)
+
+	case *CompositeValue:
+		// Contracts are not copied, like in CompositeValue.Copy
+		if value.Kind == common.CompositeKindContract {
+			return value
+		}
+
+		fields := NewStringValueOrderedMap()
+		value.Fields.Foreach(func(name string, field Value) {
+			fields.Set(name, canonicalize(interpreter, field))
+		})
+
+		return &CompositeValue{
+			Location:            value.Location,
+			QualifiedIdentifier: value.QualifiedIdentifier,
+			Kind:                value.Kind,
+			Fields:              fields,
+			InjectedFields:      value.InjectedFields,
+			ComputedFields:      value.ComputedFields,
+			NestedVariables:     value.NestedVariables,
+			Functions:           value.Functions,
+			Destructor:          value.Destructor,
+			destroyed:           value.destroyed,
+			// NOTE: new value has no owner
+			Owner:    nil,
+			modified: true,
+		}
+
+	case *SomeValue:
+		return NewSomeValueOwningNonCopying(
+			canonicalize(interpreter, value.Value),
+		)
+
+	default:
+		return value.Copy()
+	}
+}
+
+func canonicalizeDictionary(interpreter *Interpreter, dictionary *DictionaryValue) *DictionaryValue {
+
+	keys := make([]Value, len(dictionary.Keys.Values))
+	copy(keys, dictionary.Keys.Values)
+
+	sort.SliceStable(keys, func(i, j int) bool {
+		return compareCanonicalKeys(keys[i], keys[j]) < 0
+	})
+
+	keysAndValues := make([]Value, 0, len(keys)*2)
+
+	for _, key := range keys {
+		// NOTE: use Get, which loads deferred values from storage
+		value := dictionary.Get(interpreter, ReturnEmptyLocationRange, key).(*SomeValue).Value
+
+		keysAndValues = append(
+			keysAndValues,
+			canonicalize(interpreter, key),
+			canonicalize(interpreter, value),
+		)
+	}
+
+	return NewDictionaryValueUnownedNonCopying(keysAndValues...)
+}
+
+// canonicalKeyRank returns the rank of the given dictionary key,
+// which determines the order of keys of different kinds:
+// numbers come first, then strings, then addresses, then all other keys.
+//
+func canonicalKeyRank(key Value) int {
+	switch key.(type) {
+	case NumberValue:
+		return 0
+	case *StringValue:
+		return 1
+	case AddressValue:
+		return 2
+	default:
+		return 3
+	}
+}
+
+// compareCanonicalKeys is a total order over dictionary keys.
+// It returns a negative number if a is less than b, zero if they are equal,
+// and a positive number if a is greater than b.
+//
+// Keys are first ordered by their rank (see canonicalKeyRank).
+// Numbers are ordered by their value, strings lexicographically, and addresses by their bytes.
+// Ties, e.g. numbers of different types with the same value, and all other keys,
+// are ordered by their type and key string.
+//
+func compareCanonicalKeys(a, b Value) int {
+	rankA := canonicalKeyRank(a)
+	rankB := canonicalKeyRank(b)
+	if rankA != rankB {
+		return rankA - rankB
+	}
+
+	var result int
+
+	switch a := a.(type) {
+	case NumberValue:
+		result = compareNumberKeys(a, b.(NumberValue))
+
+	case *StringValue:
+		result = strings.Compare(a.Str, b.(*StringValue).Str)
+
+	case AddressValue:
+		otherAddress := b.(AddressValue)
+		result = bytes.Compare(a[:], otherAddress[:])
+	}
+
+	if result != 0 {
+		return result
+	}
+
+	result = strings.Compare(a.StaticType().String(), b.StaticType().String())
+	if result != 0 {
+		return result
+	}
+
+	return strings.Compare(dictionaryKey(a), dictionaryKey(b))
+}
+
+func compareNumberKeys(a, b NumberValue) int {
+	// NOTE: the key strings of number values are their decimal representations,
+	// for both integers and fixed-point numbers
+
+	ratA, okA := new(big.Rat).SetString(dictionaryKey(a))
+	ratB, okB := new(big.Rat).SetString(dictionaryKey(b))
+	if !okA || !okB {
+		return 0
+	}
+	return ratA.Cmp(ratB)
+}
@@ -0,0 +1,202 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2020 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package interpreter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/tests/utils"
+)
+
+func TestCanonicalize(t *testing.T) {
+
+	t.Parallel()
+
+	encode := func(t *testing.T, value Value) []byte {
+		encoded, _, err := EncodeValue(value, nil, false, nil)
+		require.NoError(t, err)
+		return encoded
+	}
+
+	canonicalize := func(t *testing.T, value Value) Value {
+		canonical, err := Canonicalize(nil, value)
+		require.NoError(t, err)
+		return canonical
+	}
+
+	t.Run("dictionaries with different insertion order", func(t *testing.T) {
+
+		t.Parallel()
+
+		first := NewDictionaryValueUnownedNonCopying(
+			NewStringValue("b"), NewIntValueFromInt64(1),
+			NewStringValue("a"), NewIntValueFromInt64(2),
+		)
+
+		second := NewDictionaryValueUnownedNonCopying(
+			NewStringValue("a"), NewIntValueFromInt64(2),
+			NewStringValue("b"), NewIntValueFromInt64(1),
+		)
+
+		require.NotEqual(t, encode(t, first), encode(t, second))
+
+		canonicalFirst := canonicalize(t, first)
+		canonicalSecond := canonicalize(t, second)
+
+		assert.Equal(t, encode(t, canonicalFirst), encode(t, canonicalSecond))
+
+		assert.Equal(t,
+			[]Value{
+				NewStringValue("a"),
+				NewStringValue("b"),
+			},
+			canonicalFirst.(*DictionaryValue).Keys.Values,
+		)
+
+		// the given value is not modified
+
+		assert.Equal(t,
+			[]Value{
+				NewStringValue("b"),
+				NewStringValue("a"),
+			},
+			first.Keys.Values,
+		)
+	})
+
+	t.Run("key order", func(t *testing.T) {
+
+		t.Parallel()
+
+		address := NewAddressValueFromBytes([]byte{0x1})
+
+		dictionary := NewDictionaryValueUnownedNonCopying(
+			BoolValue(true), NewIntValueFromInt64(1),
+			address, NewIntValueFromInt64(2),
+			NewStringValue("x"), NewIntValueFromInt64(3),
+			NewIntValueFromInt64(10), NewIntValueFromInt64(4),
+			NewUFix64ValueWithInteger(1), NewIntValueFromInt64(5),
+			UFix64Value(150000000), NewIntValueFromInt64(6),
+			NewIntValueFromInt64(1), NewIntValueFromInt64(7),
+			NewStringValue("a"), NewIntValueFromInt64(8),
+		)
+
+		canonical := canonicalize(t, dictionary).(*DictionaryValue)
+
+		assert.Equal(t,
+			[]Value{
+				// numbers, by value, then type
+				NewIntValueFromInt64(1),
+				NewUFix64ValueWithInteger(1),
+				UFix64Value(150000000),
+				NewIntValueFromInt64(10),
+				// strings
+				NewStringValue("a"),
+				NewStringValue("x"),
+				// addresses
+				address,
+				// others
+				BoolValue(true),
+			},
+			canonical.Keys.Values,
+		)
+	})
+
+	t.Run("nested containers", func(t *testing.T) {
+
+		t.Parallel()
+
+		newValue := func(keys ...string) Value {
+			dictionary := NewDictionaryValueUnownedNonCopying()
+			for _, key := range keys {
+				_ = dictionary.Insert(
+					nil,
+					ReturnEmptyLocationRange,
+					NewStringValue(key),
+					NewIntValueFromInt64(int64(len(key))),
+				)
+			}
+
+			fields := NewStringValueOrderedMap()
+			fields.Set(
+				"entries",
+				NewArrayValueUnownedNonCopying(
+					NewSomeValueOwningNonCopying(dictionary),
+				),
+			)
+
+			return NewCompositeValue(
+				utils.TestLocation,
+				"Test",
+				common.CompositeKindStructure,
+				fields,
+				nil,
+			)
+		}
+
+		first := newValue("foo", "bar", "baz")
+		second := newValue("baz", "foo", "bar")
+
+		require.NotEqual(t, encode(t, first), encode(t, second))
+
+		assert.Equal(t,
+			encode(t, canonicalize(t, first)),
+			encode(t, canonicalize(t, second)),
+		)
+	})
+
+	t.Run("resources", func(t *testing.T) {
+
+		t.Parallel()
+
+		newResource := func() *CompositeValue {
+			return NewCompositeValue(
+				utils.TestLocation,
+				"R",
+				common.CompositeKindResource,
+				NewStringValueOrderedMap(),
+				nil,
+			)
+		}
+
+		for name, value := range map[string]Value{
+			"resource": newResource(),
+			"resource-valued dictionary": NewDictionaryValueUnownedNonCopying(
+				NewStringValue("b"), newResource(),
+				NewStringValue("a"), newResource(),
+			),
+			"nested resource": NewArrayValueUnownedNonCopying(
+				NewSomeValueOwningNonCopying(newResource()),
+			),
+		} {
+			_, err := Canonicalize(nil, value)
+			require.Equal(t,
+				NonSerializableResourceError{
+					Value: value,
+				},
+				err,
+				name,
+			)
+		}
+	})
+}
@@ -0,0 +1,107 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2020 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package interpreter
+
+import (
+	"sort"
+)
+
+// CapabilityInfo describes a capability or link stored in an account
+//
+type CapabilityInfo struct {
+	// Path is the path at which the capability or link is stored.
+	// Capabilities may also be stored nested in other values, e.g. in arrays
+	Path PathValue
+	// IsLink is true if the value is a link, and false if it is a capability
+	IsLink bool
+	// BorrowType is the borrow type of the capability or the type of the link.
+	// It is nil for capabilities without a borrow type
+	BorrowType StaticType
+	// TargetAddress is the address of the account the capability targets.
+	// For links it is the address of the account
+	TargetAddress AddressValue
+	// TargetPath is the path the capability or link targets
+	TargetPath PathValue
+}
+
+// InventoryCapabilities returns all capabilities and links stored in the given account,
+// ordered by the path they are stored at.
+//
+// The keys of the account's storage are enumerated using the stored keys handler,
+// see WithStoredKeysHandler. If no handler is set, no capabilities are returned.
+//
+func InventoryCapabilities(interpreter *Interpreter, account AuthAccountValue) []CapabilityInfo {
+
+	if interpreter.storedKeysHandler == nil {
+		return nil
+	}
+
+	address := account.Address.ToAddress()
+
+	var paths []PathValue
+
+	for _, key := range interpreter.storedKeysHandler(interpreter, address) {
+		path, ok := storageKeyPath(key)
+		if !ok {
+			continue
+		}
+		paths = append(paths, path)
+	}
+
+	sort.Slice(paths, func(i, j int) bool {
+		a := paths[i]
+		b := paths[j]
+		if a.Domain != b.Domain {
+			return a.Domain < b.Domain
+		}
+		return a.Identifier < b.Identifier
+	})
+
+	var infos []CapabilityInfo
+
+	for _, path := range paths {
+
+		storedValue, ok := interpreter.readStored(address, storageKey(path), false).(*SomeValue)
+		if !ok {
+			continue
+		}
+
+		storedValue.Value.Accept(interpreter, EmptyVisitor{
+			CapabilityValueVisitor: func(_ *Interpreter, value CapabilityValue) {
+				infos = append(infos, CapabilityInfo{
+					Path:          path,
+					BorrowType:    value.BorrowType,
+					TargetAddress: value.Address,
+					TargetPath:    value.Path,
+				})
+			},
+			LinkValueVisitor: func(_ *Interpreter, value LinkValue) {
+				infos = append(infos, CapabilityInfo{
+					Path:          path,
+					IsLink:        true,
+					BorrowType:    value.Type,
+					TargetAddress: account.Address,
+					TargetPath:    value.TargetPath,
+				})
+			},
+		})
+	}
+
+	return infos
+}
@@ -0,0 +1,168 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2020 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package interpreter
+
+import (
+	"math/big"
+
+	"github.com/onflow/cadence/runtime/errors"
+	"github.com/onflow/cadence/runtime/sema"
+)
+
+// ToInt8Checked converts the value to an Int8 value.
+// It returns false if the value is not in the range of Int8.
+//
+func (v Int128Value) ToInt8Checked() (Int8Value, bool) {
+	return bigIntToInt8Checked(v.BigInt)
+}
+
+// ToInt16Checked converts the value to an Int16 value.
+// It returns false if the value is not in the range of Int16.
+//
+func (v Int128Value) ToInt16Checked() (Int16Value, bool) {
+	return bigIntToInt16Checked(v.BigInt)
+}
+
+// ToInt32Checked converts the value to an Int32 value.
+// It returns false if the value is not in the range of Int32.
+//
+func (v Int128Value) ToInt32Checked() (Int32Value, bool) {
+	return bigIntToInt32Checked(v.BigInt)
+}
+
+// ToInt64Checked converts the value to an Int64 value.
+// It returns false if the value is not in the range of Int64.
+//
+func (v Int128Value) ToInt64Checked() (Int64Value, bool) {
+	return bigIntToInt64Checked(v.BigInt)
+}
+
+// ToInt8Checked converts the value to an Int8 value.
+// It returns false if the value is not in the range of Int8.
+//
+func (v Int256Value) ToInt8Checked() (Int8Value, bool) {
+	return bigIntToInt8Checked(v.BigInt)
+}
+
+// ToInt16Checked converts the value to an Int16 value.
+// It returns false if the value is not in the range of Int16.
+//
+func (v Int256Value) ToInt16Checked() (Int16Value, bool) {
+	return bigIntToInt16Checked(v.BigInt)
+}
+
+// ToInt32Checked converts the value to an Int32 value.
+// It returns false if the value is not in the range of Int32.
+//
+func (v Int256Value) ToInt32Checked() (Int32Value, bool) {
+	return bigIntToInt32Checked(v.BigInt)
+}
+
+// ToInt64Checked converts the value to an Int64 value.
+// It returns false if the value is not in the range of Int64.
+//
+func (v Int256Value) ToInt64Checked() (Int64Value, bool) {
+	return bigIntToInt64Checked(v.BigInt)
+}
+
+// ToInt128Checked converts the value to an Int128 value.
+// It returns false if the value is not in the range of Int128.
+//
+func (v Int256Value) ToInt128Checked() (Int128Value, bool) {
+	if !bigIntInRange(v.BigInt, &sema.Int128Type{}) {
+		return Int128Value{}, false
+	}
+	return NewInt128ValueFromBigInt(new(big.Int).Set(v.BigInt)), true
+}
+
+func bigIntToInt8Checked(value *big.Int) (Int8Value, bool) {
+	if !bigIntInRange(value, &sema.Int8Type{}) {
+		return 0, false
+	}
+	return Int8Value(value.Int64()), true
+}
+
+func bigIntToInt16Checked(value *big.Int) (Int16Value, bool) {
+	if !bigIntInRange(value, &sema.Int16Type{}) {
+		return 0, false
+	}
+	return Int16Value(value.Int64()), true
+}
+
+func bigIntToInt32Checked(value *big.Int) (Int32Value, bool) {
+	if !bigIntInRange(value, &sema.Int32Type{}) {
+		return 0, false
+	}
+	return Int32Value(value.Int64()), true
+}
+
+func bigIntToInt64Checked(value *big.Int) (Int64Value, bool) {
+	if !bigIntInRange(value, &sema.Int64Type{}) {
+		return 0, false
+	}
+	return Int64Value(value.Int64()), true
+}
+
+// bigIntInRange returns true if the given integer is in the range of the given type
+//
+func bigIntInRange(value *big.Int, rangedType sema.IntegerRangedType) bool {
+	return value.Cmp(rangedType.MinInt()) >= 0 &&
+		value.Cmp(rangedType.MaxInt()) <= 0
+}
+
+// getCheckedConversionMember returns the checked conversion function with the given name
+// for the given large signed integer value, or nil if there is no such function.
+//
+// The function returns nil instead of panicking if the value is not in the range of the target type
+//
+func getCheckedConversionMember(v BigNumberValue, name string, ty sema.Type) Value {
+	targetType, ok := sema.CheckedConversionTargetTypes(ty)[name]
+	if !ok {
+		return nil
+	}
+
+	return NewHostFunctionValue(
+		func(invocation Invocation) Value {
+			value := v.ToBigInt()
+
+			var result Value
+			switch targetType.(type) {
+			case *sema.Int8Type:
+				result, ok = bigIntToInt8Checked(value)
+			case *sema.Int16Type:
+				result, ok = bigIntToInt16Checked(value)
+			case *sema.Int32Type:
+				result, ok = bigIntToInt32Checked(value)
+			case *sema.Int64Type:
+				result, ok = bigIntToInt64Checked(value)
+			case *sema.Int128Type:
+				ok = bigIntInRange(value, targetType)
+				result = NewInt128ValueFromBigInt(value)
+			default:
+				panic(errors.NewUnreachableError())
+			}
+
+			if !ok {
+				return NilValue{}
+			}
+
+			return NewSomeValueOwningNonCopying(result)
+		},
+	)
+}
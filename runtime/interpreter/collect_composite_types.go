@@ -0,0 +1,43 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2020 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package interpreter
+
+import (
+	"github.com/onflow/cadence/runtime/sema"
+)
+
+// CollectCompositeTypes returns how often each composite type occurs in the given value,
+// keyed by the type ID of the composite type.
+//
+// The value is traversed completely, i.e. composites nested in arrays, dictionaries,
+// optionals, and fields of other composites are included.
+// References are not followed, as they do not own the referenced value.
+//
+func CollectCompositeTypes(interpreter *Interpreter, root Value) map[sema.TypeID]uint64 {
+	counts := map[sema.TypeID]uint64{}
+
+	root.Accept(interpreter, EmptyVisitor{
+		CompositeValueVisitor: func(_ *Interpreter, value *CompositeValue) bool {
+			counts[value.TypeID()]++
+			return true
+		},
+	})
+
+	return counts
+}
@@ -0,0 +1,41 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2020 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package interpreter
+
+//go:generate go run golang.org/x/tools/cmd/stringer -type=ComputationKind -trimprefix=ComputationKind
+
+// ComputationKind is the kind of an operation which is metered.
+//
+// The cost of each kind of operation can be configured
+// using the interpreter option WithComputationWeights.
+//
+type ComputationKind uint8
+
+const (
+	ComputationKindUnknown ComputationKind = iota
+	ComputationKindLoopIteration
+	ComputationKindFunctionInvocation
+	ComputationKindHashing
+	ComputationKindArrayAllocation
+)
+
+// defaultComputationWeight is the weight of a kind of operation
+// which has no weight configured.
+//
+const defaultComputationWeight uint64 = 1
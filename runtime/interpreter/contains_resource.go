@@ -0,0 +1,81 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2020 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package interpreter
+
+import (
+	"github.com/onflow/cadence/runtime/common"
+)
+
+// ContainsResource returns true if the given value is a resource,
+// or if it contains a resource, e.g. as an element of an array,
+// as an entry of a dictionary, or as a field of a composite.
+//
+// References to resources are not considered, as they do not own the resource.
+//
+func ContainsResource(interpreter *Interpreter, value Value) bool {
+
+	// Values which cannot contain other values cannot contain a resource
+
+	switch value.(type) {
+	case *CompositeValue, *ArrayValue, *DictionaryValue, *SomeValue:
+		break
+	default:
+		return false
+	}
+
+	found := false
+
+	value.Accept(interpreter, EmptyVisitor{
+		SomeValueVisitor: func(_ *Interpreter, _ *SomeValue) bool {
+			return !found
+		},
+		ArrayValueVisitor: func(_ *Interpreter, _ *ArrayValue) bool {
+			return !found
+		},
+		DictionaryValueVisitor: func(_ *Interpreter, value *DictionaryValue) bool {
+			if found {
+				return false
+			}
+
+			// Only values of resource dictionaries are deferred,
+			// so there is no need to load them
+
+			if value.DeferredKeys != nil && value.DeferredKeys.Len() > 0 {
+				found = true
+				return false
+			}
+
+			return true
+		},
+		CompositeValueVisitor: func(_ *Interpreter, value *CompositeValue) bool {
+			if found {
+				return false
+			}
+
+			if value.Kind == common.CompositeKindResource {
+				found = true
+				return false
+			}
+
+			return true
+		},
+	})
+
+	return found
+}
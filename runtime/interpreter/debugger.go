@@ -0,0 +1,88 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2020 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+
+package interpreter
+
+import (
+	"github.com/onflow/cadence/runtime/ast"
+)
+
+// ControlAction is the action a debug handler requests the interpreter to take
+// before executing a statement.
+//
+type ControlAction uint8
+
+const (
+	// ControlActionContinue requests the interpreter to execute the statement
+	ControlActionContinue ControlAction = iota
+	// ControlActionPause requests the interpreter to pause before executing the statement,
+	// until Resume is called
+	ControlActionPause
+)
+
+// DebugHandlerFunc is a function that is triggered when a statement is about to be executed.
+//
+// The location range is the source position of the statement,
+// and the activation contains the variables which are in scope of the statement.
+//
+type DebugHandlerFunc func(
+	inter *Interpreter,
+	locationRange LocationRange,
+	statement ast.Statement,
+	activation *VariableActivation,
+) ControlAction
+
+// debugStatement calls the debug handler for the given statement,
+// and pauses the interpreter if the handler requests it.
+//
+func (interpreter *Interpreter) debugStatement(statement ast.Statement) {
+	locationRange := LocationRange{
+		Location: interpreter.Location,
+		Range:    ast.NewRangeFromPositioned(statement),
+	}
+
+	action := interpreter.debugHandler(
+		interpreter,
+		locationRange,
+		statement,
+		interpreter.CurrentActivation(),
+	)
+
+	if action == ControlActionPause {
+		<-interpreter.resume
+	}
+}
+
+// Resume resumes the execution of the interpreter after it was paused
+// by the debug handler requesting ControlActionPause.
+//
+// Resume blocks until the interpreter is paused,
+// so it must be called from a different goroutine than the one executing the program.
+//
+func (interpreter *Interpreter) Resume() {
+	interpreter.resume <- struct{}{}
+}
+
+// CurrentActivation returns the current activation, which contains the variables
+// that are in scope of the statement that is executed.
+// It returns nil if there is no current activation.
+//
+func (interpreter *Interpreter) CurrentActivation() *VariableActivation {
+	return interpreter.activations.Current()
+}
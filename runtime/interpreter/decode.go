@@ -42,10 +42,31 @@ type DecodingCallback func(value interface{}, path []string)
 // A Decoder decodes CBOR-encoded representations of values.
 //
 type Decoder struct {
-	decoder        *cbor.Decoder
-	owner          *common.Address
-	version        uint16
-	decodeCallback DecodingCallback
+	decoder            *cbor.Decoder
+	owner              *common.Address
+	version            uint16
+	decodeCallback     DecodingCallback
+	maxCompositeFields int
+}
+
+// DecoderOption configures a Decoder.
+//
+type DecoderOption func(decoder *Decoder)
+
+// WithMaxDecodedCompositeFields returns a decoder option
+// which bounds the decoding cost of composites:
+// decoding fails if a composite has more fields than the given maximum.
+// Zero means unlimited (default).
+//
+// The limit is only intended for untrusted input.
+// It must not be used to decode existing stored values,
+// which may have been stored before the limit was configured,
+// as they would become unreadable.
+//
+func WithMaxDecodedCompositeFields(max int) DecoderOption {
+	return func(decoder *Decoder) {
+		decoder.maxCompositeFields = max
+	}
 }
 
 // Decode returns a value decoded from its CBOR-encoded representation,
@@ -61,13 +82,14 @@ func DecodeValue(
 	path []string,
 	version uint16,
 	decodeCallback DecodingCallback,
+	options ...DecoderOption,
 ) (
 	Value,
 	error,
 ) {
 	reader := bytes.NewReader(data)
 
-	decoder, err := NewDecoder(reader, owner, version, decodeCallback)
+	decoder, err := NewDecoder(reader, owner, version, decodeCallback, options...)
 	if err != nil {
 		return nil, err
 	}
@@ -90,16 +112,23 @@ func NewDecoder(
 	owner *common.Address,
 	version uint16,
 	decodeCallback DecodingCallback,
+	options ...DecoderOption,
 ) (
 	*Decoder,
 	error,
 ) {
-	return &Decoder{
+	decoder := &Decoder{
 		decoder:        decMode.NewDecoder(reader),
 		owner:          owner,
 		version:        version,
 		decodeCallback: decodeCallback,
-	}, nil
+	}
+
+	for _, option := range options {
+		option(decoder)
+	}
+
+	return decoder, nil
 }
 
 var decMode = func() cbor.DecMode {
@@ -680,6 +709,15 @@ func (d *Decoder) decodeComposite(v interface{}, path []string) (*CompositeValue
 		)
 	}
 
+	if d.maxCompositeFields > 0 && len(encodedFields) > d.maxCompositeFields {
+		return nil, fmt.Errorf(
+			"invalid composite fields encoding (@ %s): too many fields: expected at most %d, got %d",
+			strings.Join(path, "."),
+			d.maxCompositeFields,
+			len(encodedFields),
+		)
+	}
+
 	// Gather all field names and sort them lexicographically
 
 	var fieldNames []string
@@ -0,0 +1,172 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2020 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package interpreter
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// ValueDifference is a difference between two values, found by DiffValues.
+//
+// The path is the location of the difference inside the compared values,
+// e.g. `.xs[0]["key"]`, and is empty for a difference of the values themselves.
+//
+// The old and new values are rendered as source code where possible.
+// They are empty if the value is missing on the respective side,
+// e.g. if an array element or a dictionary entry was added or removed.
+//
+type ValueDifference struct {
+	Path     string
+	OldValue string
+	NewValue string
+}
+
+// DiffValues returns the differences between the two given values.
+//
+// Arrays, dictionaries, and composites are compared element-wise,
+// so only the nested values which differ are reported.
+// Values of different types are reported as a single difference.
+//
+func DiffValues(interpreter *Interpreter, a, b Value) []ValueDifference {
+	var differences []ValueDifference
+	diffValues(interpreter, "", a, b, &differences)
+	return differences
+}
+
+func diffValues(interpreter *Interpreter, path string, a, b Value, differences *[]ValueDifference) {
+
+	report := func() {
+		*differences = append(*differences,
+			ValueDifference{
+				Path:     path,
+				OldValue: renderDiffValue(interpreter, a),
+				NewValue: renderDiffValue(interpreter, b),
+			},
+		)
+	}
+
+	if a == nil || b == nil {
+		if a != b {
+			report()
+		}
+		return
+	}
+
+	if reflect.TypeOf(a) != reflect.TypeOf(b) {
+		report()
+		return
+	}
+
+	switch a := a.(type) {
+	case *SomeValue:
+		diffValues(interpreter, path, a.Value, b.(*SomeValue).Value, differences)
+
+	case *ArrayValue:
+		otherValues := b.(*ArrayValue).Values
+
+		count := len(a.Values)
+		if len(otherValues) > count {
+			count = len(otherValues)
+		}
+
+		for i := 0; i < count; i++ {
+			var element, otherElement Value
+			if i < len(a.Values) {
+				element = a.Values[i]
+			}
+			if i < len(otherValues) {
+				otherElement = otherValues[i]
+			}
+
+			elementPath := fmt.Sprintf("%s[%d]", path, i)
+			diffValues(interpreter, elementPath, element, otherElement, differences)
+		}
+
+	case *DictionaryValue:
+		other := b.(*DictionaryValue)
+
+		getEntry := func(dictionary *DictionaryValue, key Value) Value {
+			someValue, ok := dictionary.Get(interpreter, ReturnEmptyLocationRange, key).(*SomeValue)
+			if !ok {
+				return nil
+			}
+			return someValue.Value
+		}
+
+		// Entries of the old dictionary first, then the entries only in the new dictionary
+
+		for _, key := range a.Keys.Values {
+			entryPath := fmt.Sprintf("%s[%s]", path, renderDiffValue(interpreter, key))
+			diffValues(interpreter, entryPath, getEntry(a, key), getEntry(other, key), differences)
+		}
+
+		for _, key := range other.Keys.Values {
+			if getEntry(a, key) != nil {
+				continue
+			}
+			entryPath := fmt.Sprintf("%s[%s]", path, renderDiffValue(interpreter, key))
+			diffValues(interpreter, entryPath, nil, getEntry(other, key), differences)
+		}
+
+	case *CompositeValue:
+		other := b.(*CompositeValue)
+
+		if a.TypeID() != other.TypeID() {
+			report()
+			return
+		}
+
+		// Fields of the old composite first, then the fields only in the new composite
+
+		a.Fields.Foreach(func(name string, fieldValue Value) {
+			otherFieldValue, _ := other.Fields.Get(name)
+			diffValues(interpreter, path+"."+name, fieldValue, otherFieldValue, differences)
+		})
+
+		other.Fields.Foreach(func(name string, otherFieldValue Value) {
+			if _, ok := a.Fields.Get(name); ok {
+				return
+			}
+			diffValues(interpreter, path+"."+name, nil, otherFieldValue, differences)
+		})
+
+	case EquatableValue:
+		if !a.Equal(interpreter, b) {
+			report()
+		}
+
+	default:
+		if a.String() != b.String() {
+			report()
+		}
+	}
+}
+
+func renderDiffValue(interpreter *Interpreter, value Value) string {
+	if value == nil {
+		return ""
+	}
+
+	rendered, err := PrettyPrintValue(interpreter, value)
+	if err != nil {
+		return value.String()
+	}
+	return rendered
+}
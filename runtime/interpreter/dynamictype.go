@@ -65,6 +65,14 @@ type ArrayDynamicType struct {
 
 func (ArrayDynamicType) IsDynamicType() {}
 
+// TupleDynamicType
+
+type TupleDynamicType struct {
+	ElementTypes []DynamicType
+}
+
+func (TupleDynamicType) IsDynamicType() {}
+
 // NumberDynamicType
 
 type NumberDynamicType struct {
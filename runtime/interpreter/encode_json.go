@@ -0,0 +1,293 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2020 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package interpreter
+
+import (
+	"encoding/json"
+
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/errors"
+)
+
+type jsonValue struct {
+	Type  string      `json:"type"`
+	Value interface{} `json:"value,omitempty"`
+}
+
+type jsonDictionaryEntry struct {
+	Key   jsonValue `json:"key"`
+	Value jsonValue `json:"value"`
+}
+
+type jsonCompositeField struct {
+	Name  string    `json:"name"`
+	Value jsonValue `json:"value"`
+}
+
+type jsonComposite struct {
+	ID     string               `json:"id"`
+	Fields []jsonCompositeField `json:"fields"`
+}
+
+type jsonPath struct {
+	Domain     string `json:"domain"`
+	Identifier string `json:"identifier"`
+}
+
+type jsonCapability struct {
+	Address    string   `json:"address"`
+	Path       jsonPath `json:"path"`
+	BorrowType string   `json:"borrowType,omitempty"`
+}
+
+// EncodeValueJSON returns the deterministic JSON encoding of the given value.
+//
+// Each value is encoded as an object with a type tag and the value,
+// e.g. `{"type":"Array","value":[...]}`.
+//
+// Dictionary entries are encoded in insertion order,
+// composite fields in declaration order (see CompositeValue.FieldNamesInOrder),
+// and integers and fixed-point numbers are encoded as decimal strings
+// to avoid a loss of precision.
+//
+// Values which are not storable, e.g. references and functions, result in an error.
+//
+func EncodeValueJSON(interpreter *Interpreter, value Value) ([]byte, error) {
+	prepared, err := prepareJSONValue(interpreter, value)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(prepared)
+}
+
+// prepareJSONValue returns the representation for the value that can be marshalled to JSON.
+//
+// Container values are not descended into by the visitor,
+// but prepared recursively, so the nesting of the result matches the nesting of the values.
+//
+func prepareJSONValue(interpreter *Interpreter, value Value) (result jsonValue, err error) {
+
+	prepared := false
+
+	setResult := func(ty string, value interface{}) {
+		result = jsonValue{
+			Type:  ty,
+			Value: value,
+		}
+		prepared = true
+	}
+
+	prepareNumber := func(ty string, value NumberValue) {
+		setResult(ty, value.String())
+	}
+
+	prepareChild := func(value Value) jsonValue {
+		if err != nil {
+			return jsonValue{}
+		}
+		var child jsonValue
+		child, err = prepareJSONValue(interpreter, value)
+		return child
+	}
+
+	visitor := EmptyVisitor{
+		VoidValueVisitor: func(_ *Interpreter, _ VoidValue) {
+			setResult("Void", nil)
+		},
+		BoolValueVisitor: func(_ *Interpreter, value BoolValue) {
+			setResult("Bool", bool(value))
+		},
+		StringValueVisitor: func(_ *Interpreter, value *StringValue) {
+			setResult("String", value.Str)
+		},
+		AddressValueVisitor: func(_ *Interpreter, value AddressValue) {
+			setResult("Address", value.Hex())
+		},
+		PathValueVisitor: func(_ *Interpreter, value PathValue) {
+			setResult("Path", preparePathJSONValue(value))
+		},
+		TypeValueVisitor: func(_ *Interpreter, value TypeValue) {
+			var staticType string
+			if value.Type != nil {
+				staticType = value.Type.String()
+			}
+			setResult("Type", staticType)
+		},
+		CapabilityValueVisitor: func(_ *Interpreter, value CapabilityValue) {
+			capability := jsonCapability{
+				Address: value.Address.Hex(),
+				Path:    preparePathJSONValue(value.Path),
+			}
+			if value.BorrowType != nil {
+				capability.BorrowType = value.BorrowType.String()
+			}
+			setResult("Capability", capability)
+		},
+		IntValueVisitor: func(_ *Interpreter, value IntValue) {
+			prepareNumber("Int", value)
+		},
+		Int8ValueVisitor: func(_ *Interpreter, value Int8Value) {
+			prepareNumber("Int8", value)
+		},
+		Int16ValueVisitor: func(_ *Interpreter, value Int16Value) {
+			prepareNumber("Int16", value)
+		},
+		Int32ValueVisitor: func(_ *Interpreter, value Int32Value) {
+			prepareNumber("Int32", value)
+		},
+		Int64ValueVisitor: func(_ *Interpreter, value Int64Value) {
+			prepareNumber("Int64", value)
+		},
+		Int128ValueVisitor: func(_ *Interpreter, value Int128Value) {
+			prepareNumber("Int128", value)
+		},
+		Int256ValueVisitor: func(_ *Interpreter, value Int256Value) {
+			prepareNumber("Int256", value)
+		},
+		UIntValueVisitor: func(_ *Interpreter, value UIntValue) {
+			prepareNumber("UInt", value)
+		},
+		UInt8ValueVisitor: func(_ *Interpreter, value UInt8Value) {
+			prepareNumber("UInt8", value)
+		},
+		UInt16ValueVisitor: func(_ *Interpreter, value UInt16Value) {
+			prepareNumber("UInt16", value)
+		},
+		UInt32ValueVisitor: func(_ *Interpreter, value UInt32Value) {
+			prepareNumber("UInt32", value)
+		},
+		UInt64ValueVisitor: func(_ *Interpreter, value UInt64Value) {
+			prepareNumber("UInt64", value)
+		},
+		UInt128ValueVisitor: func(_ *Interpreter, value UInt128Value) {
+			prepareNumber("UInt128", value)
+		},
+		UInt256ValueVisitor: func(_ *Interpreter, value UInt256Value) {
+			prepareNumber("UInt256", value)
+		},
+		Word8ValueVisitor: func(_ *Interpreter, value Word8Value) {
+			prepareNumber("Word8", value)
+		},
+		Word16ValueVisitor: func(_ *Interpreter, value Word16Value) {
+			prepareNumber("Word16", value)
+		},
+		Word32ValueVisitor: func(_ *Interpreter, value Word32Value) {
+			prepareNumber("Word32", value)
+		},
+		Word64ValueVisitor: func(_ *Interpreter, value Word64Value) {
+			prepareNumber("Word64", value)
+		},
+		Fix64ValueVisitor: func(_ *Interpreter, value Fix64Value) {
+			prepareNumber("Fix64", value)
+		},
+		UFix64ValueVisitor: func(_ *Interpreter, value UFix64Value) {
+			prepareNumber("UFix64", value)
+		},
+		NilValueVisitor: func(_ *Interpreter, _ NilValue) {
+			setResult("Optional", nil)
+		},
+		SomeValueVisitor: func(_ *Interpreter, value *SomeValue) bool {
+			setResult("Optional", prepareChild(value.Value))
+			return false
+		},
+		ArrayValueVisitor: func(_ *Interpreter, value *ArrayValue) bool {
+			elements := make([]jsonValue, len(value.Values))
+			for i, element := range value.Values {
+				elements[i] = prepareChild(element)
+			}
+			setResult("Array", elements)
+			return false
+		},
+		DictionaryValueVisitor: func(interpreter *Interpreter, value *DictionaryValue) bool {
+			entries := make([]jsonDictionaryEntry, len(value.Keys.Values))
+			for i, key := range value.Keys.Values {
+				// NOTE: Force unwrap. This is safe because we are iterating over the keys.
+				entryValue := value.Get(interpreter, ReturnEmptyLocationRange, key).(*SomeValue).Value
+
+				entries[i] = jsonDictionaryEntry{
+					Key:   prepareChild(key),
+					Value: prepareChild(entryValue),
+				}
+			}
+			setResult("Dictionary", entries)
+			return false
+		},
+		CompositeValueVisitor: func(interpreter *Interpreter, value *CompositeValue) bool {
+			// NOTE: the insertion order of the fields is not deterministic,
+			// e.g. decoded composites have their fields in lexicographic order
+
+			names := value.FieldNamesInOrder(interpreter)
+			fields := make([]jsonCompositeField, len(names))
+			for i, name := range names {
+				fieldValue, _ := value.Fields.Get(name)
+				fields[i] = jsonCompositeField{
+					Name:  name,
+					Value: prepareChild(fieldValue),
+				}
+			}
+			setResult(
+				jsonCompositeType(value.Kind),
+				jsonComposite{
+					ID:     string(value.TypeID()),
+					Fields: fields,
+				},
+			)
+			return false
+		},
+	}
+
+	value.Accept(interpreter, visitor)
+
+	if err != nil {
+		return jsonValue{}, err
+	}
+
+	if !prepared {
+		return jsonValue{}, EncodingUnsupportedValueError{
+			Value: value,
+		}
+	}
+
+	return result, nil
+}
+
+func preparePathJSONValue(value PathValue) jsonPath {
+	return jsonPath{
+		Domain:     value.Domain.Identifier(),
+		Identifier: value.Identifier,
+	}
+}
+
+func jsonCompositeType(kind common.CompositeKind) string {
+	switch kind {
+	case common.CompositeKindStructure:
+		return "Struct"
+	case common.CompositeKindResource:
+		return "Resource"
+	case common.CompositeKindContract:
+		return "Contract"
+	case common.CompositeKindEvent:
+		return "Event"
+	case common.CompositeKindEnum:
+		return "Enum"
+	}
+
+	panic(errors.NewUnreachableError())
+}
@@ -19,9 +19,12 @@
 package interpreter
 
 import (
+	"encoding/binary"
 	"fmt"
 	"math"
 	"math/big"
+	"math/rand"
+	"strings"
 	"testing"
 
 	"github.com/fxamacker/cbor/v2"
@@ -878,6 +881,52 @@ func TestEncodeDecodeComposite(t *testing.T) {
 	})
 }
 
+func TestDecodeCompositeMaxFields(t *testing.T) {
+
+	t.Parallel()
+
+	fields := NewStringValueOrderedMap()
+	fields.Set("a", NewIntValueFromInt64(1))
+	fields.Set("b", NewIntValueFromInt64(2))
+	fields.Set("c", NewIntValueFromInt64(3))
+
+	value := NewCompositeValue(
+		utils.TestLocation,
+		"TestStruct",
+		common.CompositeKindStructure,
+		fields,
+		nil,
+	)
+
+	encoded, _, err := EncodeValue(value, nil, false, nil)
+	require.NoError(t, err)
+
+	t.Run("unlimited", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := DecodeValue(encoded, nil, nil, CurrentEncodingVersion, nil)
+		require.NoError(t, err)
+	})
+
+	t.Run("within limit", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := DecodeValue(encoded, nil, nil, CurrentEncodingVersion, nil, WithMaxDecodedCompositeFields(3))
+		require.NoError(t, err)
+	})
+
+	t.Run("exceeding limit", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := DecodeValue(encoded, nil, nil, CurrentEncodingVersion, nil, WithMaxDecodedCompositeFields(2))
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "too many fields")
+	})
+}
+
 func TestEncodeDecodeIntValue(t *testing.T) {
 
 	t.Parallel()
@@ -4396,3 +4445,417 @@ func prepareLargeTestValue() Value {
 	}
 	return values
 }
+
+func TestEncodeValueJSON(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("nested", func(t *testing.T) {
+
+		t.Parallel()
+
+		largeInt, ok := new(big.Int).SetString("123456789012345678901234567890", 10)
+		require.True(t, ok)
+
+		fields := NewStringValueOrderedMap()
+		fields.Set("b", BoolValue(false))
+		fields.Set("a", NewSomeValueOwningNonCopying(NewStringValue("x")))
+
+		value := NewArrayValueUnownedNonCopying(
+			NewIntValueFromBigInt(largeInt),
+			UInt8Value(1),
+			UFix64Value(150000000),
+			NilValue{},
+			NewDictionaryValueUnownedNonCopying(
+				NewStringValue("z"),
+				Int8Value(-1),
+				NewStringValue("y"),
+				Int8Value(2),
+			),
+			NewCompositeValue(
+				utils.TestLocation,
+				"Test",
+				common.CompositeKindStructure,
+				fields,
+				nil,
+			),
+		)
+
+		encoded, err := EncodeValueJSON(nil, value)
+		require.NoError(t, err)
+
+		require.JSONEq(t,
+			`{
+              "type": "Array",
+              "value": [
+                {"type": "Int", "value": "123456789012345678901234567890"},
+                {"type": "UInt8", "value": "1"},
+                {"type": "UFix64", "value": "1.50000000"},
+                {"type": "Optional"},
+                {
+                  "type": "Dictionary",
+                  "value": [
+                    {"key": {"type": "String", "value": "z"}, "value": {"type": "Int8", "value": "-1"}},
+                    {"key": {"type": "String", "value": "y"}, "value": {"type": "Int8", "value": "2"}}
+                  ]
+                },
+                {
+                  "type": "Struct",
+                  "value": {
+                    "id": "S.test.Test",
+                    "fields": [
+                      {"name": "b", "value": {"type": "Bool", "value": false}},
+                      {"name": "a", "value": {"type": "Optional", "value": {"type": "String", "value": "x"}}}
+                    ]
+                  }
+                }
+              ]
+            }`,
+			string(encoded),
+		)
+
+		// Encoding is deterministic, dictionary entries are in insertion order.
+		// Without an interpreter, the composite type is not available,
+		// so the fields are in insertion order, too
+
+		encodedAgain, err := EncodeValueJSON(nil, value)
+		require.NoError(t, err)
+		require.Equal(t, encoded, encodedAgain)
+		require.Contains(t, string(encoded), `[{"key":{"type":"String","value":"z"}`)
+	})
+
+	t.Run("unsupported", func(t *testing.T) {
+
+		t.Parallel()
+
+		value := NewArrayValueUnownedNonCopying(
+			&EphemeralReferenceValue{
+				Value: BoolValue(true),
+			},
+		)
+
+		_, err := EncodeValueJSON(nil, value)
+		require.IsType(t, EncodingUnsupportedValueError{}, err)
+	})
+}
+
+// resetModified clears the modified flag of the given value and all its children,
+// as decoded values are not modified, but newly constructed values are
+//
+func resetModified(value Value) {
+	value.Accept(nil, EmptyVisitor{
+		StringValueVisitor: func(_ *Interpreter, value *StringValue) {
+			value.SetModified(false)
+		},
+		ArrayValueVisitor: func(_ *Interpreter, value *ArrayValue) bool {
+			value.SetModified(false)
+			return true
+		},
+		DictionaryValueVisitor: func(_ *Interpreter, value *DictionaryValue) bool {
+			value.SetModified(false)
+			value.Keys.SetModified(false)
+			return true
+		},
+		CompositeValueVisitor: func(_ *Interpreter, value *CompositeValue) bool {
+			value.SetModified(false)
+			return true
+		},
+		SomeValueVisitor: func(_ *Interpreter, value *SomeValue) bool {
+			value.SetModified(false)
+			return true
+		},
+	})
+}
+
+func TestBinaryRoundTrip(t *testing.T) {
+
+	t.Parallel()
+
+	newStruct := func() *CompositeValue {
+		fields := NewStringValueOrderedMap()
+		fields.Set("a", NewIntValueFromInt64(1))
+		fields.Set("b", NewArrayValueUnownedNonCopying(NewStringValue("test")))
+
+		return NewCompositeValue(
+			utils.TestLocation,
+			"S",
+			common.CompositeKindStructure,
+			fields,
+			nil,
+		)
+	}
+
+	values := map[string]Value{
+		"nil":     NilValue{},
+		"void":    VoidValue{},
+		"bool":    BoolValue(true),
+		"string":  NewStringValue("test"),
+		"int":     NewIntValueFromInt64(-42),
+		"int8":    Int8Value(-8),
+		"int16":   Int16Value(-16),
+		"int32":   Int32Value(-32),
+		"int64":   Int64Value(-64),
+		"int128":  NewInt128ValueFromInt64(-128),
+		"int256":  NewInt256ValueFromInt64(-256),
+		"uint":    NewUIntValueFromUint64(42),
+		"uint8":   UInt8Value(8),
+		"uint16":  UInt16Value(16),
+		"uint32":  UInt32Value(32),
+		"uint64":  UInt64Value(64),
+		"uint128": NewUInt128ValueFromUint64(128),
+		"uint256": NewUInt256ValueFromUint64(256),
+		"word8":   Word8Value(8),
+		"word16":  Word16Value(16),
+		"word32":  Word32Value(32),
+		"word64":  Word64Value(64),
+		"fix64":   Fix64Value(-123456789),
+		"ufix64":  UFix64Value(123456789),
+		"address": NewAddressValueFromBytes([]byte{0x1, 0x2}),
+		"path":    publicPathValue,
+		"capability": CapabilityValue{
+			Address: NewAddressValueFromBytes([]byte{0x2}),
+			Path:    privatePathValue,
+			BorrowType: ReferenceStaticType{
+				Authorized: true,
+				Type:       PrimitiveStaticTypeBool,
+			},
+		},
+		"link": LinkValue{
+			TargetPath: publicPathValue,
+			Type:       PrimitiveStaticTypeBool,
+		},
+		"some": NewSomeValueOwningNonCopying(NewStringValue("test")),
+		"array": NewArrayValueUnownedNonCopying(
+			NewStringValue("a"),
+			NewArrayValueUnownedNonCopying(NewIntValueFromInt64(1)),
+		),
+		"dictionary": NewDictionaryValueUnownedNonCopying(
+			NewStringValue("a"),
+			NewDictionaryValueUnownedNonCopying(
+				NewIntValueFromInt64(1), NewAddressValueFromBytes([]byte{0x1}),
+			),
+			NewStringValue("b"),
+			NewSomeValueOwningNonCopying(NilValue{}),
+		),
+		"composite": newStruct(),
+		"nested composite": NewArrayValueUnownedNonCopying(
+			newStruct(),
+			NewSomeValueOwningNonCopying(newStruct()),
+		),
+	}
+
+	for name, value := range values {
+
+		value := value
+
+		t.Run(name, func(t *testing.T) {
+
+			t.Parallel()
+
+			data, err := MarshalBinary(nil, value)
+			require.NoError(t, err)
+			require.Equal(t,
+				CurrentEncodingVersion,
+				binary.BigEndian.Uint16(data[:VersionEncodingLength]),
+			)
+
+			decoded, err := UnmarshalBinary(data)
+			require.NoError(t, err)
+
+			resetModified(value)
+			utils.AssertEqualWithDiff(t, value, decoded)
+		})
+	}
+
+	t.Run("resource", func(t *testing.T) {
+
+		t.Parallel()
+
+		resource := NewCompositeValue(
+			utils.TestLocation,
+			"R",
+			common.CompositeKindResource,
+			NewStringValueOrderedMap(),
+			nil,
+		)
+
+		for _, value := range []Value{
+			resource,
+			NewArrayValueUnownedNonCopying(resource),
+			NewSomeValueOwningNonCopying(resource),
+		} {
+			_, err := MarshalBinary(nil, value)
+			require.ErrorAs(t, err, &NonSerializableResourceError{})
+		}
+	})
+
+	t.Run("unsupported version", func(t *testing.T) {
+
+		t.Parallel()
+
+		data, err := MarshalBinary(nil, NewStringValue("test"))
+		require.NoError(t, err)
+
+		for _, version := range []uint16{0, CurrentEncodingVersion + 1} {
+
+			binary.BigEndian.PutUint16(data[:VersionEncodingLength], version)
+
+			_, err = UnmarshalBinary(data)
+			require.Equal(t,
+				UnsupportedBinaryFormatVersionError{
+					Version: version,
+				},
+				err,
+			)
+		}
+	})
+
+	t.Run("previous version", func(t *testing.T) {
+
+		t.Parallel()
+
+		// Negative bignums were encoded incorrectly in version 1,
+		// so the version in the header determines how the value is decoded
+
+		data, err := MarshalBinary(nil, NewIntValueFromInt64(-42))
+		require.NoError(t, err)
+
+		binary.BigEndian.PutUint16(data[:VersionEncodingLength], 1)
+
+		decoded, err := UnmarshalBinary(data)
+		require.NoError(t, err)
+		require.Equal(t, NewIntValueFromInt64(-41), decoded)
+	})
+
+	t.Run("truncated", func(t *testing.T) {
+
+		t.Parallel()
+
+		for _, data := range [][]byte{
+			nil,
+			{},
+			{0x0},
+			{0x0, byte(CurrentEncodingVersion)},
+		} {
+			_, err := UnmarshalBinary(data)
+			require.Equal(t,
+				TruncatedBinaryDataError{
+					Length: len(data),
+				},
+				err,
+			)
+		}
+	})
+}
+
+func randomTestString(r *rand.Rand) string {
+	runes := []rune{'a', 'z', '0', ' ', '"', 'é', '日', '🙂', 0}
+	length := r.Intn(10)
+	var builder strings.Builder
+	for i := 0; i < length; i++ {
+		builder.WriteRune(runes[r.Intn(len(runes))])
+	}
+	return builder.String()
+}
+
+func TestBinaryRoundTripRandom(t *testing.T) {
+
+	t.Parallel()
+
+	r := rand.New(rand.NewSource(42))
+
+	for i := 0; i < 1000; i++ {
+
+		integer := r.Int63()
+		if r.Intn(2) == 0 {
+			integer = -integer
+		}
+
+		str := randomTestString(r)
+
+		address := make([]byte, r.Intn(common.AddressLength+1))
+		r.Read(address)
+
+		fields := NewStringValueOrderedMap()
+		fields.Set("integer", NewIntValueFromInt64(integer))
+		fields.Set("string", NewStringValue(str))
+
+		value := NewDictionaryValueUnownedNonCopying(
+			NewStringValue(str),
+			NewArrayValueUnownedNonCopying(
+				NewIntValueFromInt64(integer),
+				Int64Value(integer),
+				UInt64Value(uint64(integer)),
+				Fix64Value(integer),
+				NewSomeValueOwningNonCopying(NewAddressValueFromBytes(address)),
+				NewCompositeValue(
+					utils.TestLocation,
+					"S",
+					common.CompositeKindStructure,
+					fields,
+					nil,
+				),
+			),
+		)
+
+		data, err := MarshalBinary(nil, value)
+		require.NoError(t, err)
+
+		decoded, err := UnmarshalBinary(data)
+		require.NoError(t, err)
+
+		resetModified(value)
+		utils.AssertEqualWithDiff(t, value, decoded)
+	}
+}
+
+func TestUnmarshalBinaryRandom(t *testing.T) {
+
+	t.Parallel()
+
+	r := rand.New(rand.NewSource(42))
+
+	valid, err := MarshalBinary(nil, NewArrayValueUnownedNonCopying(NewStringValue("test")))
+	require.NoError(t, err)
+
+	for i := 0; i < 1000; i++ {
+
+		// Arbitrary data must be rejected with an error, not a panic,
+		// and successfully decoded values must round-trip.
+		// Use both corrupted valid data and completely random data
+
+		var data []byte
+		if r.Intn(2) == 0 {
+			data = make([]byte, len(valid))
+			copy(data, valid)
+			data[VersionEncodingLength+r.Intn(len(data)-VersionEncodingLength)] = byte(r.Intn(256))
+			data = data[:1+r.Intn(len(data))]
+		} else {
+			data = make([]byte, r.Intn(20))
+			r.Read(data)
+			if len(data) >= VersionEncodingLength {
+				binary.BigEndian.PutUint16(data[:VersionEncodingLength], CurrentEncodingVersion)
+			}
+		}
+
+		value, err := UnmarshalBinary(data)
+		if err != nil {
+			continue
+		}
+
+		if ContainsResource(nil, value) {
+			continue
+		}
+
+		reencoded, err := MarshalBinary(nil, value)
+		if err != nil {
+			continue
+		}
+
+		redecoded, err := UnmarshalBinary(reencoded)
+		require.NoError(t, err)
+
+		resetModified(value)
+		utils.AssertEqualWithDiff(t, value, redecoded)
+	}
+}
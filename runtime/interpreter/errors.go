@@ -120,6 +120,148 @@ func (e NotInvokableError) Error() string {
 	return fmt.Sprintf("cannot call value: %#+v", e.Value)
 }
 
+// LoopLimitExceededError
+
+type LoopLimitExceededError struct {
+	Limit uint64
+	LocationRange
+}
+
+func (e LoopLimitExceededError) Error() string {
+	return fmt.Sprintf("loop iteration limit exceeded: %d", e.Limit)
+}
+
+// CallStackLimitExceededError
+
+type CallStackLimitExceededError struct {
+	Limit uint64
+	LocationRange
+}
+
+func (e CallStackLimitExceededError) Error() string {
+	return fmt.Sprintf("call stack limit exceeded: %d", e.Limit)
+}
+
+// RandomSourceUnavailableError is reported when a random number is requested,
+// but no random source is configured
+//
+type RandomSourceUnavailableError struct {
+	LocationRange
+}
+
+func (e RandomSourceUnavailableError) Error() string {
+	return "random source unavailable"
+}
+
+// DictionaryMergeResourceLossError is reported when a dictionary with resource values
+// is merged with a dictionary that contains a key which already exists,
+// which would result in the loss of a resource
+//
+type DictionaryMergeResourceLossError struct {
+	Key Value
+	LocationRange
+}
+
+func (e DictionaryMergeResourceLossError) Error() string {
+	return fmt.Sprintf(
+		"cannot merge dictionaries: key %s already exists, the resource would be lost",
+		e.Key,
+	)
+}
+
+// InvalidHexError is reported when a string which is expected
+// to be hexadecimal has an odd length or contains a non-hexadecimal character
+//
+type InvalidHexError struct {
+	Err error
+	LocationRange
+}
+
+func (e InvalidHexError) Error() string {
+	return fmt.Sprintf("invalid hexadecimal string: %s", e.Err)
+}
+
+func (e InvalidHexError) Unwrap() error {
+	return e.Err
+}
+
+// ValueNotPrintableError
+
+type ValueNotPrintableError struct {
+	Value Value
+}
+
+func (e ValueNotPrintableError) Error() string {
+	return fmt.Sprintf("cannot print value as literal: %s", e.Value)
+}
+
+// NonHashableValueError
+
+type NonHashableValueError struct {
+	Value Value
+}
+
+func (e NonHashableValueError) Error() string {
+	return fmt.Sprintf("cannot hash value: %s", e.Value)
+}
+
+// UnsupportedHashAlgorithmError
+
+type UnsupportedHashAlgorithmError struct {
+	Algorithm sema.HashAlgorithm
+}
+
+func (e UnsupportedHashAlgorithmError) Error() string {
+	return fmt.Sprintf("unsupported hash algorithm: %d", e.Algorithm)
+}
+
+// NonSerializableResourceError
+
+type NonSerializableResourceError struct {
+	Value Value
+}
+
+func (e NonSerializableResourceError) Error() string {
+	return fmt.Sprintf("cannot serialize resource: %s", e.Value)
+}
+
+// UnsupportedBinaryFormatVersionError
+
+type UnsupportedBinaryFormatVersionError struct {
+	Version uint16
+}
+
+func (e UnsupportedBinaryFormatVersionError) Error() string {
+	return fmt.Sprintf(
+		"unsupported binary format version: expected at most %d, got %d",
+		CurrentEncodingVersion,
+		e.Version,
+	)
+}
+
+// TruncatedBinaryDataError
+
+type TruncatedBinaryDataError struct {
+	Length int
+}
+
+func (e TruncatedBinaryDataError) Error() string {
+	return fmt.Sprintf(
+		"truncated binary data: expected format version and value, got %d bytes",
+		e.Length,
+	)
+}
+
+// ResourceComparisonError
+
+type ResourceComparisonError struct {
+	TypeID common.TypeID
+}
+
+func (e ResourceComparisonError) Error() string {
+	return fmt.Sprintf("cannot compare resource: %s", e.TypeID)
+}
+
 // ArgumentCountError
 
 type ArgumentCountError struct {
@@ -135,6 +277,36 @@ func (e ArgumentCountError) Error() string {
 	)
 }
 
+// ArgumentTypeError
+
+type ArgumentTypeError struct {
+	Index        int
+	ExpectedType sema.Type
+}
+
+func (e ArgumentTypeError) Error() string {
+	return fmt.Sprintf(
+		"incorrect type of argument %d: expected `%s`",
+		e.Index,
+		e.ExpectedType.QualifiedString(),
+	)
+}
+
+// InvocationError
+
+type InvocationError struct {
+	Err error
+	LocationRange
+}
+
+func (e InvocationError) Unwrap() error {
+	return e.Err
+}
+
+func (e InvocationError) Error() string {
+	return fmt.Sprintf("invalid invocation: %s", e.Err.Error())
+}
+
 // TransactionNotDeclaredError
 
 type TransactionNotDeclaredError struct {
@@ -218,6 +390,22 @@ func (e DestroyedCompositeError) Error() string {
 	return fmt.Sprintf("%s is destroyed and cannot be accessed anymore", e.CompositeKind.Name())
 }
 
+// ResourceFieldAssignmentError
+//
+type ResourceFieldAssignmentError struct {
+	TypeID    common.TypeID
+	FieldName string
+	LocationRange
+}
+
+func (e ResourceFieldAssignmentError) Error() string {
+	return fmt.Sprintf(
+		"cannot set field `%s` of resource `%s` outside of its defining location",
+		e.FieldName,
+		e.TypeID,
+	)
+}
+
 // ForceAssignmentToNonNilResourceError
 //
 type ForceAssignmentToNonNilResourceError struct {
@@ -336,6 +524,34 @@ func (e ArrayIndexOutOfBoundsError) Error() string {
 	)
 }
 
+// ArraySliceIndicesError
+//
+type ArraySliceIndicesError struct {
+	FromIndex int
+	UpToIndex int
+	Length    int
+	LocationRange
+}
+
+func (e ArraySliceIndicesError) Error() string {
+	return fmt.Sprintf(
+		"slice indices out of bounds: got %d..<%d, expected indices within 0..<%d",
+		e.FromIndex,
+		e.UpToIndex,
+		e.Length,
+	)
+}
+
+// ContainerMutatedDuringIterationError
+//
+type ContainerMutatedDuringIterationError struct {
+	LocationRange
+}
+
+func (e ContainerMutatedDuringIterationError) Error() string {
+	return "container was mutated during iteration"
+}
+
 // EventEmissionUnavailableError
 //
 type EventEmissionUnavailableError struct {
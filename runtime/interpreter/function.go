@@ -49,7 +49,10 @@ type FunctionValue interface {
 // InterpretedFunctionValue
 
 type InterpretedFunctionValue struct {
-	Interpreter      *Interpreter
+	Interpreter *Interpreter
+	// Name is the name of the declared function, if any.
+	// It is empty for function expressions
+	Name             string
 	ParameterList    *ast.ParameterList
 	Type             *sema.FunctionType
 	Activation       *VariableActivation
@@ -127,6 +130,75 @@ func NewHostFunctionValue(
 	}
 }
 
+// NewTypedHostFunctionValue returns a host function value
+// which validates the arguments of each invocation against the given function type,
+// before invoking the given function.
+//
+// An invocation with an incorrect number of arguments,
+// or with an argument which is not a subtype of the parameter type,
+// results in an InvocationError.
+//
+// Parameters of a function type or of a generic type are not validated,
+// as they cannot be checked dynamically
+//
+func NewTypedHostFunctionValue(
+	functionType *sema.FunctionType,
+	function HostFunction,
+) HostFunctionValue {
+	return NewHostFunctionValue(
+		func(invocation Invocation) Value {
+			validateHostFunctionInvocation(functionType, invocation)
+			return function(invocation)
+		},
+	)
+}
+
+func validateHostFunctionInvocation(functionType *sema.FunctionType, invocation Invocation) {
+	parameters := functionType.Parameters
+	parameterCount := len(parameters)
+	argumentCount := len(invocation.Arguments)
+
+	getLocationRange := invocation.GetLocationRange
+	if getLocationRange == nil {
+		getLocationRange = ReturnEmptyLocationRange
+	}
+
+	if argumentCount > parameterCount ||
+		(argumentCount < parameterCount &&
+			(functionType.RequiredArgumentCount == nil ||
+				argumentCount < *functionType.RequiredArgumentCount)) {
+
+		panic(InvocationError{
+			Err: ArgumentCountError{
+				ParameterCount: parameterCount,
+				ArgumentCount:  argumentCount,
+			},
+			LocationRange: getLocationRange(),
+		})
+	}
+
+	for i, argument := range invocation.Arguments {
+		parameterType := parameters[i].TypeAnnotation.Type
+
+		switch parameterType.(type) {
+		case *sema.FunctionType, *sema.GenericType:
+			continue
+		}
+
+		dynamicType := argument.DynamicType(invocation.Interpreter)
+
+		if !IsSubType(dynamicType, parameterType) {
+			panic(InvocationError{
+				Err: ArgumentTypeError{
+					Index:        i,
+					ExpectedType: parameterType,
+				},
+				LocationRange: getLocationRange(),
+			})
+		}
+	}
+}
+
 func (HostFunctionValue) IsValue() {}
 
 func (f HostFunctionValue) Accept(interpreter *Interpreter, visitor Visitor) {
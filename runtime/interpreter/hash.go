@@ -0,0 +1,366 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2020 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package interpreter
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/binary"
+	"hash"
+	"sort"
+
+	"golang.org/x/crypto/sha3"
+
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/sema"
+)
+
+// HashValue returns the hash of the canonical serialization of the given value,
+// using the given hash algorithm.
+//
+// The serialization is structural: two values have the same hash
+// if and only if they have the same type and the same contents.
+// Each value is serialized as a tag for its kind, followed by its contents,
+// where variable-length contents, e.g. strings and the elements of containers,
+// are prefixed with their length.
+// Composite fields are serialized in declaration order,
+// and dictionary entries are serialized in the order of their serialized keys,
+// so the hash does not depend on the insertion order.
+//
+// Resources, and values which have no content, e.g. references and functions,
+// cannot be hashed and result in an error.
+//
+func HashValue(interpreter *Interpreter, value Value, algorithm sema.HashAlgorithm) (result []byte, err error) {
+
+	var hasher hash.Hash
+	switch algorithm {
+	case sema.HashAlgorithmSHA2_256:
+		hasher = sha256.New()
+	case sema.HashAlgorithmSHA2_384:
+		hasher = sha512.New384()
+	case sema.HashAlgorithmSHA3_256:
+		hasher = sha3.New256()
+	case sema.HashAlgorithmSHA3_384:
+		hasher = sha3.New384()
+	default:
+		return nil, UnsupportedHashAlgorithmError{
+			Algorithm: algorithm,
+		}
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			valueErr, ok := r.(NonHashableValueError)
+			if !ok {
+				panic(r)
+			}
+			err = valueErr
+		}
+	}()
+
+	encoding := encodeValueForHashing(interpreter, value)
+
+	// Writing to a hash never returns an error
+	_, _ = hasher.Write(encoding)
+
+	return hasher.Sum(nil), nil
+}
+
+const (
+	valueHashTagVoid byte = iota
+	valueHashTagBool
+	valueHashTagString
+	valueHashTagNumber
+	valueHashTagAddress
+	valueHashTagPath
+	valueHashTagType
+	valueHashTagCapability
+	valueHashTagLink
+	valueHashTagNil
+	valueHashTagSome
+	valueHashTagArray
+	valueHashTagTuple
+	valueHashTagDictionary
+	valueHashTagComposite
+)
+
+// encodeValueForHashing returns the canonical serialization of the given value.
+// Panics with a NonHashableValueError if the value cannot be hashed.
+//
+func encodeValueForHashing(interpreter *Interpreter, value Value) []byte {
+
+	var buffer bytes.Buffer
+
+	writeTag := func(tag byte) {
+		buffer.WriteByte(tag)
+	}
+
+	writeLength := func(length int) {
+		var lengthBytes [8]byte
+		binary.BigEndian.PutUint64(lengthBytes[:], uint64(length))
+		buffer.Write(lengthBytes[:])
+	}
+
+	writeBytes := func(data []byte) {
+		writeLength(len(data))
+		buffer.Write(data)
+	}
+
+	writeString := func(s string) {
+		writeBytes([]byte(s))
+	}
+
+	writeStaticType := func(staticType StaticType) {
+		if staticType == nil {
+			writeString("")
+			return
+		}
+		writeString(staticType.String())
+	}
+
+	writePath := func(path PathValue) {
+		writeString(path.Domain.Identifier())
+		writeString(path.Identifier)
+	}
+
+	writeValue := func(value Value) {
+		buffer.Write(encodeValueForHashing(interpreter, value))
+	}
+
+	writeNumber := func(value NumberValue) {
+		writeTag(valueHashTagNumber)
+		dynamicType := value.DynamicType(interpreter).(NumberDynamicType)
+		writeString(dynamicType.StaticType.String())
+		writeBytes(value.ToBigEndianBytes())
+	}
+
+	nonHashable := func(value Value) {
+		panic(NonHashableValueError{
+			Value: value,
+		})
+	}
+
+	value.Accept(interpreter, EmptyVisitor{
+		ValueVisitor: func(_ *Interpreter, value Value) {
+			nonHashable(value)
+		},
+		TypeValueVisitor: func(_ *Interpreter, value TypeValue) {
+			writeTag(valueHashTagType)
+			writeStaticType(value.Type)
+		},
+		VoidValueVisitor: func(_ *Interpreter, _ VoidValue) {
+			writeTag(valueHashTagVoid)
+		},
+		BoolValueVisitor: func(_ *Interpreter, value BoolValue) {
+			writeTag(valueHashTagBool)
+			if value {
+				buffer.WriteByte(1)
+			} else {
+				buffer.WriteByte(0)
+			}
+		},
+		StringValueVisitor: func(_ *Interpreter, value *StringValue) {
+			writeTag(valueHashTagString)
+			writeString(value.Str)
+		},
+		ArrayValueVisitor: func(_ *Interpreter, value *ArrayValue) bool {
+			writeTag(valueHashTagArray)
+			writeLength(len(value.Values))
+			for _, element := range value.Values {
+				writeValue(element)
+			}
+			return false
+		},
+		TupleValueVisitor: func(_ *Interpreter, value *TupleValue) bool {
+			writeTag(valueHashTagTuple)
+			writeLength(len(value.Elements))
+			for _, element := range value.Elements {
+				writeValue(element)
+			}
+			return false
+		},
+		IntValueVisitor: func(_ *Interpreter, value IntValue) {
+			writeNumber(value)
+		},
+		Int8ValueVisitor: func(_ *Interpreter, value Int8Value) {
+			writeNumber(value)
+		},
+		Int16ValueVisitor: func(_ *Interpreter, value Int16Value) {
+			writeNumber(value)
+		},
+		Int32ValueVisitor: func(_ *Interpreter, value Int32Value) {
+			writeNumber(value)
+		},
+		Int64ValueVisitor: func(_ *Interpreter, value Int64Value) {
+			writeNumber(value)
+		},
+		Int128ValueVisitor: func(_ *Interpreter, value Int128Value) {
+			writeNumber(value)
+		},
+		Int256ValueVisitor: func(_ *Interpreter, value Int256Value) {
+			writeNumber(value)
+		},
+		UIntValueVisitor: func(_ *Interpreter, value UIntValue) {
+			writeNumber(value)
+		},
+		UInt8ValueVisitor: func(_ *Interpreter, value UInt8Value) {
+			writeNumber(value)
+		},
+		UInt16ValueVisitor: func(_ *Interpreter, value UInt16Value) {
+			writeNumber(value)
+		},
+		UInt32ValueVisitor: func(_ *Interpreter, value UInt32Value) {
+			writeNumber(value)
+		},
+		UInt64ValueVisitor: func(_ *Interpreter, value UInt64Value) {
+			writeNumber(value)
+		},
+		UInt128ValueVisitor: func(_ *Interpreter, value UInt128Value) {
+			writeNumber(value)
+		},
+		UInt256ValueVisitor: func(_ *Interpreter, value UInt256Value) {
+			writeNumber(value)
+		},
+		Word8ValueVisitor: func(_ *Interpreter, value Word8Value) {
+			writeNumber(value)
+		},
+		Word16ValueVisitor: func(_ *Interpreter, value Word16Value) {
+			writeNumber(value)
+		},
+		Word32ValueVisitor: func(_ *Interpreter, value Word32Value) {
+			writeNumber(value)
+		},
+		Word64ValueVisitor: func(_ *Interpreter, value Word64Value) {
+			writeNumber(value)
+		},
+		Fix64ValueVisitor: func(_ *Interpreter, value Fix64Value) {
+			writeNumber(value)
+		},
+		UFix64ValueVisitor: func(_ *Interpreter, value UFix64Value) {
+			writeNumber(value)
+		},
+		CompositeValueVisitor: func(_ *Interpreter, value *CompositeValue) bool {
+			if value.Kind == common.CompositeKindResource {
+				nonHashable(value)
+			}
+
+			writeTag(valueHashTagComposite)
+			writeString(string(value.TypeID()))
+
+			names := value.FieldNamesInOrder(interpreter)
+			writeLength(len(names))
+			for _, name := range names {
+				fieldValue, _ := value.Fields.Get(name)
+				writeString(name)
+				writeValue(fieldValue)
+			}
+			return false
+		},
+		DictionaryValueVisitor: func(_ *Interpreter, value *DictionaryValue) bool {
+			writeTag(valueHashTagDictionary)
+
+			type entry struct {
+				key   []byte
+				value []byte
+			}
+
+			entries := make([]entry, len(value.Keys.Values))
+			for i, key := range value.Keys.Values {
+
+				// NOTE: the entry is potentially deferred, so get it through the dictionary.
+				// Force unwrap, this is safe because we are iterating over the keys.
+
+				entryValue := value.Get(interpreter, ReturnEmptyLocationRange, key).(*SomeValue).Value
+
+				entries[i] = entry{
+					key:   encodeValueForHashing(interpreter, key),
+					value: encodeValueForHashing(interpreter, entryValue),
+				}
+			}
+
+			sort.Slice(entries, func(i, j int) bool {
+				return bytes.Compare(entries[i].key, entries[j].key) < 0
+			})
+
+			writeLength(len(entries))
+			for _, entry := range entries {
+				buffer.Write(entry.key)
+				buffer.Write(entry.value)
+			}
+			return false
+		},
+		NilValueVisitor: func(_ *Interpreter, _ NilValue) {
+			writeTag(valueHashTagNil)
+		},
+		SomeValueVisitor: func(_ *Interpreter, value *SomeValue) bool {
+			writeTag(valueHashTagSome)
+			writeValue(value.Value)
+			return false
+		},
+		StorageReferenceValueVisitor: func(_ *Interpreter, value *StorageReferenceValue) {
+			nonHashable(value)
+		},
+		EphemeralReferenceValueVisitor: func(_ *Interpreter, value *EphemeralReferenceValue) {
+			nonHashable(value)
+		},
+		AddressValueVisitor: func(_ *Interpreter, value AddressValue) {
+			writeTag(valueHashTagAddress)
+			buffer.Write(value[:])
+		},
+		AuthAccountValueVisitor: func(_ *Interpreter, value AuthAccountValue) {
+			nonHashable(value)
+		},
+		PublicAccountValueVisitor: func(_ *Interpreter, value PublicAccountValue) {
+			nonHashable(value)
+		},
+		PathValueVisitor: func(_ *Interpreter, value PathValue) {
+			writeTag(valueHashTagPath)
+			writePath(value)
+		},
+		CapabilityValueVisitor: func(_ *Interpreter, value CapabilityValue) {
+			writeTag(valueHashTagCapability)
+			buffer.Write(value.Address[:])
+			writePath(value.Path)
+			writeStaticType(value.BorrowType)
+		},
+		LinkValueVisitor: func(_ *Interpreter, value LinkValue) {
+			writeTag(valueHashTagLink)
+			writePath(value.TargetPath)
+			writeStaticType(value.Type)
+		},
+		InterpretedFunctionValueVisitor: func(_ *Interpreter, value InterpretedFunctionValue) {
+			nonHashable(value)
+		},
+		HostFunctionValueVisitor: func(_ *Interpreter, value HostFunctionValue) {
+			nonHashable(value)
+		},
+		BoundFunctionValueVisitor: func(_ *Interpreter, value BoundFunctionValue) {
+			nonHashable(value)
+		},
+		AuthAccountContractsValueVisitor: func(_ *Interpreter, value AuthAccountContractsValue) {
+			nonHashable(value)
+		},
+		DeployedContractValueVisitor: func(_ *Interpreter, value DeployedContractValue) {
+			nonHashable(value)
+		},
+	})
+
+	return buffer.Bytes()
+}
@@ -0,0 +1,224 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2020 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package interpreter
+
+import (
+	"crypto/sha256"
+	"sync"
+
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/common/orderedmap"
+)
+
+// ImportCache is a cache of decoded imported contract values,
+// which can be shared by the interpreters of many invocations,
+// e.g. of many transactions, to avoid decoding the same stored value repeatedly.
+//
+// Entries are keyed by the location of the contract
+// and the hash of the encoded, stored contract value.
+//
+// The cache never shares values: it stores a deep copy of the given value,
+// and returns a new deep copy of the stored value on each lookup,
+// so values, in particular resources, which are mutated or moved in one invocation,
+// are never observed by another invocation.
+//
+// Invalidation contract:
+//
+// As entries are keyed by the hash of the stored data, a lookup never returns a stale value:
+// When a contract value is updated or removed, its stored data changes,
+// and lookups with the new data miss.
+// However, entries for outdated data are not removed automatically.
+// Embedders should call Invalidate for the location of a contract
+// after the contract is updated or removed, or Clear, to release the memory of outdated entries.
+//
+// The cache is safe for concurrent use.
+//
+type ImportCache struct {
+	lock    sync.RWMutex
+	entries map[importCacheKey]Value
+}
+
+type importCacheKey struct {
+	locationID common.LocationID
+	dataHash   [sha256.Size]byte
+}
+
+func NewImportCache() *ImportCache {
+	return &ImportCache{
+		entries: map[importCacheKey]Value{},
+	}
+}
+
+func newImportCacheKey(location common.Location, data []byte) importCacheKey {
+	return importCacheKey{
+		locationID: location.ID(),
+		dataHash:   sha256.Sum256(data),
+	}
+}
+
+// Get returns a deep copy of the value cached for the given location and stored data, if any.
+//
+func (c *ImportCache) Get(location common.Location, data []byte) (Value, bool) {
+	key := newImportCacheKey(location, data)
+
+	c.lock.RLock()
+	value, ok := c.entries[key]
+	c.lock.RUnlock()
+
+	if !ok {
+		return nil, false
+	}
+
+	return deepCopyValue(value), true
+}
+
+// Set caches a deep copy of the given value, decoded from the given stored data,
+// for the given location.
+//
+func (c *ImportCache) Set(location common.Location, data []byte, value Value) {
+	key := newImportCacheKey(location, data)
+
+	valueCopy := deepCopyValue(value)
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.entries[key] = valueCopy
+}
+
+// Invalidate removes all entries for the given location.
+//
+func (c *ImportCache) Invalidate(location common.Location) {
+	locationID := location.ID()
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	for key := range c.entries {
+		if key.locationID == locationID {
+			delete(c.entries, key)
+		}
+	}
+}
+
+// Clear removes all entries.
+//
+func (c *ImportCache) Clear() {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.entries = map[importCacheKey]Value{}
+}
+
+// Len returns the number of entries.
+//
+func (c *ImportCache) Len() int {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	return len(c.entries)
+}
+
+// deepCopyValue returns a deep copy of the given stored value.
+//
+// Unlike Copy, which does not copy resources and contracts, as they are moved, not copied,
+// all containers are copied, and the owner and the modification status of all values are retained,
+// so a copy of an unmodified stored value is unmodified, too.
+//
+func deepCopyValue(value Value) Value {
+	switch value := value.(type) {
+	case *CompositeValue:
+		fields := NewStringValueOrderedMap()
+		value.Fields.Foreach(func(name string, field Value) {
+			fields.Set(name, deepCopyValue(field))
+		})
+
+		return &CompositeValue{
+			Location:            value.Location,
+			QualifiedIdentifier: value.QualifiedIdentifier,
+			Kind:                value.Kind,
+			Fields:              fields,
+			InjectedFields:      value.InjectedFields,
+			ComputedFields:      value.ComputedFields,
+			NestedVariables:     value.NestedVariables,
+			Functions:           value.Functions,
+			Destructor:          value.Destructor,
+			Owner:               value.Owner,
+			destroyed:           value.destroyed,
+			modified:            value.modified,
+		}
+
+	case *ArrayValue:
+		values := make([]Value, len(value.Values))
+		for i, element := range value.Values {
+			values[i] = deepCopyValue(element)
+		}
+
+		return &ArrayValue{
+			Values:   values,
+			Owner:    value.Owner,
+			modified: value.modified,
+		}
+
+	case *DictionaryValue:
+		entries := NewStringValueOrderedMap()
+		value.Entries.Foreach(func(key string, entry Value) {
+			entries.Set(key, deepCopyValue(entry))
+		})
+
+		return &DictionaryValue{
+			Keys:                   deepCopyValue(value.Keys).(*ArrayValue),
+			Entries:                entries,
+			Owner:                  value.Owner,
+			modified:               value.modified,
+			DeferredOwner:          value.DeferredOwner,
+			DeferredKeys:           copyStringStructOrderedMap(value.DeferredKeys),
+			DeferredStorageKeyBase: value.DeferredStorageKeyBase,
+			prevDeferredKeys:       copyStringStructOrderedMap(value.prevDeferredKeys),
+		}
+
+	case *SomeValue:
+		return &SomeValue{
+			Value: deepCopyValue(value.Value),
+			Owner: value.Owner,
+		}
+
+	case *StringValue:
+		return &StringValue{
+			Str:      value.Str,
+			modified: value.modified,
+		}
+
+	default:
+		// All other storable values are immutable
+		return value.Copy()
+	}
+}
+
+func copyStringStructOrderedMap(m *orderedmap.StringStructOrderedMap) *orderedmap.StringStructOrderedMap {
+	if m == nil {
+		return nil
+	}
+
+	result := orderedmap.NewStringStructOrderedMap()
+	m.Foreach(func(key string, value struct{}) {
+		result.Set(key, value)
+	})
+	return result
+}
@@ -21,6 +21,7 @@ package interpreter
 import (
 	"fmt"
 	goRuntime "runtime"
+	"strings"
 
 	"github.com/onflow/cadence/runtime/ast"
 	"github.com/onflow/cadence/runtime/common"
@@ -32,11 +33,21 @@ type controlReturn interface {
 	isControlReturn()
 }
 
-type controlBreak struct{}
+// controlBreak is the result of a `break` statement.
+// The label is empty if the statement exits the innermost loop or switch statement
+//
+type controlBreak struct {
+	Label string
+}
 
 func (controlBreak) isControlReturn() {}
 
-type controlContinue struct{}
+// controlContinue is the result of a `continue` statement.
+// The label is empty if the statement continues the innermost loop
+//
+type controlContinue struct {
+	Label string
+}
 
 func (controlContinue) isControlReturn() {}
 
@@ -70,6 +81,10 @@ type getterSetter struct {
 
 // OnEventEmittedFunc is a function that is triggered when an event is emitted by the program.
 //
+// It receives the fully constructed event value and its type,
+// which allows hosts, e.g. test harnesses or indexers, to observe emitted events directly.
+// If no handler is set, emitting an event fails with an EventEmissionUnavailableError.
+//
 type OnEventEmittedFunc func(
 	inter *Interpreter,
 	event *CompositeValue,
@@ -97,6 +112,35 @@ type OnFunctionInvocationFunc func(
 	line int,
 )
 
+// OnFunctionEntryFunc is a function that is triggered when an interpreted function
+// is invoked, before its body is executed.
+//
+type OnFunctionEntryFunc func(
+	inter *Interpreter,
+	name string,
+	arguments []Value,
+)
+
+// OnFunctionReturnFunc is a function that is triggered when an interpreted function returns.
+//
+type OnFunctionReturnFunc func(
+	inter *Interpreter,
+	name string,
+	result Value,
+)
+
+// OnResourceDestroyedFunc is a function that is triggered when a resource is destroyed,
+// after its destructor was executed, but before the resource is invalidated.
+//
+// Nested resources destroyed by the destructor of a resource
+// are reported before the resource itself.
+//
+type OnResourceDestroyedFunc func(
+	inter *Interpreter,
+	value *CompositeValue,
+	pos ast.Position,
+)
+
 // StorageExistenceHandlerFunc is a function that handles storage existence checks.
 //
 type StorageExistenceHandlerFunc func(
@@ -131,6 +175,14 @@ type StorageKeyHandlerFunc func(
 	indexingType sema.Type,
 ) string
 
+// StoredKeysHandlerFunc is a function that handles the enumeration of storage keys.
+// It returns the keys of all values stored in the account with the given address.
+//
+type StoredKeysHandlerFunc func(
+	inter *Interpreter,
+	storageAddress common.Address,
+) []string
+
 // InjectedCompositeFieldsHandlerFunc is a function that handles storage reads.
 //
 type InjectedCompositeFieldsHandlerFunc func(
@@ -159,6 +211,11 @@ type ImportLocationHandlerFunc func(
 // UUIDHandlerFunc is a function that handles the generation of UUIDs.
 type UUIDHandlerFunc func() (uint64, error)
 
+// RandomSourceFunc is a function that returns a random number.
+// It is not required to be cryptographically secure.
+//
+type RandomSourceFunc func() uint64
+
 // CompositeTypeCode contains the the "prepared" / "callable" "code"
 // for the functions and the destructor of a composite
 // (contract, struct, resource, event).
@@ -226,16 +283,37 @@ type Interpreter struct {
 	onStatement                    OnStatementFunc
 	onLoopIteration                OnLoopIterationFunc
 	onFunctionInvocation           OnFunctionInvocationFunc
+	onFunctionEntry                OnFunctionEntryFunc
+	onFunctionReturn               OnFunctionReturnFunc
+	onResourceDestroyed            OnResourceDestroyedFunc
+	debugHandler                   DebugHandlerFunc
+	resume                         chan struct{}
 	storageExistenceHandler        StorageExistenceHandlerFunc
 	storageReadHandler             StorageReadHandlerFunc
 	storageWriteHandler            StorageWriteHandlerFunc
 	storageKeyHandler              StorageKeyHandlerFunc
+	storedKeysHandler              StoredKeysHandlerFunc
 	injectedCompositeFieldsHandler InjectedCompositeFieldsHandlerFunc
 	contractValueHandler           ContractValueHandlerFunc
 	importLocationHandler          ImportLocationHandlerFunc
 	uuidHandler                    UUIDHandlerFunc
+	randomSource                   RandomSourceFunc
 	interpreted                    bool
 	statement                      ast.Statement
+	maxLoopIterations              uint64
+	loopIterations                 *uint64
+	maxCallDepth                   uint64
+	callDepth                      *uint64
+	verificationStats              map[sema.SignatureAlgorithm]uint64
+	conditionStats                 *ConditionStats
+	computationWeights             map[ComputationKind]uint64
+	computationUsed                *uint64
+	dryRun                         bool
+	effectsLog                     *EffectsLog
+	execution                      *execution
+	integerOverflowMode            IntegerOverflowMode
+	internedStrings                map[string]*StringValue
+	importCache                    *ImportCache
 }
 
 type Option func(*Interpreter) error
@@ -280,6 +358,59 @@ func WithOnFunctionInvocationHandler(handler OnFunctionInvocationFunc) Option {
 	}
 }
 
+// WithOnFunctionEntryHandler returns an interpreter option which sets
+// the given function as the function entry handler.
+//
+func WithOnFunctionEntryHandler(handler OnFunctionEntryFunc) Option {
+	return func(interpreter *Interpreter) error {
+		interpreter.SetOnFunctionEntryHandler(handler)
+		return nil
+	}
+}
+
+// WithOnFunctionReturnHandler returns an interpreter option which sets
+// the given function as the function return handler.
+//
+func WithOnFunctionReturnHandler(handler OnFunctionReturnFunc) Option {
+	return func(interpreter *Interpreter) error {
+		interpreter.SetOnFunctionReturnHandler(handler)
+		return nil
+	}
+}
+
+// WithOnResourceDestroyedHandler returns an interpreter option which sets
+// the given function as the resource destruction handler.
+//
+func WithOnResourceDestroyedHandler(handler OnResourceDestroyedFunc) Option {
+	return func(interpreter *Interpreter) error {
+		interpreter.SetOnResourceDestroyedHandler(handler)
+		return nil
+	}
+}
+
+// WithDebugHandler returns an interpreter option which sets
+// the given function as the debug handler.
+//
+func WithDebugHandler(handler DebugHandlerFunc) Option {
+	return func(interpreter *Interpreter) error {
+		interpreter.SetDebugHandler(handler)
+		return nil
+	}
+}
+
+// WithIntegerOverflowMode returns an interpreter option which sets
+// the behaviour of arithmetic operations on fixed-width integers when the result is out of range.
+//
+// NOTE: Changing the mode changes the execution semantics of programs,
+// and programs are type-checked assuming the default mode, where overflow aborts the program.
+//
+func WithIntegerOverflowMode(mode IntegerOverflowMode) Option {
+	return func(interpreter *Interpreter) error {
+		interpreter.SetIntegerOverflowMode(mode)
+		return nil
+	}
+}
+
 // WithPredeclaredValues returns an interpreter option which declares
 // the given the predeclared values.
 //
@@ -341,6 +472,16 @@ func WithStorageKeyHandler(handler StorageKeyHandlerFunc) Option {
 	}
 }
 
+// WithStoredKeysHandler returns an interpreter option which sets the given function
+// as the function that is used when the keys of an account's storage are enumerated.
+//
+func WithStoredKeysHandler(handler StoredKeysHandlerFunc) Option {
+	return func(interpreter *Interpreter) error {
+		interpreter.SetStoredKeysHandler(handler)
+		return nil
+	}
+}
+
 // WithInjectedCompositeFieldsHandler returns an interpreter option which sets the given function
 // as the function that is used to initialize new composite values' fields
 //
@@ -381,6 +522,16 @@ func WithUUIDHandler(handler UUIDHandlerFunc) Option {
 	}
 }
 
+// WithRandomSource returns an interpreter option which sets the given function
+// as the source of random numbers for `unsafeRandom`.
+//
+func WithRandomSource(source RandomSourceFunc) Option {
+	return func(interpreter *Interpreter) error {
+		interpreter.SetRandomSource(source)
+		return nil
+	}
+}
+
 // WithAllInterpreters returns an interpreter option which sets
 // the given map of interpreters as the map of all interpreters.
 //
@@ -391,6 +542,163 @@ func WithAllInterpreters(allInterpreters map[common.LocationID]*Interpreter) Opt
 	}
 }
 
+// WithMaxLoopIterations returns an interpreter option which sets
+// the maximum number of loop iterations of a top-level execution.
+// Zero means no limit.
+//
+func WithMaxLoopIterations(maxLoopIterations uint64) Option {
+	return func(interpreter *Interpreter) error {
+		interpreter.SetMaxLoopIterations(maxLoopIterations)
+		return nil
+	}
+}
+
+// WithMaxCallDepth returns an interpreter option which sets
+// the maximum depth of nested invocations of interpreted functions.
+// Zero means no limit.
+//
+func WithMaxCallDepth(maxCallDepth uint64) Option {
+	return func(interpreter *Interpreter) error {
+		interpreter.SetMaxCallDepth(maxCallDepth)
+		return nil
+	}
+}
+
+// withCallDepth returns an interpreter option which sets
+// the counter of nested invocations.
+//
+func withCallDepth(callDepth *uint64) Option {
+	return func(interpreter *Interpreter) error {
+		interpreter.callDepth = callDepth
+		return nil
+	}
+}
+
+// withExecution returns an interpreter option which sets
+// the state of the top-level execution.
+//
+func withExecution(execution *execution) Option {
+	return func(interpreter *Interpreter) error {
+		interpreter.execution = execution
+		return nil
+	}
+}
+
+// withLoopIterations returns an interpreter option which sets
+// the counter of loop iterations.
+//
+func withLoopIterations(loopIterations *uint64) Option {
+	return func(interpreter *Interpreter) error {
+		interpreter.loopIterations = loopIterations
+		return nil
+	}
+}
+
+// WithComputationWeights returns an interpreter option which sets
+// the weights of the metered kinds of operations.
+// Kinds of operations without a weight have a weight of 1.
+//
+func WithComputationWeights(weights map[ComputationKind]uint64) Option {
+	return func(interpreter *Interpreter) error {
+		interpreter.SetComputationWeights(weights)
+		return nil
+	}
+}
+
+// withComputationUsed returns an interpreter option which sets
+// the counter of used computation.
+//
+func withComputationUsed(computationUsed *uint64) Option {
+	return func(interpreter *Interpreter) error {
+		interpreter.computationUsed = computationUsed
+		return nil
+	}
+}
+
+// WithDryRun returns an interpreter option which enables or disables dry-run mode.
+//
+// In dry-run mode, programs are evaluated and metered as usual,
+// but writes to account storage are not performed.
+// Instead, the intended writes are recorded in the effects log.
+//
+func WithDryRun(enabled bool) Option {
+	return func(interpreter *Interpreter) error {
+		interpreter.SetDryRun(enabled)
+		return nil
+	}
+}
+
+// withEffectsLog returns an interpreter option which sets
+// the log of storage effects recorded in dry-run mode.
+//
+func withEffectsLog(effectsLog *EffectsLog) Option {
+	return func(interpreter *Interpreter) error {
+		interpreter.effectsLog = effectsLog
+		return nil
+	}
+}
+
+// withVerificationStats returns an interpreter option which sets
+// the counters of signature verifications.
+//
+func withVerificationStats(verificationStats map[sema.SignatureAlgorithm]uint64) Option {
+	return func(interpreter *Interpreter) error {
+		interpreter.verificationStats = verificationStats
+		return nil
+	}
+}
+
+// withConditionStats returns an interpreter option which sets
+// the counters of evaluated conditions.
+//
+func withConditionStats(conditionStats *ConditionStats) Option {
+	return func(interpreter *Interpreter) error {
+		interpreter.conditionStats = conditionStats
+		return nil
+	}
+}
+
+// WithStringsInterned returns an interpreter option which enables or disables
+// the interning of string values.
+//
+// If enabled, string values created for string literals are deduplicated
+// through a pool keyed by their content, i.e. evaluating the same literal repeatedly
+// returns a shared instance instead of allocating a new string value.
+//
+func WithStringsInterned(enabled bool) Option {
+	return func(interpreter *Interpreter) error {
+		if !enabled {
+			interpreter.internedStrings = nil
+		} else if interpreter.internedStrings == nil {
+			interpreter.internedStrings = map[string]*StringValue{}
+		}
+		return nil
+	}
+}
+
+// withInternedStrings returns an interpreter option which sets
+// the pool of interned string values.
+//
+func withInternedStrings(internedStrings map[string]*StringValue) Option {
+	return func(interpreter *Interpreter) error {
+		interpreter.internedStrings = internedStrings
+		return nil
+	}
+}
+
+// WithImportCache returns an interpreter option which sets
+// the cache of decoded imported contract values.
+//
+// The cache may be shared by the interpreters of many invocations.
+// If nil, the default, imported contract values are not cached.
+//
+func WithImportCache(cache *ImportCache) Option {
+	return func(interpreter *Interpreter) error {
+		interpreter.importCache = cache
+		return nil
+	}
+}
+
 // withTypeCodes returns an interpreter option which sets the type codes.
 //
 func withTypeCodes(typeCodes TypeCodes) Option {
@@ -417,6 +725,13 @@ func NewInterpreter(program *Program, location common.Location, options ...Optio
 			InterfaceCodes:       map[sema.TypeID]WrapperCode{},
 			TypeRequirementCodes: map[sema.TypeID]WrapperCode{},
 		}),
+		withLoopIterations(new(uint64)),
+		withCallDepth(new(uint64)),
+		withVerificationStats(map[sema.SignatureAlgorithm]uint64{}),
+		withConditionStats(&ConditionStats{}),
+		withComputationUsed(new(uint64)),
+		withEffectsLog(&EffectsLog{}),
+		withExecution(&execution{}),
 	}
 
 	interpreter.defineBaseFunctions()
@@ -455,6 +770,53 @@ func (interpreter *Interpreter) SetOnFunctionInvocationHandler(function OnFuncti
 	interpreter.onFunctionInvocation = function
 }
 
+// SetOnFunctionEntryHandler sets the function that is triggered when an interpreted function is invoked.
+//
+func (interpreter *Interpreter) SetOnFunctionEntryHandler(function OnFunctionEntryFunc) {
+	interpreter.onFunctionEntry = function
+}
+
+// SetOnFunctionReturnHandler sets the function that is triggered when an interpreted function returns.
+//
+func (interpreter *Interpreter) SetOnFunctionReturnHandler(function OnFunctionReturnFunc) {
+	interpreter.onFunctionReturn = function
+}
+
+// SetOnResourceDestroyedHandler sets the function that is triggered when a resource is destroyed.
+//
+func (interpreter *Interpreter) SetOnResourceDestroyedHandler(function OnResourceDestroyedFunc) {
+	interpreter.onResourceDestroyed = function
+}
+
+// SetDebugHandler sets the function that is triggered when a statement is about to be executed,
+// and which decides if the interpreter should pause before executing it.
+//
+func (interpreter *Interpreter) SetDebugHandler(function DebugHandlerFunc) {
+	interpreter.debugHandler = function
+	if function != nil && interpreter.resume == nil {
+		interpreter.resume = make(chan struct{})
+	}
+}
+
+// SetComputationWeights sets the weights of the metered kinds of operations.
+//
+func (interpreter *Interpreter) SetComputationWeights(weights map[ComputationKind]uint64) {
+	interpreter.computationWeights = weights
+}
+
+// SetDryRun enables or disables dry-run mode.
+//
+func (interpreter *Interpreter) SetDryRun(enabled bool) {
+	interpreter.dryRun = enabled
+}
+
+// SetIntegerOverflowMode sets the behaviour of arithmetic operations on fixed-width integers
+// when the result is out of range.
+//
+func (interpreter *Interpreter) SetIntegerOverflowMode(mode IntegerOverflowMode) {
+	interpreter.integerOverflowMode = mode
+}
+
 // SetStorageExistenceHandler sets the function that is used when a storage key is checked for existence.
 //
 func (interpreter *Interpreter) SetStorageExistenceHandler(function StorageExistenceHandlerFunc) {
@@ -479,6 +841,12 @@ func (interpreter *Interpreter) SetStorageKeyHandler(function StorageKeyHandlerF
 	interpreter.storageKeyHandler = function
 }
 
+// SetStoredKeysHandler sets the function that is used when the keys of an account's storage are enumerated.
+//
+func (interpreter *Interpreter) SetStoredKeysHandler(function StoredKeysHandlerFunc) {
+	interpreter.storedKeysHandler = function
+}
+
 // SetInjectedCompositeFieldsHandler sets the function that is used to initialize
 // new composite values' fields
 //
@@ -504,6 +872,27 @@ func (interpreter *Interpreter) SetUUIDHandler(function UUIDHandlerFunc) {
 	interpreter.uuidHandler = function
 }
 
+// SetRandomSource sets the function that is used as the source of random numbers.
+//
+func (interpreter *Interpreter) SetRandomSource(source RandomSourceFunc) {
+	interpreter.randomSource = source
+}
+
+// UnsafeRandom returns a random number from the random source.
+//
+// The random number is not cryptographically secure.
+// If no random source is configured, the function panics with a RandomSourceUnavailableError.
+//
+func (interpreter *Interpreter) UnsafeRandom(getLocationRange func() LocationRange) UInt64Value {
+	if interpreter.randomSource == nil {
+		panic(RandomSourceUnavailableError{
+			LocationRange: getLocationRange(),
+		})
+	}
+
+	return UInt64Value(interpreter.randomSource())
+}
+
 // SetAllInterpreters sets the given map of interpreters as the map of all interpreters.
 //
 func (interpreter *Interpreter) SetAllInterpreters(allInterpreters map[common.LocationID]*Interpreter) {
@@ -513,6 +902,20 @@ func (interpreter *Interpreter) SetAllInterpreters(allInterpreters map[common.Lo
 	interpreter.allInterpreters[interpreter.Location.ID()] = interpreter
 }
 
+// SetMaxLoopIterations sets the maximum number of loop iterations of a top-level execution.
+// Zero means no limit.
+//
+func (interpreter *Interpreter) SetMaxLoopIterations(maxLoopIterations uint64) {
+	interpreter.maxLoopIterations = maxLoopIterations
+}
+
+// SetMaxCallDepth sets the maximum depth of nested invocations of interpreted functions.
+// Zero means no limit.
+//
+func (interpreter *Interpreter) SetMaxCallDepth(maxCallDepth uint64) {
+	interpreter.maxCallDepth = maxCallDepth
+}
+
 // setTypeCodes sets the type codes.
 //
 func (interpreter *Interpreter) setTypeCodes(typeCodes TypeCodes) {
@@ -557,13 +960,54 @@ func (interpreter *Interpreter) Interpret() (err error) {
 		err = internalErr
 	})
 
+	interpreter.startExecution()
+	defer interpreter.endExecution()
+
 	interpreter.Program.Program.Accept(interpreter)
 
 	interpreter.interpreted = true
 
+	// The global declarations of the program are evaluated before e.g. a function of the program is invoked,
+	// so the following top-level execution continues this one
+
+	interpreter.continueExecution()
+
 	return nil
 }
 
+// Execute interprets the given checked element, e.g. a statement or a program,
+// and returns the result. For an expression statement the result is an ExpressionStatementResult.
+//
+// Execute allows interpreting code incrementally, e.g. in a REPL.
+//
+func (interpreter *Interpreter) Execute(element ast.Element) (result ast.Repr, err error) {
+
+	// recover internal panics and return them as an error
+	defer interpreter.recoverErrors(func(internalErr error) {
+		err = internalErr
+	})
+
+	interpreter.startExecution()
+	defer interpreter.endExecution()
+
+	return element.Accept(interpreter), nil
+}
+
+// EnterActivation enters a new activation, which is nested in the current activation.
+// All variables which are declared afterwards are declared in the new activation,
+// until the activation is left again using LeaveActivation.
+//
+func (interpreter *Interpreter) EnterActivation() {
+	interpreter.activations.PushNewWithCurrent()
+}
+
+// LeaveActivation leaves the current activation,
+// i.e. it discards all variables declared in the activation.
+//
+func (interpreter *Interpreter) LeaveActivation() {
+	interpreter.activations.Pop()
+}
+
 func (interpreter *Interpreter) prepareInterpretation() {
 	program := interpreter.Program.Program
 
@@ -736,6 +1180,9 @@ func (interpreter *Interpreter) Invoke(functionName string, arguments ...Value)
 		err = internalErr
 	})
 
+	interpreter.startExecution()
+	defer interpreter.endExecution()
+
 	return interpreter.invokeVariable(functionName, arguments)
 }
 
@@ -746,6 +1193,9 @@ func (interpreter *Interpreter) InvokeTransaction(index int, arguments ...Value)
 		err = internalErr
 	})
 
+	interpreter.startExecution()
+	defer interpreter.endExecution()
+
 	_, err = interpreter.prepareInvokeTransaction(index, arguments)
 	return err
 }
@@ -844,6 +1294,7 @@ func (interpreter *Interpreter) functionDeclarationValue(
 
 	return InterpretedFunctionValue{
 		Interpreter:      interpreter,
+		Name:             declaration.Identifier.Identifier,
 		ParameterList:    declaration.ParameterList,
 		Type:             functionType,
 		Activation:       lexicalScope,
@@ -943,10 +1394,19 @@ func (interpreter *Interpreter) visitCondition(condition *ast.Condition) {
 
 	value := result.Value.(BoolValue)
 
+	switch condition.Kind {
+	case ast.ConditionKindPre:
+		interpreter.conditionStats.PreChecked++
+	case ast.ConditionKindPost:
+		interpreter.conditionStats.PostChecked++
+	}
+
 	if value {
 		return
 	}
 
+	interpreter.conditionStats.Failed++
+
 	var message string
 	if condition.Message != nil {
 		messageValue := interpreter.evalExpression(condition.Message)
@@ -1425,6 +1885,7 @@ func (interpreter *Interpreter) compositeInitializerFunction(
 
 	return &InterpretedFunctionValue{
 		Interpreter:      interpreter,
+		Name:             initializer.FunctionDeclaration.Identifier.Identifier,
 		ParameterList:    parameterList,
 		Type:             functionType,
 		Activation:       lexicalScope,
@@ -1468,6 +1929,7 @@ func (interpreter *Interpreter) compositeDestructorFunction(
 
 	return &InterpretedFunctionValue{
 		Interpreter:      interpreter,
+		Name:             destructor.FunctionDeclaration.Identifier.Identifier,
 		Type:             emptyFunctionType,
 		Activation:       lexicalScope,
 		BeforeStatements: beforeStatements,
@@ -1552,6 +2014,7 @@ func (interpreter *Interpreter) compositeFunction(
 
 	return InterpretedFunctionValue{
 		Interpreter:      interpreter,
+		Name:             functionDeclaration.Identifier.Identifier,
 		ParameterList:    parameterList,
 		Type:             functionType,
 		Activation:       lexicalScope,
@@ -1692,6 +2155,11 @@ func (interpreter *Interpreter) convert(value Value, valueType, targetType sema.
 			return ConvertWord64(value)
 		}
 
+	case *sema.TupleType:
+		if !valueType.Equal(unwrappedTargetType) {
+			return interpreter.convertTuple(value, valueType, unwrappedTargetType.(*sema.TupleType))
+		}
+
 	// Fix*
 
 	case *sema.Fix64Type:
@@ -1708,6 +2176,34 @@ func (interpreter *Interpreter) convert(value Value, valueType, targetType sema.
 	return value
 }
 
+// convertTuple converts and boxes each element of a tuple value
+// to the corresponding element type of the target tuple type
+//
+func (interpreter *Interpreter) convertTuple(value Value, valueType sema.Type, targetType *sema.TupleType) Value {
+	tupleValue, ok := value.(*TupleValue)
+	if !ok {
+		return value
+	}
+
+	tupleValueType, ok := valueType.(*sema.TupleType)
+	if !ok ||
+		len(tupleValueType.ElementTypeAnnotations) != len(targetType.ElementTypeAnnotations) {
+
+		return value
+	}
+
+	elements := make([]Value, len(tupleValue.Elements))
+	for i, element := range tupleValue.Elements {
+		elements[i] = interpreter.convertAndBox(
+			element,
+			tupleValueType.ElementTypeAnnotations[i].Type,
+			targetType.ElementTypeAnnotations[i].Type,
+		)
+	}
+
+	return NewTupleValue(elements...)
+}
+
 // boxOptional boxes a value in optionals, if necessary
 func (interpreter *Interpreter) boxOptional(value Value, valueType, targetType sema.Type) Value {
 	inner := value
@@ -2017,16 +2513,36 @@ func (interpreter *Interpreter) NewSubInterpreter(
 		WithOnStatementHandler(interpreter.onStatement),
 		WithOnLoopIterationHandler(interpreter.onLoopIteration),
 		WithOnFunctionInvocationHandler(interpreter.onFunctionInvocation),
+		WithOnFunctionEntryHandler(interpreter.onFunctionEntry),
+		WithOnFunctionReturnHandler(interpreter.onFunctionReturn),
+		WithOnResourceDestroyedHandler(interpreter.onResourceDestroyed),
+		WithDebugHandler(interpreter.debugHandler),
 		WithStorageExistenceHandler(interpreter.storageExistenceHandler),
 		WithStorageReadHandler(interpreter.storageReadHandler),
 		WithStorageWriteHandler(interpreter.storageWriteHandler),
 		WithStorageKeyHandler(interpreter.storageKeyHandler),
+		WithStoredKeysHandler(interpreter.storedKeysHandler),
 		WithInjectedCompositeFieldsHandler(interpreter.injectedCompositeFieldsHandler),
 		WithContractValueHandler(interpreter.contractValueHandler),
 		WithImportLocationHandler(interpreter.importLocationHandler),
 		WithUUIDHandler(interpreter.uuidHandler),
+		WithRandomSource(interpreter.randomSource),
 		WithAllInterpreters(interpreter.allInterpreters),
 		withTypeCodes(interpreter.typeCodes),
+		WithMaxLoopIterations(interpreter.maxLoopIterations),
+		withLoopIterations(interpreter.loopIterations),
+		WithMaxCallDepth(interpreter.maxCallDepth),
+		withCallDepth(interpreter.callDepth),
+		withVerificationStats(interpreter.verificationStats),
+		withConditionStats(interpreter.conditionStats),
+		WithComputationWeights(interpreter.computationWeights),
+		withComputationUsed(interpreter.computationUsed),
+		WithDryRun(interpreter.dryRun),
+		withEffectsLog(interpreter.effectsLog),
+		withExecution(interpreter.execution),
+		WithIntegerOverflowMode(interpreter.integerOverflowMode),
+		withInternedStrings(interpreter.internedStrings),
+		WithImportCache(interpreter.importCache),
 	}
 
 	return NewInterpreter(
@@ -2048,6 +2564,10 @@ func (interpreter *Interpreter) readStored(storageAddress common.Address, key st
 }
 
 func (interpreter *Interpreter) writeStored(storageAddress common.Address, key string, value OptionalValue) {
+	if interpreter.dryRun {
+		return
+	}
+
 	value.SetOwner(&storageAddress)
 
 	interpreter.storageWriteHandler(interpreter, storageAddress, key, value)
@@ -2168,6 +2688,56 @@ var converterDeclarations = []valueConverterDeclaration{
 	)},
 }
 
+func init() {
+
+	// Declare the functions of the converters,
+	// e.g. the functions which decode the byte representations of integers,
+	// or the functions which decode addresses
+
+	for i, declaration := range converterDeclarations {
+		variable := sema.BaseValueActivation.Find(declaration.name)
+		if variable == nil {
+			continue
+		}
+
+		functionType, ok := variable.Type.(*sema.CheckedFunctionType)
+		if !ok || functionType.Members == nil || functionType.Members.Len() == 0 {
+			continue
+		}
+
+		numberType := functionType.ReturnTypeAnnotation.Type
+
+		nestedVariables := NewStringVariableOrderedMap()
+
+		functionType.Members.Foreach(func(name string, _ *sema.Member) {
+			var function FunctionValue
+
+			switch name {
+			case sema.FromBigEndianBytesFunctionName:
+				function = newFromBytesFunction(numberType, false)
+
+			case sema.FromLittleEndianBytesFunctionName:
+				function = newFromBytesFunction(numberType, true)
+
+			case sema.AddressFromBytesFunctionName:
+				function = newAddressFromBytesFunction()
+
+			case sema.AddressFromStringFunctionName:
+				function = newAddressFromStringFunction()
+
+			default:
+				panic(errors.NewUnreachableError())
+			}
+
+			nestedVariables.Set(name, NewVariableWithValue(function))
+		})
+
+		converter := declaration.value.(HostFunctionValue)
+		converter.NestedVariables = nestedVariables
+		converterDeclarations[i].value = converter
+	}
+}
+
 func init() {
 
 	converterNames := make(map[string]struct{}, len(converterDeclarations))
@@ -2197,6 +2767,7 @@ func init() {
 func (interpreter *Interpreter) defineBaseFunctions() {
 	interpreter.defineConverterFunctions()
 	interpreter.defineTypeFunction()
+	interpreter.defineStringFunction()
 }
 
 func (interpreter *Interpreter) defineConverterFunctions() {
@@ -2235,6 +2806,39 @@ func (interpreter *Interpreter) defineTypeFunction() {
 	}
 }
 
+func (interpreter *Interpreter) defineStringFunction() {
+	function := NewHostFunctionValue(
+		func(invocation Invocation) Value {
+			return NewStringValue("")
+		},
+	)
+
+	function.NestedVariables = NewStringVariableOrderedMap()
+
+	function.NestedVariables.Set(
+		sema.StringFunctionEncodeBase64FunctionName,
+		NewVariableWithValue(newStringEncodeBase64Function()),
+	)
+
+	function.NestedVariables.Set(
+		sema.StringFunctionDecodeBase64FunctionName,
+		NewVariableWithValue(newStringDecodeBase64Function()),
+	)
+
+	function.NestedVariables.Set(
+		sema.StringFunctionFromUTF8FunctionName,
+		NewVariableWithValue(newStringFromUTF8Function()),
+	)
+
+	err := interpreter.ImportValue(
+		sema.StringType.String(),
+		function,
+	)
+	if err != nil {
+		panic(errors.NewUnreachableError())
+	}
+}
+
 // TODO:
 // - FunctionType
 //
@@ -2280,6 +2884,24 @@ func IsSubType(subType DynamicType, superType sema.Type) bool {
 	case CompositeDynamicType:
 		return sema.IsSubType(typedSubType.StaticType, superType)
 
+	case TupleDynamicType:
+		typedSuperType, ok := superType.(*sema.TupleType)
+		if !ok {
+			return false
+		}
+
+		if len(typedSubType.ElementTypes) != len(typedSuperType.ElementTypeAnnotations) {
+			return false
+		}
+
+		for i, elementType := range typedSubType.ElementTypes {
+			if !IsSubType(elementType, typedSuperType.ElementTypeAnnotations[i].Type) {
+				return false
+			}
+		}
+
+		return true
+
 	case ArrayDynamicType:
 		var superTypeElementType sema.Type
 
@@ -2450,6 +3072,29 @@ func storageKey(path PathValue) string {
 	return fmt.Sprintf("%s\x1F%s", path.Domain.Identifier(), path.Identifier)
 }
 
+// storageKeyPath returns the path for the given storage key,
+// the inverse of storageKey.
+//
+// Returns false if the key is not the key of a path,
+// e.g. the key of a deferred value.
+//
+func storageKeyPath(key string) (PathValue, bool) {
+	parts := strings.Split(key, "\x1F")
+	if len(parts) != 2 {
+		return PathValue{}, false
+	}
+
+	domain := common.PathDomainFromIdentifier(parts[0])
+	if domain == common.PathDomainUnknown {
+		return PathValue{}, false
+	}
+
+	return PathValue{
+		Domain:     domain,
+		Identifier: parts[1],
+	}, true
+}
+
 func (interpreter *Interpreter) authAccountSaveFunction(addressValue AddressValue) HostFunctionValue {
 	return NewHostFunctionValue(func(invocation Invocation) Value {
 
@@ -2473,6 +3118,13 @@ func (interpreter *Interpreter) authAccountSaveFunction(addressValue AddressValu
 
 		// Write new value
 
+		interpreter.reportStorageEffect(StorageEffect{
+			Address:   address,
+			Path:      path,
+			Operation: StorageOperationSave,
+			ValueType: invocation.ArgumentTypes[0],
+		})
+
 		interpreter.writeStored(
 			address,
 			key,
@@ -2527,6 +3179,13 @@ func (interpreter *Interpreter) authAccountReadFunction(addressValue AddressValu
 				// Remove the value from storage,
 				// but only if the type check succeeded.
 
+				interpreter.reportStorageEffect(StorageEffect{
+					Address:   address,
+					Path:      path,
+					Operation: StorageOperationLoad,
+					ValueType: ty,
+				})
+
 				interpreter.writeStored(address, key, NilValue{})
 			}
 
@@ -2617,6 +3276,13 @@ func (interpreter *Interpreter) authAccountLinkFunction(addressValue AddressValu
 			},
 		)
 
+		interpreter.reportStorageEffect(StorageEffect{
+			Address:   address,
+			Path:      newCapabilityPath,
+			Operation: StorageOperationLink,
+			ValueType: borrowType,
+		})
+
 		interpreter.writeStored(
 			address,
 			newCapabilityKey,
@@ -2673,6 +3339,12 @@ func (interpreter *Interpreter) authAccountUnlinkFunction(addressValue AddressVa
 
 		// Write new value
 
+		interpreter.reportStorageEffect(StorageEffect{
+			Address:   address,
+			Path:      capabilityPath,
+			Operation: StorageOperationUnlink,
+		})
+
 		interpreter.writeStored(
 			address,
 			capabilityKey,
@@ -2862,34 +3534,39 @@ func (interpreter *Interpreter) getElaboration(location common.Location) *sema.E
 }
 
 func (interpreter *Interpreter) getCompositeType(location common.Location, qualifiedIdentifier string) *sema.CompositeType {
-	if location == nil {
-		ty := sema.NativeCompositeTypes[qualifiedIdentifier]
-		if ty == nil {
-			panic(TypeLoadingError{
-				TypeID: common.TypeID(qualifiedIdentifier),
-			})
+	ty := interpreter.findCompositeType(location, qualifiedIdentifier)
+	if ty == nil {
+		var typeID common.TypeID
+		if location == nil {
+			typeID = common.TypeID(qualifiedIdentifier)
+		} else {
+			typeID = location.TypeID(qualifiedIdentifier)
 		}
 
-		return ty
+		panic(TypeLoadingError{
+			TypeID: typeID,
+		})
 	}
 
-	typeID := location.TypeID(qualifiedIdentifier)
+	return ty
+}
+
+// findCompositeType returns the composite type with the given qualified identifier
+// declared in the given location, or nil if the type cannot be found
+//
+func (interpreter *Interpreter) findCompositeType(location common.Location, qualifiedIdentifier string) *sema.CompositeType {
+	if location == nil {
+		return sema.NativeCompositeTypes[qualifiedIdentifier]
+	}
 
 	elaboration := interpreter.getElaboration(location)
 	if elaboration == nil {
-		panic(TypeLoadingError{
-			TypeID: typeID,
-		})
+		return nil
 	}
 
-	ty := elaboration.CompositeTypes[typeID]
-	if ty == nil {
-		panic(TypeLoadingError{
-			TypeID: typeID,
-		})
-	}
+	typeID := location.TypeID(qualifiedIdentifier)
 
-	return ty
+	return elaboration.CompositeTypes[typeID]
 }
 
 func (interpreter *Interpreter) getInterfaceType(location common.Location, qualifiedIdentifier string) *sema.InterfaceType {
@@ -2911,7 +3588,216 @@ func (interpreter *Interpreter) getInterfaceType(location common.Location, quali
 	return ty
 }
 
+// execution is the state of the top-level execution,
+// which is shared with all sub-interpreters.
+//
+// A top-level execution is an interpretation of a program or an invocation by the host environment,
+// e.g. Interpret or Invoke, which is not nested in another one.
+// For example, the interpretation of an imported program or the invocation of a function value
+// by a host function are nested in the current top-level execution.
+//
+type execution struct {
+	depth uint64
+	// continued is true if the next top-level execution continues the previous one
+	continued bool
+}
+
+// startExecution starts an interpretation or an invocation by the host environment.
+//
+// The counters of the interpreter, e.g. the computation used,
+// are reset at the start of a top-level execution,
+// unless the top-level execution continues the previous one, see continueExecution.
+//
+func (interpreter *Interpreter) startExecution() {
+	execution := interpreter.execution
+
+	if execution.depth == 0 {
+		if execution.continued {
+			execution.continued = false
+		} else {
+			interpreter.resetLoopIterations()
+			interpreter.resetCallDepth()
+			interpreter.resetVerificationStats()
+			interpreter.resetConditionStats()
+			interpreter.resetComputationUsed()
+			interpreter.resetEffectsLog()
+		}
+	}
+
+	execution.depth++
+}
+
+// endExecution ends an interpretation or an invocation by the host environment,
+// see startExecution.
+//
+func (interpreter *Interpreter) endExecution() {
+	interpreter.execution.depth--
+}
+
+// continueExecution declares that the next top-level execution continues the current one,
+// if the current execution is a top-level execution, so the counters of the interpreter are not reset.
+//
+func (interpreter *Interpreter) continueExecution() {
+	execution := interpreter.execution
+
+	if execution.depth == 1 {
+		execution.continued = true
+	}
+}
+
+// resetLoopIterations resets the counter of loop iterations,
+// which is shared with all sub-interpreters, at the start of a top-level execution.
+//
+func (interpreter *Interpreter) resetLoopIterations() {
+	*interpreter.loopIterations = 0
+}
+
+// resetVerificationStats resets the counters of signature verifications,
+// which are shared with all sub-interpreters, at the start of a top-level execution.
+//
+func (interpreter *Interpreter) resetVerificationStats() {
+	for algorithm := range interpreter.verificationStats {
+		delete(interpreter.verificationStats, algorithm)
+	}
+}
+
+// ReportSignatureVerification records that a signature verification
+// using the given signature algorithm was performed.
+//
+func (interpreter *Interpreter) ReportSignatureVerification(algorithm sema.SignatureAlgorithm) {
+	interpreter.verificationStats[algorithm]++
+}
+
+// VerificationStats returns the number of signature verifications
+// performed during the current top-level execution, per signature algorithm.
+//
+func (interpreter *Interpreter) VerificationStats() map[sema.SignatureAlgorithm]uint64 {
+	stats := make(map[sema.SignatureAlgorithm]uint64, len(interpreter.verificationStats))
+	for algorithm, count := range interpreter.verificationStats {
+		stats[algorithm] = count
+	}
+	return stats
+}
+
+// ConditionStats are the counters of the pre-conditions and post-conditions
+// evaluated during a top-level execution.
+//
+type ConditionStats struct {
+	PreChecked  uint64
+	PostChecked uint64
+	Failed      uint64
+}
+
+// resetConditionStats resets the counters of evaluated conditions,
+// which are shared with all sub-interpreters, at the start of a top-level execution.
+//
+func (interpreter *Interpreter) resetConditionStats() {
+	*interpreter.conditionStats = ConditionStats{}
+}
+
+// ConditionStats returns the number of pre-conditions and post-conditions
+// evaluated during the current top-level execution, and how many of them failed.
+//
+// A failed condition aborts the invocation with a ConditionError,
+// which contains the condition's message and location.
+//
+func (interpreter *Interpreter) ConditionStats() ConditionStats {
+	return *interpreter.conditionStats
+}
+
+// resetComputationUsed resets the counter of used computation,
+// which is shared with all sub-interpreters, at the start of a top-level execution.
+//
+func (interpreter *Interpreter) resetComputationUsed() {
+	*interpreter.computationUsed = 0
+}
+
+// MeterComputation adds the weight of the given kind of operation
+// to the computation used by the current top-level execution.
+//
+func (interpreter *Interpreter) MeterComputation(kind ComputationKind) {
+	weight, ok := interpreter.computationWeights[kind]
+	if !ok {
+		weight = defaultComputationWeight
+	}
+	*interpreter.computationUsed += weight
+}
+
+// ComputationUsed returns the computation used by the current top-level execution.
+//
+func (interpreter *Interpreter) ComputationUsed() uint64 {
+	return *interpreter.computationUsed
+}
+
+// resetEffectsLog resets the log of storage effects,
+// which is shared with all sub-interpreters, at the start of a top-level execution.
+//
+func (interpreter *Interpreter) resetEffectsLog() {
+	*interpreter.effectsLog = (*interpreter.effectsLog)[:0]
+}
+
+// reportStorageEffect records the given storage effect, if in dry-run mode.
+//
+func (interpreter *Interpreter) reportStorageEffect(effect StorageEffect) {
+	if !interpreter.dryRun {
+		return
+	}
+
+	*interpreter.effectsLog = append(*interpreter.effectsLog, effect)
+}
+
+// EffectsLog returns the storage effects recorded in dry-run mode
+// during the current top-level execution.
+//
+func (interpreter *Interpreter) EffectsLog() EffectsLog {
+	effects := make(EffectsLog, len(*interpreter.effectsLog))
+	copy(effects, *interpreter.effectsLog)
+	return effects
+}
+
+// resetCallDepth resets the counter of nested invocations,
+// which is shared with all sub-interpreters, at the start of a top-level execution.
+//
+func (interpreter *Interpreter) resetCallDepth() {
+	*interpreter.callDepth = 0
+}
+
+// enterCall increments the counter of nested invocations,
+// and aborts if the maximum call depth is exceeded.
+//
+func (interpreter *Interpreter) enterCall(getLocationRange func() LocationRange) {
+	*interpreter.callDepth++
+
+	if interpreter.maxCallDepth > 0 &&
+		*interpreter.callDepth > interpreter.maxCallDepth {
+
+		panic(CallStackLimitExceededError{
+			Limit:         interpreter.maxCallDepth,
+			LocationRange: getLocationRange(),
+		})
+	}
+}
+
+// leaveCall decrements the counter of nested invocations.
+//
+func (interpreter *Interpreter) leaveCall() {
+	*interpreter.callDepth--
+}
+
 func (interpreter *Interpreter) reportLoopIteration(pos ast.HasPosition) {
+	interpreter.MeterComputation(ComputationKindLoopIteration)
+
+	if interpreter.maxLoopIterations > 0 {
+		*interpreter.loopIterations++
+
+		if *interpreter.loopIterations > interpreter.maxLoopIterations {
+			panic(LoopLimitExceededError{
+				Limit:         interpreter.maxLoopIterations,
+				LocationRange: locationRangeGetter(interpreter.Location, pos)(),
+			})
+		}
+	}
+
 	if interpreter.onLoopIteration == nil {
 		return
 	}
@@ -2920,7 +3806,17 @@ func (interpreter *Interpreter) reportLoopIteration(pos ast.HasPosition) {
 	interpreter.onLoopIteration(interpreter, line)
 }
 
+func (interpreter *Interpreter) reportResourceDestroyed(value *CompositeValue, getLocationRange func() LocationRange) {
+	if interpreter.onResourceDestroyed == nil {
+		return
+	}
+
+	interpreter.onResourceDestroyed(interpreter, value, getLocationRange().StartPos)
+}
+
 func (interpreter *Interpreter) reportFunctionInvocation(pos ast.HasPosition) {
+	interpreter.MeterComputation(ComputationKindFunctionInvocation)
+
 	if interpreter.onFunctionInvocation == nil {
 		return
 	}
@@ -2989,3 +3885,32 @@ func (interpreter *Interpreter) getTypeFunction(self Value) HostFunctionValue {
 func (interpreter *Interpreter) setMember(self Value, getLocationRange func() LocationRange, identifier string, value Value) {
 	self.(MemberAccessibleValue).SetMember(interpreter, getLocationRange, identifier, value)
 }
+
+// ImportCache returns the cache of decoded imported contract values, if any.
+//
+func (interpreter *Interpreter) ImportCache() *ImportCache {
+	return interpreter.importCache
+}
+
+// internString returns a string value for the given string.
+//
+// If string interning is enabled, the string value is shared
+// with all other interned string values with the same content.
+//
+// Shared string values are never mutated in place,
+// as string values are copied when they are transferred,
+// e.g. when they are assigned to a variable.
+//
+func (interpreter *Interpreter) internString(str string) *StringValue {
+	if interpreter.internedStrings == nil {
+		return NewStringValue(str)
+	}
+
+	value, ok := interpreter.internedStrings[str]
+	if !ok {
+		value = NewStringValue(str)
+		interpreter.internedStrings[str] = value
+	}
+
+	return value
+}
@@ -19,6 +19,8 @@
 package interpreter
 
 import (
+	"math/big"
+
 	"github.com/onflow/cadence/fixedpoint"
 	"github.com/onflow/cadence/runtime/ast"
 	"github.com/onflow/cadence/runtime/common"
@@ -108,12 +110,12 @@ func (interpreter *Interpreter) VisitBinaryExpression(expression *ast.BinaryExpr
 	case ast.OperationPlus:
 		left := interpreter.evalExpression(expression.Left).(NumberValue)
 		right := interpreter.evalExpression(expression.Right).(NumberValue)
-		return left.Plus(right)
+		return interpreter.arithmetic(left, right, (*big.Int).Add, left.Plus)
 
 	case ast.OperationMinus:
 		left := interpreter.evalExpression(expression.Left).(NumberValue)
 		right := interpreter.evalExpression(expression.Right).(NumberValue)
-		return left.Minus(right)
+		return interpreter.arithmetic(left, right, (*big.Int).Sub, left.Minus)
 
 	case ast.OperationMod:
 		left := interpreter.evalExpression(expression.Left).(NumberValue)
@@ -123,12 +125,12 @@ func (interpreter *Interpreter) VisitBinaryExpression(expression *ast.BinaryExpr
 	case ast.OperationMul:
 		left := interpreter.evalExpression(expression.Left).(NumberValue)
 		right := interpreter.evalExpression(expression.Right).(NumberValue)
-		return left.Mul(right)
+		return interpreter.arithmetic(left, right, (*big.Int).Mul, left.Mul)
 
 	case ast.OperationDiv:
 		left := interpreter.evalExpression(expression.Left).(NumberValue)
 		right := interpreter.evalExpression(expression.Right).(NumberValue)
-		return left.Div(right)
+		return interpreter.divide(left, right)
 
 	case ast.OperationBitwiseOr:
 		left := interpreter.evalExpression(expression.Left).(IntegerValue)
@@ -273,7 +275,7 @@ func (interpreter *Interpreter) VisitUnaryExpression(expression *ast.UnaryExpres
 
 	case ast.OperationMinus:
 		integerValue := value.(NumberValue)
-		return integerValue.Negate()
+		return interpreter.negate(integerValue)
 
 	case ast.OperationMove:
 		return value
@@ -317,7 +319,7 @@ func (interpreter *Interpreter) VisitFixedPointExpression(expression *ast.FixedP
 }
 
 func (interpreter *Interpreter) VisitStringExpression(expression *ast.StringExpression) ast.Repr {
-	return NewStringValue(expression.Value)
+	return interpreter.internString(expression.Value)
 }
 
 func (interpreter *Interpreter) VisitArrayExpression(expression *ast.ArrayExpression) ast.Repr {
@@ -332,9 +334,22 @@ func (interpreter *Interpreter) VisitArrayExpression(expression *ast.ArrayExpres
 		copies[i] = interpreter.copyAndConvert(argument, argumentType, elementType)
 	}
 
+	interpreter.MeterComputation(ComputationKindArrayAllocation)
+
 	return NewArrayValueUnownedNonCopying(copies...)
 }
 
+func (interpreter *Interpreter) VisitTupleExpression(expression *ast.TupleExpression) ast.Repr {
+	elements := interpreter.visitExpressionsNonCopying(expression.Elements)
+
+	copies := make([]Value, len(elements))
+	for i, element := range elements {
+		copies[i] = element.Copy()
+	}
+
+	return NewTupleValue(copies...)
+}
+
 func (interpreter *Interpreter) VisitDictionaryExpression(expression *ast.DictionaryExpression) ast.Repr {
 	values := interpreter.visitEntries(expression.Entries)
 
@@ -373,15 +388,22 @@ func (interpreter *Interpreter) VisitDictionaryExpression(expression *ast.Dictio
 func (interpreter *Interpreter) VisitMemberExpression(expression *ast.MemberExpression) ast.Repr {
 	result := interpreter.evalExpression(expression.Expression)
 	if expression.Optional {
-		switch typedResult := result.(type) {
-		case NilValue:
-			return typedResult
 
-		case *SomeValue:
-			result = typedResult.Value
+		// Nested optionals are flattened, e.g. when accessing a member
+		// of the result of indexing into a dictionary with optional values:
+		// If any of the optionals is nil, the result is nil
 
-		default:
-			panic(errors.NewUnreachableError())
+		for {
+			switch typedResult := result.(type) {
+			case NilValue:
+				return typedResult
+
+			case *SomeValue:
+				result = typedResult.Value
+				continue
+			}
+
+			break
 		}
 	}
 
@@ -416,6 +438,37 @@ func (interpreter *Interpreter) VisitConditionalExpression(expression *ast.Condi
 	}
 }
 
+func (interpreter *Interpreter) VisitSwitchExpression(expression *ast.SwitchExpression) ast.Repr {
+
+	testValue := interpreter.evalExpression(expression.Expression).(EquatableValue)
+
+	elaboration := interpreter.Program.Elaboration
+	caseResultTypes := elaboration.SwitchExpressionCaseResultTypes[expression]
+	resultType := elaboration.SwitchExpressionResultTypes[expression]
+
+	for i, switchCase := range expression.Cases {
+
+		// If the case has no expression it is the default case,
+		// otherwise evaluate the case expression and compare it to the test value
+
+		if switchCase.Expression != nil {
+			caseValue := interpreter.evalExpression(switchCase.Expression).(EquatableValue)
+
+			if !testValue.Equal(interpreter, caseValue) {
+				continue
+			}
+		}
+
+		value := interpreter.evalExpression(switchCase.Result)
+
+		return interpreter.convertAndBox(value, caseResultTypes[i], resultType)
+	}
+
+	// The checker ensures switch expressions are exhaustive
+
+	panic(errors.NewUnreachableError())
+}
+
 func (interpreter *Interpreter) VisitInvocationExpression(invocationExpression *ast.InvocationExpression) ast.Repr {
 	// interpret the invoked expression
 	result := interpreter.evalExpression(invocationExpression.InvokedExpression)
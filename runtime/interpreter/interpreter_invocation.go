@@ -39,6 +39,9 @@ func (interpreter *Interpreter) InvokeFunctionValue(
 		err = internalErr
 	})
 
+	interpreter.startExecution()
+	defer interpreter.endExecution()
+
 	return interpreter.invokeFunctionValue(
 		function,
 		arguments,
@@ -89,6 +92,9 @@ func (interpreter *Interpreter) invokeInterpretedFunction(
 	invocation Invocation,
 ) Value {
 
+	interpreter.enterCall(invocation.GetLocationRange)
+	defer interpreter.leaveCall()
+
 	// Start a new activation record.
 	// Lexical scope: use the function declaration's activation record,
 	// not the current one (which would be dynamic scope)
@@ -110,11 +116,15 @@ func (interpreter *Interpreter) invokeInterpretedFunctionActivated(
 ) Value {
 	defer interpreter.activations.Pop()
 
+	if interpreter.onFunctionEntry != nil {
+		interpreter.onFunctionEntry(interpreter, function.Name, arguments)
+	}
+
 	if function.ParameterList != nil {
 		interpreter.bindParameterArguments(function.ParameterList, arguments)
 	}
 
-	return interpreter.visitFunctionBody(
+	result := interpreter.visitFunctionBody(
 		function.BeforeStatements,
 		function.PreConditions,
 		func() controlReturn {
@@ -123,6 +133,12 @@ func (interpreter *Interpreter) invokeInterpretedFunctionActivated(
 		function.PostConditions,
 		function.Type.ReturnTypeAnnotation.Type,
 	)
+
+	if interpreter.onFunctionReturn != nil {
+		interpreter.onFunctionReturn(interpreter, function.Name, result)
+	}
+
+	return result
 }
 
 // bindParameterArguments binds the argument values to the given parameters
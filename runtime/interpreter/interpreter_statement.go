@@ -38,6 +38,10 @@ func (interpreter *Interpreter) evalStatement(statement ast.Statement) interface
 		interpreter.onStatement(interpreter, statement)
 	}
 
+	if interpreter.debugHandler != nil {
+		interpreter.debugStatement(statement)
+	}
+
 	return statement.Accept(interpreter)
 }
 
@@ -72,12 +76,23 @@ func (interpreter *Interpreter) VisitReturnStatement(statement *ast.ReturnStatem
 	return functionReturn{value}
 }
 
-func (interpreter *Interpreter) VisitBreakStatement(_ *ast.BreakStatement) ast.Repr {
-	return controlBreak{}
+func (interpreter *Interpreter) VisitBreakStatement(statement *ast.BreakStatement) ast.Repr {
+	return controlBreak{
+		Label: loopLabel(statement.Label),
+	}
 }
 
-func (interpreter *Interpreter) VisitContinueStatement(_ *ast.ContinueStatement) ast.Repr {
-	return controlContinue{}
+func (interpreter *Interpreter) VisitContinueStatement(statement *ast.ContinueStatement) ast.Repr {
+	return controlContinue{
+		Label: loopLabel(statement.Label),
+	}
+}
+
+func loopLabel(label *ast.Identifier) string {
+	if label == nil {
+		return ""
+	}
+	return label.Identifier
 }
 
 func (interpreter *Interpreter) VisitIfStatement(statement *ast.IfStatement) ast.Repr {
@@ -157,7 +172,9 @@ func (interpreter *Interpreter) VisitSwitchStatement(switchStatement *ast.Switch
 
 			result := block.Accept(interpreter)
 
-			if _, ok := result.(controlBreak); ok {
+			// A labeled `break` statement exits a loop, not the switch statement
+
+			if controlBreak, ok := result.(controlBreak); ok && controlBreak.Label == "" {
 				return nil
 			}
 
@@ -205,16 +222,28 @@ func (interpreter *Interpreter) VisitWhileStatement(statement *ast.WhileStatemen
 
 		result := statement.Block.Accept(interpreter)
 
-		switch result.(type) {
-		case controlBreak:
-			return nil
+		if done, result := loopResult(statement.Label, result); done {
+			return result
+		}
+	}
+}
+
+func (interpreter *Interpreter) VisitRepeatWhileStatement(statement *ast.RepeatWhileStatement) ast.Repr {
+
+	for {
+
+		interpreter.reportLoopIteration(statement)
 
-		case controlContinue:
-			// NO-OP
+		result := statement.Block.Accept(interpreter)
 
-		case functionReturn:
+		if done, result := loopResult(statement.Label, result); done {
 			return result
 		}
+
+		value := interpreter.evalExpression(statement.Test).(BoolValue)
+		if !value {
+			return nil
+		}
 	}
 }
 
@@ -228,24 +257,46 @@ func (interpreter *Interpreter) VisitForStatement(statement *ast.ForStatement) a
 		nil,
 	)
 
-	values := interpreter.evalExpression(statement.Value).(*ArrayValue).Values[:]
+	value := interpreter.evalExpression(statement.Value)
 
-	for _, value := range values {
+	if statement.ValueIdentifier != nil {
+		if dictionary, ok := value.(*DictionaryValue); ok {
+			return interpreter.visitDictionaryForStatement(statement, dictionary, variable)
+		}
+	}
 
-		interpreter.reportLoopIteration(statement)
+	array := value.(*ArrayValue)
 
-		variable.SetValue(value)
+	// If the statement has two bindings,
+	// the first is bound to the index, and the second to the element
 
-		result := statement.Block.Accept(interpreter)
+	var elementVariable *Variable
+	if statement.ValueIdentifier != nil {
+		elementVariable = interpreter.declareVariable(
+			statement.ValueIdentifier.Identifier,
+			nil,
+		)
+	}
 
-		switch result.(type) {
-		case controlBreak:
-			return nil
+	// NOTE: iterate over the elements the array has when the loop starts,
+	// so elements added to the array by the loop's block are not iterated over
+
+	values := array.Values[:]
 
-		case controlContinue:
-			// NO-OP
+	for index, value := range values {
+
+		interpreter.reportLoopIteration(statement)
+
+		if elementVariable != nil {
+			variable.SetValue(NewIntValueFromInt64(int64(index)))
+			elementVariable.SetValue(value)
+		} else {
+			variable.SetValue(value)
+		}
 
-		case functionReturn:
+		result := statement.Block.Accept(interpreter)
+
+		if done, result := loopResult(statement.Label, result); done {
 			return result
 		}
 	}
@@ -253,6 +304,72 @@ func (interpreter *Interpreter) VisitForStatement(statement *ast.ForStatement) a
 	return nil
 }
 
+// visitDictionaryForStatement evaluates a for-loop with a key and a value binding,
+// which iterates over the entries of a dictionary, in insertion order.
+//
+func (interpreter *Interpreter) visitDictionaryForStatement(
+	statement *ast.ForStatement,
+	dictionary *DictionaryValue,
+	keyVariable *Variable,
+) ast.Repr {
+
+	valueVariable := interpreter.declareVariable(
+		statement.ValueIdentifier.Identifier,
+		nil,
+	)
+
+	getLocationRange := locationRangeGetter(interpreter.Location, statement.Value)
+
+	var result ast.Repr
+
+	dictionary.ForEachKey(func(keyValue Value) bool {
+
+		interpreter.reportLoopIteration(statement)
+
+		// NOTE: Force unwrap. This is safe because we are iterating over the keys.
+		value := dictionary.Get(interpreter, getLocationRange, keyValue).(*SomeValue).Value
+
+		keyVariable.SetValue(keyValue.Copy())
+		valueVariable.SetValue(value.Copy())
+
+		var done bool
+		done, result = loopResult(statement.Label, statement.Block.Accept(interpreter))
+		return !done
+	})
+
+	return result
+}
+
+// loopResult handles the result of the evaluation of the block of a loop
+// with the given label, if any.
+//
+// It returns true if the loop is done, together with the result of the loop,
+// i.e. if the loop is exited by a `break` statement or an enclosing statement is continued,
+// or the loop is exited by a `return` statement.
+//
+func loopResult(label *ast.Identifier, result ast.Repr) (bool, ast.Repr) {
+	switch result := result.(type) {
+	case controlBreak:
+		if result.Label == "" || result.Label == loopLabel(label) {
+			return true, nil
+		}
+		// The break exits an enclosing loop
+		return true, result
+
+	case controlContinue:
+		if result.Label == "" || result.Label == loopLabel(label) {
+			return false, nil
+		}
+		// The continue continues an enclosing loop
+		return true, result
+
+	case functionReturn:
+		return true, result
+	}
+
+	return false, nil
+}
+
 func (interpreter *Interpreter) VisitEmitStatement(statement *ast.EmitStatement) ast.Repr {
 	event := interpreter.evalExpression(statement.InvocationExpression).(*CompositeValue)
 
@@ -309,6 +426,27 @@ func (interpreter *Interpreter) VisitVariableDeclaration(declaration *ast.Variab
 	return nil
 }
 
+// VisitTupleVariableDeclaration first visits the declaration's value,
+// then declares a variable for each element of the resulting tuple
+func (interpreter *Interpreter) VisitTupleVariableDeclaration(declaration *ast.TupleVariableDeclaration) ast.Repr {
+
+	targetType := interpreter.Program.Elaboration.TupleVariableDeclarationTargetTypes[declaration]
+	valueType := interpreter.Program.Elaboration.TupleVariableDeclarationValueTypes[declaration]
+
+	result := interpreter.evalExpression(declaration.Value)
+
+	tupleValue := interpreter.copyAndConvert(result, valueType, targetType).(*TupleValue)
+
+	for i, identifier := range declaration.Identifiers {
+		interpreter.declareVariable(
+			identifier.Identifier,
+			tupleValue.Elements[i],
+		)
+	}
+
+	return nil
+}
+
 func (interpreter *Interpreter) VisitAssignmentStatement(assignment *ast.AssignmentStatement) ast.Repr {
 	targetType := interpreter.Program.Elaboration.AssignmentStatementTargetTypes[assignment]
 	valueType := interpreter.Program.Elaboration.AssignmentStatementValueTypes[assignment]
@@ -25,6 +25,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/onflow/cadence/runtime/ast"
 	"github.com/onflow/cadence/runtime/sema"
 	"github.com/onflow/cadence/runtime/tests/utils"
 )
@@ -156,3 +157,147 @@ func TestInterpreterBoxing(t *testing.T) {
 		})
 	}
 }
+
+func TestTypedHostFunctionValue(t *testing.T) {
+
+	t.Parallel()
+
+	functionType := &sema.FunctionType{
+		Parameters: []*sema.Parameter{
+			{
+				Identifier:     "a",
+				TypeAnnotation: sema.NewTypeAnnotation(&sema.IntType{}),
+			},
+			{
+				Identifier:     "b",
+				TypeAnnotation: sema.NewTypeAnnotation(sema.StringType),
+			},
+		},
+		ReturnTypeAnnotation: sema.NewTypeAnnotation(sema.BoolType),
+	}
+
+	function := NewTypedHostFunctionValue(
+		functionType,
+		func(invocation Invocation) Value {
+			return BoolValue(true)
+		},
+	)
+
+	t.Run("valid", func(t *testing.T) {
+
+		t.Parallel()
+
+		result := function.Invoke(Invocation{
+			Arguments: []Value{
+				NewIntValueFromInt64(1),
+				NewStringValue("b"),
+			},
+		})
+
+		require.Equal(t, BoolValue(true), result)
+	})
+
+	t.Run("too few arguments", func(t *testing.T) {
+
+		t.Parallel()
+
+		require.PanicsWithValue(t,
+			InvocationError{
+				Err: ArgumentCountError{
+					ParameterCount: 2,
+					ArgumentCount:  1,
+				},
+			},
+			func() {
+				function.Invoke(Invocation{
+					Arguments: []Value{
+						NewIntValueFromInt64(1),
+					},
+				})
+			},
+		)
+	})
+
+	t.Run("wrong argument type", func(t *testing.T) {
+
+		t.Parallel()
+
+		require.PanicsWithValue(t,
+			InvocationError{
+				Err: ArgumentTypeError{
+					Index:        1,
+					ExpectedType: sema.StringType,
+				},
+			},
+			func() {
+				function.Invoke(Invocation{
+					Arguments: []Value{
+						NewIntValueFromInt64(1),
+						BoolValue(true),
+					},
+				})
+			},
+		)
+	})
+}
+
+func TestInterpreterStringInterning(t *testing.T) {
+
+	t.Parallel()
+
+	checker, err := sema.NewChecker(nil, utils.TestLocation)
+	require.NoError(t, err)
+
+	program := ProgramFromChecker(checker)
+
+	expression := &ast.StringExpression{Value: "test"}
+
+	t.Run("disabled", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter, err := NewInterpreter(program, checker.Location)
+		require.NoError(t, err)
+
+		first := inter.VisitStringExpression(expression).(*StringValue)
+		second := inter.VisitStringExpression(expression).(*StringValue)
+
+		assert.NotSame(t, first, second)
+		assert.Equal(t, first, second)
+	})
+
+	t.Run("enabled", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter, err := NewInterpreter(
+			program,
+			checker.Location,
+			WithStringsInterned(true),
+		)
+		require.NoError(t, err)
+
+		first := inter.VisitStringExpression(expression).(*StringValue)
+		second := inter.VisitStringExpression(expression).(*StringValue)
+
+		assert.Same(t, first, second)
+
+		other := inter.VisitStringExpression(&ast.StringExpression{Value: "other"}).(*StringValue)
+
+		assert.NotSame(t, first, other)
+		assert.Equal(t, "other", other.Str)
+
+		// Sub-interpreters share the pool
+
+		subInter, err := inter.NewSubInterpreter(program, checker.Location)
+		require.NoError(t, err)
+
+		third := subInter.VisitStringExpression(expression).(*StringValue)
+
+		assert.Same(t, first, third)
+
+		// Copies are not shared
+
+		assert.NotSame(t, first, first.Copy())
+	})
+}
@@ -0,0 +1,124 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2020 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package interpreter
+
+import (
+	"github.com/onflow/cadence/runtime/common"
+)
+
+// FindOrphanedResources returns the storage paths of the values
+// which reference resources that are not stored anywhere in the given storage snapshot.
+//
+// The storage of an account cannot be enumerated, so the snapshot is given
+// as a value graph, where the values stored under a storage path are the values
+// of dictionary entries with a path key, e.g. `{/storage/a: <-r, /storage/b: s}`.
+//
+// A resource is considered stored if it is reachable from a storage path
+// through containment, i.e. not only through an ephemeral reference.
+// A resource which is only reachable through an ephemeral reference is orphaned,
+// and the path of the stored value containing the reference is returned.
+//
+// The paths are returned in the order they are found, and each path is only returned once.
+//
+func FindOrphanedResources(interpreter *Interpreter, root Value) []PathValue {
+
+	type resourceReference struct {
+		resource *CompositeValue
+		path     PathValue
+	}
+
+	storedResources := map[*CompositeValue]struct{}{}
+	var references []resourceReference
+
+	var currentPath *PathValue
+
+	var visitor Visitor
+
+	visitor = EmptyVisitor{
+		SomeValueVisitor: func(_ *Interpreter, _ *SomeValue) bool {
+			return true
+		},
+		ArrayValueVisitor: func(_ *Interpreter, _ *ArrayValue) bool {
+			return true
+		},
+		DictionaryValueVisitor: func(interpreter *Interpreter, value *DictionaryValue) bool {
+			for _, key := range value.Keys.Values {
+				// NOTE: Force unwrap. This is safe because we are iterating over the keys.
+				entryValue := value.Get(interpreter, ReturnEmptyLocationRange, key).(*SomeValue).Value
+
+				// Values nested in a stored value are attributed to the outermost path
+
+				path, ok := key.(PathValue)
+				if ok && currentPath == nil {
+					currentPath = &path
+					entryValue.Accept(interpreter, visitor)
+					currentPath = nil
+				} else {
+					entryValue.Accept(interpreter, visitor)
+				}
+			}
+			return false
+		},
+		CompositeValueVisitor: func(_ *Interpreter, value *CompositeValue) bool {
+			if value.Kind == common.CompositeKindResource && currentPath != nil {
+				storedResources[value] = struct{}{}
+			}
+			return true
+		},
+		EphemeralReferenceValueVisitor: func(_ *Interpreter, value *EphemeralReferenceValue) {
+			// References outside of storage cannot leak a resource into storage
+
+			if currentPath == nil {
+				return
+			}
+
+			resource, ok := value.Value.(*CompositeValue)
+			if !ok || resource.Kind != common.CompositeKindResource {
+				return
+			}
+
+			references = append(references,
+				resourceReference{
+					resource: resource,
+					path:     *currentPath,
+				},
+			)
+		},
+	}
+
+	root.Accept(interpreter, visitor)
+
+	var orphanedPaths []PathValue
+	seenPaths := map[PathValue]struct{}{}
+
+	for _, reference := range references {
+		if _, ok := storedResources[reference.resource]; ok {
+			continue
+		}
+
+		if _, ok := seenPaths[reference.path]; ok {
+			continue
+		}
+		seenPaths[reference.path] = struct{}{}
+
+		orphanedPaths = append(orphanedPaths, reference.path)
+	}
+
+	return orphanedPaths
+}
@@ -0,0 +1,170 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2020 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package interpreter
+
+import (
+	"math/big"
+
+	"github.com/onflow/cadence/runtime/sema"
+)
+
+// IntegerOverflowMode determines the behaviour of arithmetic operations
+// on fixed-width integer values when the result is out of range.
+//
+// The Word* types always wrap around and are not affected.
+//
+type IntegerOverflowMode uint8
+
+const (
+	// IntegerOverflowModePanic aborts the program with an overflow or underflow error.
+	// This is the default
+	IntegerOverflowModePanic IntegerOverflowMode = iota
+	// IntegerOverflowModeSaturate clamps the result to the range of the type
+	IntegerOverflowModeSaturate
+	// IntegerOverflowModeWrap wraps the result around, using two's complement arithmetic
+	IntegerOverflowModeWrap
+)
+
+// overflowingIntegerType returns the type of the given fixed-width integer value,
+// if the value is affected by the integer overflow mode,
+// i.e. if it is an Int* or UInt* value with a fixed width.
+//
+func overflowingIntegerType(value NumberValue) sema.IntegerRangedType {
+	switch value.(type) {
+	case Int8Value:
+		return &sema.Int8Type{}
+	case Int16Value:
+		return &sema.Int16Type{}
+	case Int32Value:
+		return &sema.Int32Type{}
+	case Int64Value:
+		return &sema.Int64Type{}
+	case Int128Value:
+		return &sema.Int128Type{}
+	case Int256Value:
+		return &sema.Int256Type{}
+	case UInt8Value:
+		return &sema.UInt8Type{}
+	case UInt16Value:
+		return &sema.UInt16Type{}
+	case UInt32Value:
+		return &sema.UInt32Type{}
+	case UInt64Value:
+		return &sema.UInt64Type{}
+	case UInt128Value:
+		return &sema.UInt128Type{}
+	case UInt256Value:
+		return &sema.UInt256Type{}
+	default:
+		return nil
+	}
+}
+
+// wrapBigInt wraps the given integer around to the range of the given type
+//
+func wrapBigInt(value *big.Int, rangedType sema.IntegerRangedType) *big.Int {
+	minInt := rangedType.MinInt()
+
+	modulus := new(big.Int).Sub(rangedType.MaxInt(), minInt)
+	modulus.Add(modulus, big.NewInt(1))
+
+	result := new(big.Int).Sub(value, minInt)
+	result.Mod(result, modulus)
+	return result.Add(result, minInt)
+}
+
+// arithmetic performs the given binary arithmetic operation
+// according to the interpreter's integer overflow mode.
+//
+// If the mode is the default panic mode, or the operands are not affected by the mode,
+// the given default operation is performed.
+//
+func (interpreter *Interpreter) arithmetic(
+	left, right NumberValue,
+	operation func(z, x, y *big.Int) *big.Int,
+	defaultOperation func(other NumberValue) NumberValue,
+) NumberValue {
+
+	if interpreter.integerOverflowMode == IntegerOverflowModePanic {
+		return defaultOperation(right)
+	}
+
+	rangedType := overflowingIntegerType(left)
+	if rangedType == nil {
+		return defaultOperation(right)
+	}
+
+	result := operation(
+		new(big.Int),
+		IntegerValueToBigInt(left),
+		IntegerValueToBigInt(right),
+	)
+
+	return interpreter.overflowResult(result, rangedType)
+}
+
+// divide divides the given number values
+// according to the interpreter's integer overflow mode.
+//
+// Division by zero is always an error.
+//
+func (interpreter *Interpreter) divide(left, right NumberValue) NumberValue {
+	if interpreter.integerOverflowMode != IntegerOverflowModePanic &&
+		overflowingIntegerType(right) != nil &&
+		IntegerValueToBigInt(right).Sign() == 0 {
+
+		return left.Div(right)
+	}
+
+	return interpreter.arithmetic(left, right, (*big.Int).Quo, left.Div)
+}
+
+// negate negates the given number value
+// according to the interpreter's integer overflow mode.
+//
+func (interpreter *Interpreter) negate(value NumberValue) NumberValue {
+
+	if interpreter.integerOverflowMode == IntegerOverflowModePanic {
+		return value.Negate()
+	}
+
+	rangedType := overflowingIntegerType(value)
+	if rangedType == nil {
+		return value.Negate()
+	}
+
+	result := new(big.Int).Neg(IntegerValueToBigInt(value))
+
+	return interpreter.overflowResult(result, rangedType)
+}
+
+func (interpreter *Interpreter) overflowResult(result *big.Int, rangedType sema.IntegerRangedType) NumberValue {
+	switch interpreter.integerOverflowMode {
+	case IntegerOverflowModeSaturate:
+		result = saturateBigInt(result, rangedType)
+	case IntegerOverflowModeWrap:
+		result = wrapBigInt(result, rangedType)
+	}
+
+	return interpreter.convert(
+		NewIntValueFromBigInt(result),
+		&sema.IntType{},
+		rangedType,
+	).(NumberValue)
+}
@@ -0,0 +1,127 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2020 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package interpreter
+
+import (
+	"fmt"
+
+	"github.com/onflow/cadence/runtime/format"
+)
+
+// PrettyPrintValue returns the Cadence source code representation of the given value.
+//
+// Scalars are printed as literals, where numbers of a type other than `Int`
+// are wrapped in a conversion to their type, e.g. `UInt8(1)`.
+// Arrays and dictionaries are printed as array and dictionary literals,
+// and composites are printed as an invocation of their type with the fields as arguments,
+// e.g. `S(a: 1, b: "2")`.
+//
+// Values which cannot be expressed as a literal, e.g. references and functions,
+// result in an error.
+//
+func PrettyPrintValue(interpreter *Interpreter, value Value) (string, error) {
+	switch value := value.(type) {
+	case BoolValue, *StringValue, AddressValue, PathValue, TypeValue, NilValue, IntValue:
+		return value.String(), nil
+
+	case NumberValue:
+		dynamicType := value.DynamicType(interpreter).(NumberDynamicType)
+		return fmt.Sprintf(
+			"%s(%s)",
+			dynamicType.StaticType,
+			value.String(),
+		), nil
+
+	case *SomeValue:
+		return PrettyPrintValue(interpreter, value.Value)
+
+	case *ArrayValue:
+		elements := make([]string, len(value.Values))
+		for i, element := range value.Values {
+			var err error
+			elements[i], err = PrettyPrintValue(interpreter, element)
+			if err != nil {
+				return "", err
+			}
+		}
+		return format.Array(elements), nil
+
+	case *DictionaryValue:
+		pairs := make([]struct {
+			Key   string
+			Value string
+		}, len(value.Keys.Values))
+
+		for i, keyValue := range value.Keys.Values {
+			key, err := PrettyPrintValue(interpreter, keyValue)
+			if err != nil {
+				return "", err
+			}
+
+			// NOTE: the entry is potentially deferred, so get it through the dictionary
+
+			entryValue := value.Get(interpreter, ReturnEmptyLocationRange, keyValue)
+
+			entry, err := PrettyPrintValue(interpreter, entryValue)
+			if err != nil {
+				return "", err
+			}
+
+			pairs[i].Key = key
+			pairs[i].Value = entry
+		}
+
+		return format.Dictionary(pairs), nil
+
+	case *CompositeValue:
+		fields := make([]struct {
+			Name  string
+			Value string
+		}, 0, value.Fields.Len())
+
+		var err error
+		value.Fields.Foreach(func(name string, fieldValue Value) {
+			if err != nil {
+				return
+			}
+
+			var field string
+			field, err = PrettyPrintValue(interpreter, fieldValue)
+			fields = append(fields,
+				struct {
+					Name  string
+					Value string
+				}{
+					Name:  name,
+					Value: field,
+				},
+			)
+		})
+		if err != nil {
+			return "", err
+		}
+
+		return format.Composite(value.QualifiedIdentifier, fields), nil
+
+	default:
+		return "", ValueNotPrintableError{
+			Value: value,
+		}
+	}
+}
@@ -0,0 +1,77 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2020 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package interpreter
+
+import (
+	"github.com/onflow/cadence/runtime/sema"
+)
+
+const prngStateFieldName = "state"
+
+// NewPRNGValue constructs a PRNG value, a deterministic pseudo-random number generator
+// which is seeded with the given seed.
+//
+// The generator uses the SplitMix64 algorithm, so the stream of numbers
+// for a seed is identical across platforms.
+//
+func NewPRNGValue(seed uint64) *CompositeValue {
+	fields := NewStringValueOrderedMap()
+	fields.Set(prngStateFieldName, UInt64Value(seed))
+
+	return &CompositeValue{
+		QualifiedIdentifier: sema.PRNGType.QualifiedIdentifier(),
+		Kind:                sema.PRNGType.Kind,
+		Fields:              fields,
+		Functions: map[string]FunctionValue{
+			sema.PRNGNextFunctionName: prngNextFunction,
+		},
+	}
+}
+
+var prngNextFunction = NewHostFunctionValue(
+	func(invocation Invocation) Value {
+		self := invocation.Self
+
+		state, _ := self.Fields.Get(prngStateFieldName)
+		nextState, result := splitMix64(uint64(state.(UInt64Value)))
+
+		self.SetMember(
+			invocation.Interpreter,
+			invocation.GetLocationRange,
+			prngStateFieldName,
+			UInt64Value(nextState),
+		)
+
+		return UInt64Value(result)
+	},
+)
+
+// splitMix64 returns the next state and the pseudo-random number for the given state,
+// as specified by the SplitMix64 algorithm.
+//
+func splitMix64(state uint64) (nextState uint64, result uint64) {
+	nextState = state + 0x9E3779B97F4A7C15
+
+	result = nextState
+	result = (result ^ (result >> 30)) * 0xBF58476D1CE4E5B9
+	result = (result ^ (result >> 27)) * 0x94D049BB133111EB
+	result = result ^ (result >> 31)
+
+	return nextState, result
+}
@@ -0,0 +1,57 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2020 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package interpreter
+
+import (
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/sema"
+)
+
+//go:generate go run golang.org/x/tools/cmd/stringer -type=StorageOperation -trimprefix=StorageOperation
+
+// StorageOperation is the kind of an operation which writes to account storage.
+//
+type StorageOperation uint8
+
+const (
+	StorageOperationUnknown StorageOperation = iota
+	StorageOperationSave
+	StorageOperationLoad
+	StorageOperationLink
+	StorageOperationUnlink
+)
+
+// StorageEffect is a write to account storage which was not performed,
+// because the interpreter is in dry-run mode.
+//
+// ValueType is the static type of the written value,
+// i.e. the saved value, the loaded value, or the borrow type of the link.
+// It is nil for unlink operations.
+//
+type StorageEffect struct {
+	Address   common.Address
+	Path      PathValue
+	Operation StorageOperation
+	ValueType sema.Type
+}
+
+// EffectsLog is the list of storage effects recorded in dry-run mode,
+// in the order they would have been performed.
+//
+type EffectsLog []StorageEffect
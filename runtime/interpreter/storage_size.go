@@ -0,0 +1,192 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2020 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package interpreter
+
+import (
+	"math/big"
+)
+
+// Approximate storage sizes, in bytes.
+//
+// The sizes do not need to match the encoded size exactly,
+// they are only used to estimate the cost of storing a value
+//
+const (
+	// storageSizeValueHeader is the approximate overhead of each value,
+	// e.g. the tag of the encoded value
+	storageSizeValueHeader = 1
+	// storageSizeContainerHeader is the approximate overhead of each container value,
+	// e.g. the length of an array or dictionary, or the type ID of a composite
+	storageSizeContainerHeader = 8
+	storageSizeBool            = 1
+	storageSizeAddress         = 8
+	storageSizePathDomain      = 1
+)
+
+// EstimateStorageSize returns the approximate number of bytes
+// required to store the given value.
+//
+// The estimate is cheaper to compute than encoding the value,
+// and can be used as a pre-flight estimate of the storage cost.
+//
+func EstimateStorageSize(interpreter *Interpreter, value Value) uint64 {
+	var size uint64
+
+	add := func(n int) {
+		size += storageSizeValueHeader + uint64(n)
+	}
+
+	addBigInt := func(value *big.Int) {
+		// Encoded as the minimal number of bytes, at least one
+		n := len(value.Bytes())
+		if n == 0 {
+			n = 1
+		}
+		add(n)
+	}
+
+	visitor := EmptyVisitor{
+		VoidValueVisitor: func(_ *Interpreter, _ VoidValue) {
+			add(0)
+		},
+		NilValueVisitor: func(_ *Interpreter, _ NilValue) {
+			add(0)
+		},
+		BoolValueVisitor: func(_ *Interpreter, _ BoolValue) {
+			add(storageSizeBool)
+		},
+		StringValueVisitor: func(_ *Interpreter, value *StringValue) {
+			add(len(value.Str))
+		},
+		AddressValueVisitor: func(_ *Interpreter, _ AddressValue) {
+			add(storageSizeAddress)
+		},
+		PathValueVisitor: func(_ *Interpreter, value PathValue) {
+			add(storageSizePathDomain + len(value.Identifier))
+		},
+		TypeValueVisitor: func(_ *Interpreter, value TypeValue) {
+			var n int
+			if value.Type != nil {
+				n = len(value.Type.String())
+			}
+			add(n)
+		},
+		CapabilityValueVisitor: func(_ *Interpreter, value CapabilityValue) {
+			n := storageSizeAddress + storageSizePathDomain + len(value.Path.Identifier)
+			if value.BorrowType != nil {
+				n += len(value.BorrowType.String())
+			}
+			add(n)
+		},
+		LinkValueVisitor: func(_ *Interpreter, value LinkValue) {
+			n := storageSizePathDomain + len(value.TargetPath.Identifier)
+			if value.Type != nil {
+				n += len(value.Type.String())
+			}
+			add(n)
+		},
+		IntValueVisitor: func(_ *Interpreter, value IntValue) {
+			addBigInt(value.BigInt)
+		},
+		Int8ValueVisitor: func(_ *Interpreter, _ Int8Value) {
+			add(1)
+		},
+		Int16ValueVisitor: func(_ *Interpreter, _ Int16Value) {
+			add(2)
+		},
+		Int32ValueVisitor: func(_ *Interpreter, _ Int32Value) {
+			add(4)
+		},
+		Int64ValueVisitor: func(_ *Interpreter, _ Int64Value) {
+			add(8)
+		},
+		Int128ValueVisitor: func(_ *Interpreter, _ Int128Value) {
+			add(16)
+		},
+		Int256ValueVisitor: func(_ *Interpreter, _ Int256Value) {
+			add(32)
+		},
+		UIntValueVisitor: func(_ *Interpreter, value UIntValue) {
+			addBigInt(value.BigInt)
+		},
+		UInt8ValueVisitor: func(_ *Interpreter, _ UInt8Value) {
+			add(1)
+		},
+		UInt16ValueVisitor: func(_ *Interpreter, _ UInt16Value) {
+			add(2)
+		},
+		UInt32ValueVisitor: func(_ *Interpreter, _ UInt32Value) {
+			add(4)
+		},
+		UInt64ValueVisitor: func(_ *Interpreter, _ UInt64Value) {
+			add(8)
+		},
+		UInt128ValueVisitor: func(_ *Interpreter, _ UInt128Value) {
+			add(16)
+		},
+		UInt256ValueVisitor: func(_ *Interpreter, _ UInt256Value) {
+			add(32)
+		},
+		Word8ValueVisitor: func(_ *Interpreter, _ Word8Value) {
+			add(1)
+		},
+		Word16ValueVisitor: func(_ *Interpreter, _ Word16Value) {
+			add(2)
+		},
+		Word32ValueVisitor: func(_ *Interpreter, _ Word32Value) {
+			add(4)
+		},
+		Word64ValueVisitor: func(_ *Interpreter, _ Word64Value) {
+			add(8)
+		},
+		Fix64ValueVisitor: func(_ *Interpreter, _ Fix64Value) {
+			add(8)
+		},
+		UFix64ValueVisitor: func(_ *Interpreter, _ UFix64Value) {
+			add(8)
+		},
+		SomeValueVisitor: func(_ *Interpreter, _ *SomeValue) bool {
+			add(0)
+			return true
+		},
+		ArrayValueVisitor: func(_ *Interpreter, _ *ArrayValue) bool {
+			add(storageSizeContainerHeader)
+			return true
+		},
+		DictionaryValueVisitor: func(_ *Interpreter, _ *DictionaryValue) bool {
+			add(storageSizeContainerHeader)
+			return true
+		},
+		CompositeValueVisitor: func(_ *Interpreter, value *CompositeValue) bool {
+			n := storageSizeContainerHeader + len(value.QualifiedIdentifier)
+			if value.Location != nil {
+				n += len(value.Location.ID())
+			}
+			value.Fields.Foreach(func(name string, _ Value) {
+				n += len(name)
+			})
+			add(n)
+			return true
+		},
+	}
+
+	value.Accept(interpreter, visitor)
+
+	return size
+}
@@ -19,15 +19,21 @@
 package interpreter
 
 import (
+	"encoding/base64"
 	"encoding/binary"
 	"encoding/hex"
 	"fmt"
 	"math"
 	"math/big"
+	"sort"
 	"strconv"
 	"strings"
+	"unicode"
+	"unicode/utf8"
 
 	"github.com/rivo/uniseg"
+	"golang.org/x/text/cases"
+	"golang.org/x/text/language"
 	"golang.org/x/text/unicode/norm"
 
 	"github.com/onflow/cadence/runtime/common"
@@ -171,6 +177,32 @@ func (v TypeValue) GetMember(inter *Interpreter, _ func() LocationRange, name st
 			typeID = string(inter.ConvertStaticToSemaType(staticType).ID())
 		}
 		return NewStringValue(typeID)
+
+	case sema.MetaTypeIsSubtypeFunctionName:
+		return NewHostFunctionValue(
+			func(invocation Invocation) Value {
+				otherTypeValue := invocation.Arguments[0].(TypeValue)
+
+				staticType := v.Type
+				otherStaticType := otherTypeValue.Type
+
+				// Unknown types are never subtypes of other types,
+				// and no type is a subtype of an unknown type
+
+				if staticType == nil || otherStaticType == nil {
+					return BoolValue(false)
+				}
+
+				// NOTE: use the checker's subtyping relation,
+				// so static and dynamic type checks agree
+
+				result := sema.IsSubType(
+					inter.ConvertStaticToSemaType(staticType),
+					inter.ConvertStaticToSemaType(otherStaticType),
+				)
+				return BoolValue(result)
+			},
+		)
 	}
 
 	return nil
@@ -417,12 +449,15 @@ func (v *StringValue) SetIndex(index int, char *StringValue) {
 	v.Str = sb.String()
 }
 
-func (v *StringValue) GetMember(_ *Interpreter, _ func() LocationRange, name string) Value {
+func (v *StringValue) GetMember(_ *Interpreter, getLocationRange func() LocationRange, name string) Value {
 	switch name {
 	case "length":
 		count := v.Length()
 		return NewIntValueFromInt64(int64(count))
 
+	case "utf8":
+		return ByteSliceToByteArrayValue([]byte(v.Str))
+
 	case "concat":
 		return NewHostFunctionValue(
 			func(invocation Invocation) Value {
@@ -443,7 +478,64 @@ func (v *StringValue) GetMember(_ *Interpreter, _ func() LocationRange, name str
 	case "decodeHex":
 		return NewHostFunctionValue(
 			func(invocation Invocation) Value {
-				return v.DecodeHex()
+				result, err := v.DecodeHex()
+				if err != nil {
+					panic(InvalidHexError{
+						Err:           err,
+						LocationRange: getLocationRange(),
+					})
+				}
+				return result
+			},
+		)
+
+	case "toLower":
+		return NewHostFunctionValue(
+			func(invocation Invocation) Value {
+				return v.ToLower()
+			},
+		)
+
+	case "toUpper":
+		return NewHostFunctionValue(
+			func(invocation Invocation) Value {
+				return v.ToUpper()
+			},
+		)
+
+	case "contains":
+		return NewHostFunctionValue(
+			func(invocation Invocation) Value {
+				other := invocation.Arguments[0].(*StringValue)
+				return v.Contains(other)
+			},
+		)
+
+	case "startsWith":
+		return NewHostFunctionValue(
+			func(invocation Invocation) Value {
+				prefix := invocation.Arguments[0].(*StringValue)
+				return v.StartsWith(prefix)
+			},
+		)
+
+	case "endsWith":
+		return NewHostFunctionValue(
+			func(invocation Invocation) Value {
+				suffix := invocation.Arguments[0].(*StringValue)
+				return v.EndsWith(suffix)
+			},
+		)
+
+	case "trim":
+		return NewHostFunctionValue(
+			func(invocation Invocation) Value {
+				if len(invocation.Arguments) == 0 {
+					return v.TrimSpace()
+				}
+
+				cutset := invocation.Arguments[0].(*StringValue)
+				return v.Trim(cutset)
 			},
 		)
 	}
@@ -457,14 +549,16 @@ func (v *StringValue) Length() int {
 	return uniseg.GraphemeClusterCount(v.Str)
 }
 
-// DecodeHex hex-decodes this string and returns an array of UInt8 values
+// DecodeHex hex-decodes this string and returns an array of UInt8 values.
+//
+// Returns an error if the string has an odd length or contains a non-hexadecimal character
 //
-func (v *StringValue) DecodeHex() *ArrayValue {
+func (v *StringValue) DecodeHex() (*ArrayValue, error) {
 	str := v.Str
 
 	bs, err := hex.DecodeString(str)
 	if err != nil {
-		panic(err)
+		return nil, err
 	}
 
 	values := make([]Value, len(str)/2)
@@ -472,7 +566,60 @@ func (v *StringValue) DecodeHex() *ArrayValue {
 		values[i] = UInt8Value(b)
 	}
 
-	return NewArrayValueUnownedNonCopying(values...)
+	return NewArrayValueUnownedNonCopying(values...), nil
+}
+
+// ToLower returns a new string with all characters converted to lowercase.
+//
+// The full Unicode case mapping is used, independent of any locale,
+// so the result is deterministic
+//
+func (v *StringValue) ToLower() *StringValue {
+	return NewStringValue(cases.Lower(language.Und).String(v.Str))
+}
+
+// ToUpper returns a new string with all characters converted to uppercase.
+//
+// The full Unicode case mapping is used, independent of any locale,
+// so the result is deterministic
+//
+func (v *StringValue) ToUpper() *StringValue {
+	return NewStringValue(cases.Upper(language.Und).String(v.Str))
+}
+
+// Contains returns true if the string contains the given string.
+//
+// Both strings are valid UTF-8, so the byte-wise comparison
+// never matches a part of a multi-byte code point
+//
+func (v *StringValue) Contains(other *StringValue) BoolValue {
+	return BoolValue(strings.Contains(v.Str, other.Str))
+}
+
+// StartsWith returns true if the string begins with the given prefix
+//
+func (v *StringValue) StartsWith(prefix *StringValue) BoolValue {
+	return BoolValue(strings.HasPrefix(v.Str, prefix.Str))
+}
+
+// EndsWith returns true if the string ends with the given suffix
+//
+func (v *StringValue) EndsWith(suffix *StringValue) BoolValue {
+	return BoolValue(strings.HasSuffix(v.Str, suffix.Str))
+}
+
+// TrimSpace returns a new string with all leading and trailing whitespace removed.
+// Whitespace is defined by the Unicode White_Space property, e.g. U+00A0
+//
+func (v *StringValue) TrimSpace() *StringValue {
+	return NewStringValue(strings.TrimFunc(v.Str, unicode.IsSpace))
+}
+
+// Trim returns a new string with all leading and trailing code points
+// contained in the given cutset removed
+//
+func (v *StringValue) Trim(cutset *StringValue) *StringValue {
+	return NewStringValue(strings.Trim(v.Str, cutset.Str))
 }
 
 func (*StringValue) SetMember(_ *Interpreter, _ func() LocationRange, _ string, _ Value) {
@@ -485,6 +632,9 @@ type ArrayValue struct {
 	Values   []Value
 	Owner    *common.Address
 	modified bool
+	// iterations tracks the iterations over the array which are in progress.
+	// Elements must not be added or removed while an iteration is in progress.
+	iterations iterationGuard
 }
 
 func NewArrayValueUnownedNonCopying(values ...Value) *ArrayValue {
@@ -632,6 +782,15 @@ func (v *ArrayValue) Append(element Value) {
 	v.Values = append(v.Values, element)
 }
 
+func (v *ArrayValue) AppendAll(other *ArrayValue) {
+	v.modified = true
+
+	for _, element := range other.Values {
+		element.SetOwner(v.Owner)
+	}
+	v.Values = append(v.Values, other.Values...)
+}
+
 func (v *ArrayValue) Insert(i int, element Value) {
 	v.modified = true
 
@@ -675,6 +834,22 @@ func (v *ArrayValue) RemoveLast() Value {
 	return lastElement
 }
 
+// Iterate calls the given function for each element of the array, in order,
+// until the function returns false.
+//
+// Adding or removing elements while the iteration is in progress
+// results in a ContainerMutatedDuringIterationError
+//
+func (v *ArrayValue) Iterate(f func(element Value) bool) {
+	v.iterations.iterate(func() {
+		for _, element := range v.Values {
+			if !f(element) {
+				return
+			}
+		}
+	})
+}
+
 func (v *ArrayValue) Contains(needleValue Value) BoolValue {
 	needleEquatable := needleValue.(EquatableValue)
 
@@ -695,11 +870,22 @@ func (v *ArrayValue) GetMember(_ *Interpreter, _ func() LocationRange, name stri
 	case "append":
 		return NewHostFunctionValue(
 			func(invocation Invocation) Value {
+				v.iterations.checkNotIterating(invocation.GetLocationRange)
 				v.Append(invocation.Arguments[0])
 				return VoidValue{}
 			},
 		)
 
+	case "appendAll":
+		return NewHostFunctionValue(
+			func(invocation Invocation) Value {
+				v.iterations.checkNotIterating(invocation.GetLocationRange)
+				otherArray := invocation.Arguments[0].(*ArrayValue)
+				v.AppendAll(otherArray)
+				return VoidValue{}
+			},
+		)
+
 	case "concat":
 		return NewHostFunctionValue(
 			func(invocation Invocation) Value {
@@ -711,6 +897,7 @@ func (v *ArrayValue) GetMember(_ *Interpreter, _ func() LocationRange, name stri
 	case "insert":
 		return NewHostFunctionValue(
 			func(invocation Invocation) Value {
+				v.iterations.checkNotIterating(invocation.GetLocationRange)
 				i := invocation.Arguments[0].(NumberValue).ToInt()
 				element := invocation.Arguments[1]
 				v.Insert(i, element)
@@ -721,6 +908,7 @@ func (v *ArrayValue) GetMember(_ *Interpreter, _ func() LocationRange, name stri
 	case "remove":
 		return NewHostFunctionValue(
 			func(invocation Invocation) Value {
+				v.iterations.checkNotIterating(invocation.GetLocationRange)
 				i := invocation.Arguments[0].(NumberValue).ToInt()
 				return v.Remove(i)
 			},
@@ -729,6 +917,7 @@ func (v *ArrayValue) GetMember(_ *Interpreter, _ func() LocationRange, name stri
 	case "removeFirst":
 		return NewHostFunctionValue(
 			func(invocation Invocation) Value {
+				v.iterations.checkNotIterating(invocation.GetLocationRange)
 				return v.RemoveFirst()
 			},
 		)
@@ -736,6 +925,7 @@ func (v *ArrayValue) GetMember(_ *Interpreter, _ func() LocationRange, name stri
 	case "removeLast":
 		return NewHostFunctionValue(
 			func(invocation Invocation) Value {
+				v.iterations.checkNotIterating(invocation.GetLocationRange)
 				return v.RemoveLast()
 			},
 		)
@@ -747,11 +937,80 @@ func (v *ArrayValue) GetMember(_ *Interpreter, _ func() LocationRange, name stri
 			},
 		)
 
+	case "reverse":
+		return NewHostFunctionValue(
+			func(invocation Invocation) Value {
+				return v.Reverse()
+			},
+		)
+
+	case "sorted":
+		return NewHostFunctionValue(
+			func(invocation Invocation) Value {
+				return v.Sorted()
+			},
+		)
+
+	case "slice":
+		return NewHostFunctionValue(
+			func(invocation Invocation) Value {
+				from := invocation.Arguments[0].(NumberValue).ToInt()
+				upTo := invocation.Arguments[1].(NumberValue).ToInt()
+				return v.Slice(from, upTo, invocation.GetLocationRange)
+			},
+		)
+
 	}
 
 	return nil
 }
 
+// Reverse returns a new array which contains copies of the elements in reverse order
+//
+func (v *ArrayValue) Reverse() *ArrayValue {
+	count := v.Count()
+	reversed := make([]Value, count)
+	for i, value := range v.Values {
+		reversed[count-1-i] = value.Copy()
+	}
+	return NewArrayValueUnownedNonCopying(reversed...)
+}
+
+// Sorted returns a new array which contains copies of the elements in ascending order.
+// The elements must be numbers. The sort is stable
+//
+func (v *ArrayValue) Sorted() *ArrayValue {
+	sorted := v.Copy().(*ArrayValue)
+	values := sorted.Values
+	sort.SliceStable(values, func(i, j int) bool {
+		return bool(values[i].(NumberValue).Less(values[j].(NumberValue)))
+	})
+	return sorted
+}
+
+// Slice returns a new array which contains copies of the elements
+// from the index `from` (inclusive) to the index `upTo` (exclusive)
+//
+func (v *ArrayValue) Slice(from, upTo int, getLocationRange func() LocationRange) *ArrayValue {
+	count := v.Count()
+
+	// Check bounds
+	if from < 0 || upTo > count || from > upTo {
+		panic(ArraySliceIndicesError{
+			FromIndex:     from,
+			UpToIndex:     upTo,
+			Length:        count,
+			LocationRange: getLocationRange(),
+		})
+	}
+
+	copies := make([]Value, upTo-from)
+	for i, value := range v.Values[from:upTo] {
+		copies[i] = value.Copy()
+	}
+	return NewArrayValueUnownedNonCopying(copies...)
+}
+
 func (v *ArrayValue) SetMember(_ *Interpreter, _ func() LocationRange, _ string, _ Value) {
 	panic(errors.NewUnreachableError())
 }
@@ -760,6 +1019,31 @@ func (v *ArrayValue) Count() int {
 	return len(v.Values)
 }
 
+// iterationGuard tracks the iterations over a container value which are in progress,
+// so that structural mutations of the container during an iteration,
+// e.g. inserting or removing elements, can be rejected
+//
+type iterationGuard struct {
+	count int
+}
+
+func (g *iterationGuard) iterate(f func()) {
+	g.count++
+	defer func() {
+		g.count--
+	}()
+
+	f()
+}
+
+func (g *iterationGuard) checkNotIterating(getLocationRange func() LocationRange) {
+	if g.count > 0 {
+		panic(ContainerMutatedDuringIterationError{
+			LocationRange: getLocationRange(),
+		})
+	}
+}
+
 // NumberValue
 
 type NumberValue interface {
@@ -1237,6 +1521,19 @@ func (v Int8Value) BitwiseRightShift(other IntegerValue) IntegerValue {
 func (v Int8Value) GetMember(_ *Interpreter, _ func() LocationRange, name string) Value {
 	switch name {
 
+	case sema.SaturatingAddFunctionName,
+		sema.SaturatingSubtractFunctionName,
+		sema.SaturatingMultiplyFunctionName:
+
+		return getSaturatingArithmeticMember(
+			v,
+			name,
+			&sema.Int8Type{},
+			func(value Value) Value {
+				return ConvertInt8(value)
+			},
+		)
+
 	case sema.ToStringFunctionName:
 		return NewHostFunctionValue(
 			func(invocation Invocation) Value {
@@ -1250,6 +1547,13 @@ func (v Int8Value) GetMember(_ *Interpreter, _ func() LocationRange, name string
 				return ByteSliceToByteArrayValue(v.ToBigEndianBytes())
 			},
 		)
+
+	case sema.ToLittleEndianBytesFunctionName:
+		return NewHostFunctionValue(
+			func(invocation Invocation) Value {
+				return ByteSliceToByteArrayValue(reverseBytes(v.ToBigEndianBytes()))
+			},
+		)
 	}
 
 	return nil
@@ -1473,6 +1777,19 @@ func (v Int16Value) BitwiseRightShift(other IntegerValue) IntegerValue {
 func (v Int16Value) GetMember(_ *Interpreter, _ func() LocationRange, name string) Value {
 	switch name {
 
+	case sema.SaturatingAddFunctionName,
+		sema.SaturatingSubtractFunctionName,
+		sema.SaturatingMultiplyFunctionName:
+
+		return getSaturatingArithmeticMember(
+			v,
+			name,
+			&sema.Int16Type{},
+			func(value Value) Value {
+				return ConvertInt16(value)
+			},
+		)
+
 	case sema.ToStringFunctionName:
 		return NewHostFunctionValue(
 			func(invocation Invocation) Value {
@@ -1486,6 +1803,13 @@ func (v Int16Value) GetMember(_ *Interpreter, _ func() LocationRange, name strin
 				return ByteSliceToByteArrayValue(v.ToBigEndianBytes())
 			},
 		)
+
+	case sema.ToLittleEndianBytesFunctionName:
+		return NewHostFunctionValue(
+			func(invocation Invocation) Value {
+				return ByteSliceToByteArrayValue(reverseBytes(v.ToBigEndianBytes()))
+			},
+		)
 	}
 
 	return nil
@@ -1711,6 +2035,19 @@ func (v Int32Value) BitwiseRightShift(other IntegerValue) IntegerValue {
 func (v Int32Value) GetMember(_ *Interpreter, _ func() LocationRange, name string) Value {
 	switch name {
 
+	case sema.SaturatingAddFunctionName,
+		sema.SaturatingSubtractFunctionName,
+		sema.SaturatingMultiplyFunctionName:
+
+		return getSaturatingArithmeticMember(
+			v,
+			name,
+			&sema.Int32Type{},
+			func(value Value) Value {
+				return ConvertInt32(value)
+			},
+		)
+
 	case sema.ToStringFunctionName:
 		return NewHostFunctionValue(
 			func(invocation Invocation) Value {
@@ -1724,6 +2061,13 @@ func (v Int32Value) GetMember(_ *Interpreter, _ func() LocationRange, name strin
 				return ByteSliceToByteArrayValue(v.ToBigEndianBytes())
 			},
 		)
+
+	case sema.ToLittleEndianBytesFunctionName:
+		return NewHostFunctionValue(
+			func(invocation Invocation) Value {
+				return ByteSliceToByteArrayValue(reverseBytes(v.ToBigEndianBytes()))
+			},
+		)
 	}
 
 	return nil
@@ -1948,6 +2292,19 @@ func (v Int64Value) BitwiseRightShift(other IntegerValue) IntegerValue {
 func (v Int64Value) GetMember(_ *Interpreter, _ func() LocationRange, name string) Value {
 	switch name {
 
+	case sema.SaturatingAddFunctionName,
+		sema.SaturatingSubtractFunctionName,
+		sema.SaturatingMultiplyFunctionName:
+
+		return getSaturatingArithmeticMember(
+			v,
+			name,
+			&sema.Int64Type{},
+			func(value Value) Value {
+				return ConvertInt64(value)
+			},
+		)
+
 	case sema.ToStringFunctionName:
 		return NewHostFunctionValue(
 			func(invocation Invocation) Value {
@@ -1961,6 +2318,13 @@ func (v Int64Value) GetMember(_ *Interpreter, _ func() LocationRange, name strin
 				return ByteSliceToByteArrayValue(v.ToBigEndianBytes())
 			},
 		)
+
+	case sema.ToLittleEndianBytesFunctionName:
+		return NewHostFunctionValue(
+			func(invocation Invocation) Value {
+				return ByteSliceToByteArrayValue(reverseBytes(v.ToBigEndianBytes()))
+			},
+		)
 	}
 
 	return nil
@@ -2245,6 +2609,19 @@ func (v Int128Value) BitwiseRightShift(other IntegerValue) IntegerValue {
 func (v Int128Value) GetMember(_ *Interpreter, _ func() LocationRange, name string) Value {
 	switch name {
 
+	case sema.SaturatingAddFunctionName,
+		sema.SaturatingSubtractFunctionName,
+		sema.SaturatingMultiplyFunctionName:
+
+		return getSaturatingArithmeticMember(
+			v,
+			name,
+			&sema.Int128Type{},
+			func(value Value) Value {
+				return ConvertInt128(value)
+			},
+		)
+
 	case sema.ToStringFunctionName:
 		return NewHostFunctionValue(
 			func(invocation Invocation) Value {
@@ -2258,6 +2635,20 @@ func (v Int128Value) GetMember(_ *Interpreter, _ func() LocationRange, name stri
 				return ByteSliceToByteArrayValue(v.ToBigEndianBytes())
 			},
 		)
+
+	case sema.ToLittleEndianBytesFunctionName:
+		return NewHostFunctionValue(
+			func(invocation Invocation) Value {
+				return ByteSliceToByteArrayValue(reverseBytes(v.ToBigEndianBytes()))
+			},
+		)
+
+	case sema.ToInt8CheckedFunctionName,
+		sema.ToInt16CheckedFunctionName,
+		sema.ToInt32CheckedFunctionName,
+		sema.ToInt64CheckedFunctionName:
+
+		return getCheckedConversionMember(v, name, &sema.Int128Type{})
 	}
 
 	return nil
@@ -2268,7 +2659,7 @@ func (Int128Value) SetMember(_ *Interpreter, _ func() LocationRange, _ string, _
 }
 
 func (v Int128Value) ToBigEndianBytes() []byte {
-	return SignedBigIntToBigEndianBytes(v.BigInt)
+	return SignedBigIntToSizedBigEndianBytes(v.BigInt, 16)
 }
 
 // Int256Value
@@ -2541,6 +2932,19 @@ func (v Int256Value) BitwiseRightShift(other IntegerValue) IntegerValue {
 func (v Int256Value) GetMember(_ *Interpreter, _ func() LocationRange, name string) Value {
 	switch name {
 
+	case sema.SaturatingAddFunctionName,
+		sema.SaturatingSubtractFunctionName,
+		sema.SaturatingMultiplyFunctionName:
+
+		return getSaturatingArithmeticMember(
+			v,
+			name,
+			&sema.Int256Type{},
+			func(value Value) Value {
+				return ConvertInt256(value)
+			},
+		)
+
 	case sema.ToStringFunctionName:
 		return NewHostFunctionValue(
 			func(invocation Invocation) Value {
@@ -2554,6 +2958,21 @@ func (v Int256Value) GetMember(_ *Interpreter, _ func() LocationRange, name stri
 				return ByteSliceToByteArrayValue(v.ToBigEndianBytes())
 			},
 		)
+
+	case sema.ToLittleEndianBytesFunctionName:
+		return NewHostFunctionValue(
+			func(invocation Invocation) Value {
+				return ByteSliceToByteArrayValue(reverseBytes(v.ToBigEndianBytes()))
+			},
+		)
+
+	case sema.ToInt8CheckedFunctionName,
+		sema.ToInt16CheckedFunctionName,
+		sema.ToInt32CheckedFunctionName,
+		sema.ToInt64CheckedFunctionName,
+		sema.ToInt128CheckedFunctionName:
+
+		return getCheckedConversionMember(v, name, &sema.Int256Type{})
 	}
 
 	return nil
@@ -2564,7 +2983,7 @@ func (Int256Value) SetMember(_ *Interpreter, _ func() LocationRange, _ string, _
 }
 
 func (v Int256Value) ToBigEndianBytes() []byte {
-	return SignedBigIntToBigEndianBytes(v.BigInt)
+	return SignedBigIntToSizedBigEndianBytes(v.BigInt, 32)
 }
 
 // UIntValue
@@ -2987,6 +3406,19 @@ func (v UInt8Value) BitwiseRightShift(other IntegerValue) IntegerValue {
 func (v UInt8Value) GetMember(_ *Interpreter, _ func() LocationRange, name string) Value {
 	switch name {
 
+	case sema.SaturatingAddFunctionName,
+		sema.SaturatingSubtractFunctionName,
+		sema.SaturatingMultiplyFunctionName:
+
+		return getSaturatingArithmeticMember(
+			v,
+			name,
+			&sema.UInt8Type{},
+			func(value Value) Value {
+				return ConvertUInt8(value)
+			},
+		)
+
 	case sema.ToStringFunctionName:
 		return NewHostFunctionValue(
 			func(invocation Invocation) Value {
@@ -3000,6 +3432,13 @@ func (v UInt8Value) GetMember(_ *Interpreter, _ func() LocationRange, name strin
 				return ByteSliceToByteArrayValue(v.ToBigEndianBytes())
 			},
 		)
+
+	case sema.ToLittleEndianBytesFunctionName:
+		return NewHostFunctionValue(
+			func(invocation Invocation) Value {
+				return ByteSliceToByteArrayValue(reverseBytes(v.ToBigEndianBytes()))
+			},
+		)
 	}
 
 	return nil
@@ -3189,6 +3628,19 @@ func (v UInt16Value) BitwiseRightShift(other IntegerValue) IntegerValue {
 func (v UInt16Value) GetMember(_ *Interpreter, _ func() LocationRange, name string) Value {
 	switch name {
 
+	case sema.SaturatingAddFunctionName,
+		sema.SaturatingSubtractFunctionName,
+		sema.SaturatingMultiplyFunctionName:
+
+		return getSaturatingArithmeticMember(
+			v,
+			name,
+			&sema.UInt16Type{},
+			func(value Value) Value {
+				return ConvertUInt16(value)
+			},
+		)
+
 	case sema.ToStringFunctionName:
 		return NewHostFunctionValue(
 			func(invocation Invocation) Value {
@@ -3202,6 +3654,13 @@ func (v UInt16Value) GetMember(_ *Interpreter, _ func() LocationRange, name stri
 				return ByteSliceToByteArrayValue(v.ToBigEndianBytes())
 			},
 		)
+
+	case sema.ToLittleEndianBytesFunctionName:
+		return NewHostFunctionValue(
+			func(invocation Invocation) Value {
+				return ByteSliceToByteArrayValue(reverseBytes(v.ToBigEndianBytes()))
+			},
+		)
 	}
 
 	return nil
@@ -3395,6 +3854,19 @@ func (v UInt32Value) BitwiseRightShift(other IntegerValue) IntegerValue {
 func (v UInt32Value) GetMember(_ *Interpreter, _ func() LocationRange, name string) Value {
 	switch name {
 
+	case sema.SaturatingAddFunctionName,
+		sema.SaturatingSubtractFunctionName,
+		sema.SaturatingMultiplyFunctionName:
+
+		return getSaturatingArithmeticMember(
+			v,
+			name,
+			&sema.UInt32Type{},
+			func(value Value) Value {
+				return ConvertUInt32(value)
+			},
+		)
+
 	case sema.ToStringFunctionName:
 		return NewHostFunctionValue(
 			func(invocation Invocation) Value {
@@ -3408,6 +3880,13 @@ func (v UInt32Value) GetMember(_ *Interpreter, _ func() LocationRange, name stri
 				return ByteSliceToByteArrayValue(v.ToBigEndianBytes())
 			},
 		)
+
+	case sema.ToLittleEndianBytesFunctionName:
+		return NewHostFunctionValue(
+			func(invocation Invocation) Value {
+				return ByteSliceToByteArrayValue(reverseBytes(v.ToBigEndianBytes()))
+			},
+		)
 	}
 
 	return nil
@@ -3604,6 +4083,19 @@ func (v UInt64Value) BitwiseRightShift(other IntegerValue) IntegerValue {
 func (v UInt64Value) GetMember(_ *Interpreter, _ func() LocationRange, name string) Value {
 	switch name {
 
+	case sema.SaturatingAddFunctionName,
+		sema.SaturatingSubtractFunctionName,
+		sema.SaturatingMultiplyFunctionName:
+
+		return getSaturatingArithmeticMember(
+			v,
+			name,
+			&sema.UInt64Type{},
+			func(value Value) Value {
+				return ConvertUInt64(value)
+			},
+		)
+
 	case sema.ToStringFunctionName:
 		return NewHostFunctionValue(
 			func(invocation Invocation) Value {
@@ -3617,6 +4109,13 @@ func (v UInt64Value) GetMember(_ *Interpreter, _ func() LocationRange, name stri
 				return ByteSliceToByteArrayValue(v.ToBigEndianBytes())
 			},
 		)
+
+	case sema.ToLittleEndianBytesFunctionName:
+		return NewHostFunctionValue(
+			func(invocation Invocation) Value {
+				return ByteSliceToByteArrayValue(reverseBytes(v.ToBigEndianBytes()))
+			},
+		)
 	}
 
 	return nil
@@ -3872,6 +4371,19 @@ func (v UInt128Value) BitwiseRightShift(other IntegerValue) IntegerValue {
 func (v UInt128Value) GetMember(_ *Interpreter, _ func() LocationRange, name string) Value {
 	switch name {
 
+	case sema.SaturatingAddFunctionName,
+		sema.SaturatingSubtractFunctionName,
+		sema.SaturatingMultiplyFunctionName:
+
+		return getSaturatingArithmeticMember(
+			v,
+			name,
+			&sema.UInt128Type{},
+			func(value Value) Value {
+				return ConvertUInt128(value)
+			},
+		)
+
 	case sema.ToStringFunctionName:
 		return NewHostFunctionValue(
 			func(invocation Invocation) Value {
@@ -3884,6 +4396,13 @@ func (v UInt128Value) GetMember(_ *Interpreter, _ func() LocationRange, name str
 				return ByteSliceToByteArrayValue(v.ToBigEndianBytes())
 			},
 		)
+
+	case sema.ToLittleEndianBytesFunctionName:
+		return NewHostFunctionValue(
+			func(invocation Invocation) Value {
+				return ByteSliceToByteArrayValue(reverseBytes(v.ToBigEndianBytes()))
+			},
+		)
 	}
 
 	return nil
@@ -3894,7 +4413,7 @@ func (UInt128Value) SetMember(_ *Interpreter, _ func() LocationRange, _ string,
 }
 
 func (v UInt128Value) ToBigEndianBytes() []byte {
-	return UnsignedBigIntToBigEndianBytes(v.BigInt)
+	return UnsignedBigIntToSizedBigEndianBytes(v.BigInt, 16)
 }
 
 // UInt256Value
@@ -4137,6 +4656,19 @@ func (v UInt256Value) BitwiseRightShift(other IntegerValue) IntegerValue {
 func (v UInt256Value) GetMember(_ *Interpreter, _ func() LocationRange, name string) Value {
 	switch name {
 
+	case sema.SaturatingAddFunctionName,
+		sema.SaturatingSubtractFunctionName,
+		sema.SaturatingMultiplyFunctionName:
+
+		return getSaturatingArithmeticMember(
+			v,
+			name,
+			&sema.UInt256Type{},
+			func(value Value) Value {
+				return ConvertUInt256(value)
+			},
+		)
+
 	case sema.ToStringFunctionName:
 		return NewHostFunctionValue(
 			func(invocation Invocation) Value {
@@ -4150,6 +4682,13 @@ func (v UInt256Value) GetMember(_ *Interpreter, _ func() LocationRange, name str
 				return ByteSliceToByteArrayValue(v.ToBigEndianBytes())
 			},
 		)
+
+	case sema.ToLittleEndianBytesFunctionName:
+		return NewHostFunctionValue(
+			func(invocation Invocation) Value {
+				return ByteSliceToByteArrayValue(reverseBytes(v.ToBigEndianBytes()))
+			},
+		)
 	}
 
 	return nil
@@ -4160,7 +4699,7 @@ func (UInt256Value) SetMember(_ *Interpreter, _ func() LocationRange, _ string,
 }
 
 func (v UInt256Value) ToBigEndianBytes() []byte {
-	return UnsignedBigIntToBigEndianBytes(v.BigInt)
+	return UnsignedBigIntToSizedBigEndianBytes(v.BigInt, 32)
 }
 
 // Word8Value
@@ -5326,21 +5865,68 @@ func (v *CompositeValue) Destroy(interpreter *Interpreter, getLocationRange func
 		destructor.Invoke(invocation)
 	}
 
+	interpreter.reportResourceDestroyed(v, getLocationRange)
+
 	v.destroyed = true
 	v.modified = true
 }
 
 func (*CompositeValue) IsValue() {}
 
+// Accept visits the composite value, and then its fields.
+//
+// The fields are visited deterministically, in the order returned by FieldNamesInOrder.
+//
 func (v *CompositeValue) Accept(interpreter *Interpreter, visitor Visitor) {
 	descend := visitor.VisitCompositeValue(interpreter, v)
 	if !descend {
 		return
 	}
 
-	v.Fields.Foreach(func(_ string, value Value) {
+	for _, name := range v.FieldNamesInOrder(interpreter) {
+		value, _ := v.Fields.Get(name)
 		value.Accept(interpreter, visitor)
+	}
+}
+
+// FieldNamesInOrder returns the names of the stored fields of the composite value
+// in the order in which the fields are declared in the composite type.
+//
+// Stored fields which are not declared by the composite type
+// follow the declared fields in the order in which they were set.
+// If the composite type is not available, all fields are returned in the order in which they were set.
+//
+func (v *CompositeValue) FieldNamesInOrder(interpreter *Interpreter) []string {
+	names := make([]string, 0, v.Fields.Len())
+
+	var compositeType *sema.CompositeType
+	if interpreter != nil {
+		compositeType = interpreter.findCompositeType(v.Location, v.QualifiedIdentifier)
+	}
+
+	var declared map[string]bool
+
+	if compositeType != nil {
+		declared = make(map[string]bool, len(compositeType.Fields))
+
+		for _, name := range compositeType.Fields {
+			if _, ok := v.Fields.Get(name); !ok {
+				continue
+			}
+
+			names = append(names, name)
+			declared[name] = true
+		}
+	}
+
+	v.Fields.Foreach(func(name string, _ Value) {
+		if declared[name] {
+			return
+		}
+		names = append(names, name)
 	})
+
+	return names
 }
 
 func (v *CompositeValue) DynamicType(interpreter *Interpreter) DynamicType {
@@ -5583,28 +6169,145 @@ func formatComposite(typeId string, fields *StringValueOrderedMap) string {
 	return format.Composite(typeId, preparedFields)
 }
 
-func (v *CompositeValue) GetField(name string) Value {
-	value, _ := v.Fields.Get(name)
-	return value
+// GetField returns the value of the stored field with the given name,
+// and whether the composite has such a field.
+//
+// Unlike GetMember, injected fields, computed fields, and functions are not considered.
+//
+func (v *CompositeValue) GetField(_ *Interpreter, name string) (Value, bool) {
+	return v.Fields.Get(name)
 }
 
-func (v *CompositeValue) Equal(interpreter *Interpreter, other Value) BoolValue {
-	// TODO: add support for other composite kinds
+// SetField sets the value of the stored field with the given name.
+//
+// Fields of resources may only be set by code in the location
+// in which the resource is defined.
+//
+func (v *CompositeValue) SetField(
+	interpreter *Interpreter,
+	getLocationRange func() LocationRange,
+	name string,
+	value Value,
+) {
+	v.checkStatus(getLocationRange)
 
-	if v.Kind != common.CompositeKindEnum {
-		return false
+	if v.Kind == common.CompositeKindResource &&
+		v.Location != nil &&
+		!common.LocationsMatch(interpreter.Location, v.Location) {
+
+		panic(ResourceFieldAssignmentError{
+			TypeID:        v.TypeID(),
+			FieldName:     name,
+			LocationRange: getLocationRange(),
+		})
 	}
 
+	v.modified = true
+
+	value.SetOwner(v.Owner)
+
+	v.Fields.Set(name, value)
+}
+
+// Equal returns true if the other value is a composite value of the same type,
+// and all fields are equal.
+//
+// Enums are equal if their raw values are equal.
+// Resources cannot be compared, as this would imply they can be duplicated,
+// so comparing a resource results in a panic.
+//
+func (v *CompositeValue) Equal(interpreter *Interpreter, other Value) BoolValue {
 	otherComposite, ok := other.(*CompositeValue)
 	if !ok {
 		return false
 	}
 
-	rawValue, _ := v.Fields.Get(sema.EnumRawValueFieldName)
-	otherRawValue, _ := otherComposite.Fields.Get(sema.EnumRawValueFieldName)
+	if v.Kind == common.CompositeKindResource ||
+		otherComposite.Kind == common.CompositeKindResource {
+
+		panic(ResourceComparisonError{
+			TypeID: v.TypeID(),
+		})
+	}
+
+	if v.Kind != otherComposite.Kind ||
+		v.TypeID() != otherComposite.TypeID() {
+
+		return false
+	}
+
+	if v.Kind == common.CompositeKindEnum {
+		rawValue, _ := v.Fields.Get(sema.EnumRawValueFieldName)
+		otherRawValue, _ := otherComposite.Fields.Get(sema.EnumRawValueFieldName)
+
+		return rawValue.(NumberValue).
+			Equal(interpreter, otherRawValue)
+	}
+
+	if v.Fields.Len() != otherComposite.Fields.Len() {
+		return false
+	}
+
+	for pair := v.Fields.Oldest(); pair != nil; pair = pair.Next() {
+		otherValue, ok := otherComposite.Fields.Get(pair.Key)
+		if !ok {
+			return false
+		}
+
+		if !interpreter.fieldValuesEqual(pair.Value, otherValue) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// fieldValuesEqual returns true if the given values of composite fields are equal.
+//
+// Unlike the equality operator (see testEqual), which does not support arrays and dictionaries,
+// arrays and dictionaries are compared element-wise, recursively.
+//
+func (interpreter *Interpreter) fieldValuesEqual(left, right Value) BoolValue {
+	left = interpreter.unbox(left)
+	right = interpreter.unbox(right)
+
+	switch left := left.(type) {
+	case *ArrayValue:
+		right, ok := right.(*ArrayValue)
+		if !ok || left.Count() != right.Count() {
+			return false
+		}
+
+		for i, value := range left.Values {
+			if !interpreter.fieldValuesEqual(value, right.Values[i]) {
+				return false
+			}
+		}
+
+		return true
+
+	case *DictionaryValue:
+		right, ok := right.(*DictionaryValue)
+		if !ok || left.Count() != right.Count() {
+			return false
+		}
 
-	return rawValue.(NumberValue).
-		Equal(interpreter, otherRawValue)
+		for pair := left.Entries.Oldest(); pair != nil; pair = pair.Next() {
+			otherValue, ok := right.Entries.Get(pair.Key)
+			if !ok {
+				return false
+			}
+
+			if !interpreter.fieldValuesEqual(pair.Value, otherValue) {
+				return false
+			}
+		}
+
+		return true
+
+	default:
+		return interpreter.testEqual(left, right)
+	}
 }
 
 func (v *CompositeValue) KeyString() string {
@@ -5624,6 +6327,107 @@ func (v *CompositeValue) TypeID() common.TypeID {
 	return v.Location.TypeID(v.QualifiedIdentifier)
 }
 
+// TupleValue
+
+type TupleValue struct {
+	Elements []Value
+	Owner    *common.Address
+	modified bool
+}
+
+func NewTupleValue(elements ...Value) *TupleValue {
+	return &TupleValue{
+		Elements: elements,
+		modified: true,
+	}
+}
+
+func (*TupleValue) IsValue() {}
+
+func (v *TupleValue) Accept(interpreter *Interpreter, visitor Visitor) {
+	descend := visitor.VisitTupleValue(interpreter, v)
+	if !descend {
+		return
+	}
+
+	for _, element := range v.Elements {
+		element.Accept(interpreter, visitor)
+	}
+}
+
+func (v *TupleValue) DynamicType(interpreter *Interpreter) DynamicType {
+	elementTypes := make([]DynamicType, len(v.Elements))
+
+	for i, element := range v.Elements {
+		elementTypes[i] = element.DynamicType(interpreter)
+	}
+
+	return TupleDynamicType{
+		ElementTypes: elementTypes,
+	}
+}
+
+func (*TupleValue) StaticType() StaticType {
+	// Tuples cannot be stored
+	return nil
+}
+
+func (v *TupleValue) Copy() Value {
+	copies := make([]Value, len(v.Elements))
+	for i, element := range v.Elements {
+		copies[i] = element.Copy()
+	}
+	return NewTupleValue(copies...)
+}
+
+func (v *TupleValue) GetOwner() *common.Address {
+	return v.Owner
+}
+
+func (v *TupleValue) SetOwner(owner *common.Address) {
+	if v.Owner == owner {
+		return
+	}
+
+	v.Owner = owner
+
+	for _, element := range v.Elements {
+		element.SetOwner(owner)
+	}
+}
+
+func (v *TupleValue) IsModified() bool {
+	if v.modified {
+		return true
+	}
+
+	for _, element := range v.Elements {
+		if element.IsModified() {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (v *TupleValue) SetModified(modified bool) {
+	v.modified = modified
+}
+
+func (v *TupleValue) Destroy(interpreter *Interpreter, getLocationRange func() LocationRange) {
+	for _, element := range v.Elements {
+		maybeDestroy(interpreter, getLocationRange, element)
+	}
+}
+
+func (v *TupleValue) String() string {
+	elements := make([]string, len(v.Elements))
+	for i, element := range v.Elements {
+		elements[i] = element.String()
+	}
+	return format.Tuple(elements)
+}
+
 // DictionaryValue
 
 type DictionaryValue struct {
@@ -5648,6 +6452,9 @@ type DictionaryValue struct {
 	// prevDeferredKeys are the keys which are deferred and have been loaded from storage,
 	// i.e. they are keys that were previously in DeferredKeys.
 	prevDeferredKeys *orderedmap.StringStructOrderedMap
+	// iterations tracks the iterations over the dictionary which are in progress.
+	// Keys must not be inserted or removed while an iteration is in progress.
+	iterations iterationGuard
 }
 
 func NewDictionaryValueUnownedNonCopying(keysAndValues ...Value) *DictionaryValue {
@@ -5961,11 +6768,69 @@ func (v *DictionaryValue) GetMember(interpreter *Interpreter, getLocationRange f
 			},
 		)
 
+	case "merge":
+		return NewHostFunctionValue(
+			func(invocation Invocation) Value {
+				other := invocation.Arguments[0].(*DictionaryValue)
+				overwrite := bool(invocation.Arguments[1].(BoolValue))
+				otherType := invocation.ArgumentTypes[0].(*sema.DictionaryType)
+
+				v.Merge(
+					invocation.Interpreter,
+					invocation.GetLocationRange,
+					other,
+					overwrite,
+					otherType.ValueType.IsResourceType(),
+				)
+
+				return VoidValue{}
+			},
+		)
+
+	case "forEachKey":
+		return NewHostFunctionValue(
+			func(invocation Invocation) Value {
+
+				function := invocation.Arguments[0].(FunctionValue)
+				functionType := invocation.ArgumentTypes[0].(*sema.FunctionType)
+				keyType := functionType.Parameters[0].TypeAnnotation.Type
+
+				v.ForEachKey(func(keyValue Value) bool {
+					functionInvocation := Invocation{
+						Arguments:        []Value{keyValue.Copy()},
+						ArgumentTypes:    []sema.Type{keyType},
+						GetLocationRange: invocation.GetLocationRange,
+						Interpreter:      invocation.Interpreter,
+					}
+
+					return bool(function.Invoke(functionInvocation).(BoolValue))
+				})
+
+				return VoidValue{}
+			},
+		)
+
 	}
 
 	return nil
 }
 
+// ForEachKey calls the given function for each key of the dictionary, in insertion order,
+// until the function returns false.
+//
+// Inserting or removing keys while the iteration is in progress
+// results in a ContainerMutatedDuringIterationError
+//
+func (v *DictionaryValue) ForEachKey(f func(keyValue Value) bool) {
+	v.iterations.iterate(func() {
+		for _, keyValue := range v.Keys.Values {
+			if !f(keyValue) {
+				return
+			}
+		}
+	})
+}
+
 func (v *DictionaryValue) SetMember(_ *Interpreter, _ func() LocationRange, _ string, _ Value) {
 	// Dictionaries have no settable members (fields / functions)
 	panic(errors.NewUnreachableError())
@@ -5975,6 +6840,40 @@ func (v *DictionaryValue) Count() int {
 	return v.Keys.Count()
 }
 
+// Merge inserts all entries of the other dictionary, in insertion order.
+//
+// Existing values are replaced if overwrite is true, and kept otherwise.
+// If the values are resources, the dictionary must not contain any of the other dictionary's keys,
+// otherwise a DictionaryMergeResourceLossError is reported before any entry is inserted.
+//
+func (v *DictionaryValue) Merge(
+	inter *Interpreter,
+	getLocationRange func() LocationRange,
+	other *DictionaryValue,
+	overwrite bool,
+	resourceValues bool,
+) {
+	if resourceValues {
+		for _, keyValue := range other.Keys.Values {
+			if bool(v.ContainsKey(keyValue)) {
+				panic(DictionaryMergeResourceLossError{
+					Key:           keyValue,
+					LocationRange: getLocationRange(),
+				})
+			}
+		}
+	}
+
+	for _, keyValue := range other.Keys.Values {
+		if !overwrite && bool(v.ContainsKey(keyValue)) {
+			continue
+		}
+
+		value := other.Get(inter, getLocationRange, keyValue).(*SomeValue).Value
+		_ = v.Insert(inter, getLocationRange, keyValue.Copy(), value)
+	}
+}
+
 // TODO: unset owner?
 func (v *DictionaryValue) Remove(inter *Interpreter, getLocationRange func() LocationRange, keyValue Value) OptionalValue {
 	v.modified = true
@@ -5982,6 +6881,12 @@ func (v *DictionaryValue) Remove(inter *Interpreter, getLocationRange func() Loc
 	// Don't use `Entries` here: the value might be deferred and needs to be loaded
 	value := v.Get(inter, getLocationRange, keyValue)
 
+	// Removing an existing key is a structural mutation
+
+	if _, ok := value.(*SomeValue); ok {
+		v.iterations.checkNotIterating(getLocationRange)
+	}
+
 	key := dictionaryKey(keyValue)
 
 	// If a resource that was previously deferred is removed from the dictionary,
@@ -6025,6 +6930,12 @@ func (v *DictionaryValue) Insert(inter *Interpreter, locationRangeGetter func()
 	// Don't use `Entries` here: the value might be deferred and needs to be loaded
 	existingValue := v.Get(inter, locationRangeGetter, keyValue)
 
+	// Inserting a new key is a structural mutation
+
+	if _, ok := existingValue.(NilValue); ok {
+		v.iterations.checkNotIterating(locationRangeGetter)
+	}
+
 	key := dictionaryKey(keyValue)
 
 	value.SetOwner(v.Owner)
@@ -6548,6 +7459,136 @@ func ConvertAddress(value Value) AddressValue {
 	return result
 }
 
+// newAddressFromBytesFunction returns the function `Address.fromBytes`,
+// which returns nil if the number of bytes is not exactly the size of an address
+//
+func newAddressFromBytesFunction() HostFunctionValue {
+	return NewHostFunctionValue(
+		func(invocation Invocation) Value {
+			bytes, err := ByteArrayValueToByteSlice(invocation.Arguments[0])
+			if err != nil {
+				panic(err)
+			}
+
+			if len(bytes) != common.AddressLength {
+				return NilValue{}
+			}
+
+			return NewSomeValueOwningNonCopying(
+				NewAddressValueFromBytes(bytes),
+			)
+		},
+	)
+}
+
+// newAddressFromStringFunction returns the function `Address.fromString`,
+// which returns nil if the string is not the `0x`-prefixed, hexadecimal representation
+// of an address, zero-padded to the full length of an address
+//
+func newAddressFromStringFunction() HostFunctionValue {
+	return NewHostFunctionValue(
+		func(invocation Invocation) Value {
+			stringValue, ok := invocation.Arguments[0].(*StringValue)
+			if !ok {
+				panic(errors.NewUnreachableError())
+			}
+
+			const prefix = "0x"
+
+			str := stringValue.Str
+			if !strings.HasPrefix(str, prefix) ||
+				len(str) != len(prefix)+hex.EncodedLen(common.AddressLength) {
+
+				return NilValue{}
+			}
+
+			bytes, err := hex.DecodeString(str[len(prefix):])
+			if err != nil {
+				return NilValue{}
+			}
+
+			return NewSomeValueOwningNonCopying(
+				NewAddressValueFromBytes(bytes),
+			)
+		},
+	)
+}
+
+// base64Encoding returns the Base64 encoding selected by the optional
+// second argument of the invocation, which is the standard encoding by default.
+//
+func base64Encoding(invocation Invocation) *base64.Encoding {
+	if len(invocation.Arguments) > 1 {
+		urlSafe, ok := invocation.Arguments[1].(BoolValue)
+		if !ok {
+			panic(errors.NewUnreachableError())
+		}
+
+		if urlSafe {
+			return base64.URLEncoding
+		}
+	}
+
+	return base64.StdEncoding
+}
+
+func newStringEncodeBase64Function() HostFunctionValue {
+	return NewHostFunctionValue(
+		func(invocation Invocation) Value {
+			arrayValue, ok := invocation.Arguments[0].(*ArrayValue)
+			if !ok {
+				panic(errors.NewUnreachableError())
+			}
+
+			bytes, err := ByteArrayValueToByteSlice(arrayValue)
+			if err != nil {
+				panic(err)
+			}
+
+			return NewStringValue(base64Encoding(invocation).EncodeToString(bytes))
+		},
+	)
+}
+
+func newStringDecodeBase64Function() HostFunctionValue {
+	return NewHostFunctionValue(
+		func(invocation Invocation) Value {
+			stringValue, ok := invocation.Arguments[0].(*StringValue)
+			if !ok {
+				panic(errors.NewUnreachableError())
+			}
+
+			bytes, err := base64Encoding(invocation).DecodeString(stringValue.Str)
+			if err != nil {
+				return NilValue{}
+			}
+
+			return NewSomeValueOwningNonCopying(
+				ByteSliceToByteArrayValue(bytes),
+			)
+		},
+	)
+}
+
+func newStringFromUTF8Function() HostFunctionValue {
+	return NewHostFunctionValue(
+		func(invocation Invocation) Value {
+			bytes, err := ByteArrayValueToByteSlice(invocation.Arguments[0])
+			if err != nil {
+				panic(err)
+			}
+
+			if !utf8.Valid(bytes) {
+				return NilValue{}
+			}
+
+			return NewSomeValueOwningNonCopying(
+				NewStringValue(string(bytes)),
+			)
+		},
+	)
+}
+
 func (AddressValue) IsValue() {}
 
 func (v AddressValue) Accept(interpreter *Interpreter, visitor Visitor) {
@@ -6613,7 +7654,7 @@ func (v AddressValue) GetMember(_ *Interpreter, _ func() LocationRange, name str
 	case sema.ToStringFunctionName:
 		return NewHostFunctionValue(
 			func(invocation Invocation) Value {
-				return NewStringValue(v.String())
+				return NewStringValue(v.ToAddress().HexWithPrefix())
 			},
 		)
 
@@ -7195,8 +8236,15 @@ func NewPublicAccountKeysValue(getFunction FunctionValue) *CompositeValue {
 }
 
 func NewCryptoAlgorithmEnumCaseValue(enumType *sema.CompositeType, rawValue uint8) *CompositeValue {
+	return NewBuiltinEnumCaseValue(enumType, uint64(rawValue))
+}
+
+// NewBuiltinEnumCaseValue constructs the value of a case of a built-in enum type,
+// see sema.NewBuiltinEnumType.
+//
+func NewBuiltinEnumCaseValue(enumType *sema.CompositeType, rawValue uint64) *CompositeValue {
 	fields := NewStringValueOrderedMap()
-	fields.Set(sema.EnumRawValueFieldName, UInt8Value(rawValue))
+	fields.Set(sema.EnumRawValueFieldName, builtinEnumRawValue(enumType.EnumRawType, rawValue))
 
 	return &CompositeValue{
 		QualifiedIdentifier: enumType.QualifiedIdentifier(),
@@ -7204,3 +8252,53 @@ func NewCryptoAlgorithmEnumCaseValue(enumType *sema.CompositeType, rawValue uint
 		Fields:              fields,
 	}
 }
+
+// builtinEnumRawValue returns the raw value of a built-in enum case
+// as a value of the enum's raw type, so the enum constructor,
+// which compares the byte representations of the raw values,
+// finds the case for an argument of the raw type.
+//
+func builtinEnumRawValue(rawType sema.Type, rawValue uint64) IntegerValue {
+	value := UInt64Value(rawValue)
+
+	switch rawType.(type) {
+	case *sema.IntType:
+		return ConvertInt(value)
+	case *sema.Int8Type:
+		return ConvertInt8(value)
+	case *sema.Int16Type:
+		return ConvertInt16(value)
+	case *sema.Int32Type:
+		return ConvertInt32(value)
+	case *sema.Int64Type:
+		return ConvertInt64(value)
+	case *sema.Int128Type:
+		return ConvertInt128(value)
+	case *sema.Int256Type:
+		return ConvertInt256(value)
+	case *sema.UIntType:
+		return ConvertUInt(value)
+	case *sema.UInt8Type:
+		return ConvertUInt8(value)
+	case *sema.UInt16Type:
+		return ConvertUInt16(value)
+	case *sema.UInt32Type:
+		return ConvertUInt32(value)
+	case *sema.UInt64Type:
+		return value
+	case *sema.UInt128Type:
+		return ConvertUInt128(value)
+	case *sema.UInt256Type:
+		return ConvertUInt256(value)
+	case *sema.Word8Type:
+		return ConvertWord8(value)
+	case *sema.Word16Type:
+		return ConvertWord16(value)
+	case *sema.Word32Type:
+		return ConvertWord32(value)
+	case *sema.Word64Type:
+		return ConvertWord64(value)
+	}
+
+	panic(errors.NewUnreachableError())
+}
@@ -0,0 +1,173 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2020 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package interpreter
+
+// CountValuesByKind returns the number of values of each kind
+// in the value graph of the given root value, including the root value itself.
+//
+// The kinds are stable names, e.g. "Composite", "Dictionary", or "UInt64".
+// Values of containers, e.g. the elements of arrays and the keys and values of dictionaries,
+// are counted as well.
+//
+func CountValuesByKind(interpreter *Interpreter, root Value) map[string]uint64 {
+
+	counts := map[string]uint64{}
+
+	count := func(kind string) {
+		counts[kind]++
+	}
+
+	root.Accept(interpreter, EmptyVisitor{
+		ValueVisitor: func(_ *Interpreter, _ Value) {
+			count("Value")
+		},
+		TypeValueVisitor: func(_ *Interpreter, _ TypeValue) {
+			count("Type")
+		},
+		VoidValueVisitor: func(_ *Interpreter, _ VoidValue) {
+			count("Void")
+		},
+		BoolValueVisitor: func(_ *Interpreter, _ BoolValue) {
+			count("Bool")
+		},
+		StringValueVisitor: func(_ *Interpreter, _ *StringValue) {
+			count("String")
+		},
+		ArrayValueVisitor: func(_ *Interpreter, _ *ArrayValue) bool {
+			count("Array")
+			return true
+		},
+		IntValueVisitor: func(_ *Interpreter, _ IntValue) {
+			count("Int")
+		},
+		Int8ValueVisitor: func(_ *Interpreter, _ Int8Value) {
+			count("Int8")
+		},
+		Int16ValueVisitor: func(_ *Interpreter, _ Int16Value) {
+			count("Int16")
+		},
+		Int32ValueVisitor: func(_ *Interpreter, _ Int32Value) {
+			count("Int32")
+		},
+		Int64ValueVisitor: func(_ *Interpreter, _ Int64Value) {
+			count("Int64")
+		},
+		Int128ValueVisitor: func(_ *Interpreter, _ Int128Value) {
+			count("Int128")
+		},
+		Int256ValueVisitor: func(_ *Interpreter, _ Int256Value) {
+			count("Int256")
+		},
+		UIntValueVisitor: func(_ *Interpreter, _ UIntValue) {
+			count("UInt")
+		},
+		UInt8ValueVisitor: func(_ *Interpreter, _ UInt8Value) {
+			count("UInt8")
+		},
+		UInt16ValueVisitor: func(_ *Interpreter, _ UInt16Value) {
+			count("UInt16")
+		},
+		UInt32ValueVisitor: func(_ *Interpreter, _ UInt32Value) {
+			count("UInt32")
+		},
+		UInt64ValueVisitor: func(_ *Interpreter, _ UInt64Value) {
+			count("UInt64")
+		},
+		UInt128ValueVisitor: func(_ *Interpreter, _ UInt128Value) {
+			count("UInt128")
+		},
+		UInt256ValueVisitor: func(_ *Interpreter, _ UInt256Value) {
+			count("UInt256")
+		},
+		Word8ValueVisitor: func(_ *Interpreter, _ Word8Value) {
+			count("Word8")
+		},
+		Word16ValueVisitor: func(_ *Interpreter, _ Word16Value) {
+			count("Word16")
+		},
+		Word32ValueVisitor: func(_ *Interpreter, _ Word32Value) {
+			count("Word32")
+		},
+		Word64ValueVisitor: func(_ *Interpreter, _ Word64Value) {
+			count("Word64")
+		},
+		Fix64ValueVisitor: func(_ *Interpreter, _ Fix64Value) {
+			count("Fix64")
+		},
+		UFix64ValueVisitor: func(_ *Interpreter, _ UFix64Value) {
+			count("UFix64")
+		},
+		CompositeValueVisitor: func(_ *Interpreter, _ *CompositeValue) bool {
+			count("Composite")
+			return true
+		},
+		DictionaryValueVisitor: func(_ *Interpreter, _ *DictionaryValue) bool {
+			count("Dictionary")
+			return true
+		},
+		NilValueVisitor: func(_ *Interpreter, _ NilValue) {
+			count("Nil")
+		},
+		SomeValueVisitor: func(_ *Interpreter, _ *SomeValue) bool {
+			count("Some")
+			return true
+		},
+		StorageReferenceValueVisitor: func(_ *Interpreter, _ *StorageReferenceValue) {
+			count("StorageReference")
+		},
+		EphemeralReferenceValueVisitor: func(_ *Interpreter, _ *EphemeralReferenceValue) {
+			count("EphemeralReference")
+		},
+		AddressValueVisitor: func(_ *Interpreter, _ AddressValue) {
+			count("Address")
+		},
+		AuthAccountValueVisitor: func(_ *Interpreter, _ AuthAccountValue) {
+			count("AuthAccount")
+		},
+		PublicAccountValueVisitor: func(_ *Interpreter, _ PublicAccountValue) {
+			count("PublicAccount")
+		},
+		PathValueVisitor: func(_ *Interpreter, _ PathValue) {
+			count("Path")
+		},
+		CapabilityValueVisitor: func(_ *Interpreter, _ CapabilityValue) {
+			count("Capability")
+		},
+		LinkValueVisitor: func(_ *Interpreter, _ LinkValue) {
+			count("Link")
+		},
+		InterpretedFunctionValueVisitor: func(_ *Interpreter, _ InterpretedFunctionValue) {
+			count("InterpretedFunction")
+		},
+		HostFunctionValueVisitor: func(_ *Interpreter, _ HostFunctionValue) {
+			count("HostFunction")
+		},
+		BoundFunctionValueVisitor: func(_ *Interpreter, _ BoundFunctionValue) {
+			count("BoundFunction")
+		},
+		AuthAccountContractsValueVisitor: func(_ *Interpreter, _ AuthAccountContractsValue) {
+			count("AuthAccountContracts")
+		},
+		DeployedContractValueVisitor: func(_ *Interpreter, _ DeployedContractValue) {
+			count("DeployedContract")
+		},
+	})
+
+	return counts
+}
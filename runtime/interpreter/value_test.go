@@ -19,12 +19,16 @@
 package interpreter
 
 import (
+	"math"
+	"math/big"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/common/orderedmap"
+	"github.com/onflow/cadence/runtime/sema"
 	"github.com/onflow/cadence/runtime/tests/utils"
 )
 
@@ -827,3 +831,1140 @@ func TestBlockValue(t *testing.T) {
 	const expectedTs UFix64Value = 5.0
 	assert.Equal(t, expectedTs, actualTs)
 }
+
+func TestPrettyPrintValue(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("literals", func(t *testing.T) {
+
+		t.Parallel()
+
+		fields := NewStringValueOrderedMap()
+		fields.Set("a", UInt8Value(1))
+		fields.Set("b", NewArrayValueUnownedNonCopying(
+			NewStringValue("x"),
+			NilValue{},
+		))
+
+		value := NewArrayValueUnownedNonCopying(
+			NewIntValueFromInt64(-42),
+			BoolValue(true),
+			NewSomeValueOwningNonCopying(Int16Value(-2)),
+			NewDictionaryValueUnownedNonCopying(
+				NewStringValue("key"),
+				UFix64Value(150000000),
+			),
+			AddressValue{0, 0, 0, 0, 0, 0, 0, 0x1},
+			NewCompositeValue(
+				utils.TestLocation,
+				"Test",
+				common.CompositeKindStructure,
+				fields,
+				nil,
+			),
+		)
+
+		result, err := PrettyPrintValue(nil, value)
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			`[-42, true, Int16(-2), {"key": UFix64(1.50000000)}, 0x1, Test(a: UInt8(1), b: ["x", nil])]`,
+			result,
+		)
+	})
+
+	t.Run("reference", func(t *testing.T) {
+
+		t.Parallel()
+
+		value := NewArrayValueUnownedNonCopying(
+			&EphemeralReferenceValue{
+				Value: BoolValue(true),
+			},
+		)
+
+		_, err := PrettyPrintValue(nil, value)
+		require.Error(t, err)
+		require.IsType(t, ValueNotPrintableError{}, err)
+	})
+
+	t.Run("function", func(t *testing.T) {
+
+		t.Parallel()
+
+		value := NewHostFunctionValue(
+			func(invocation Invocation) Value {
+				return VoidValue{}
+			},
+		)
+
+		_, err := PrettyPrintValue(nil, value)
+		require.Error(t, err)
+		require.IsType(t, ValueNotPrintableError{}, err)
+	})
+}
+
+func TestEstimateStorageSize(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("scalars", func(t *testing.T) {
+
+		t.Parallel()
+
+		require.Equal(t, uint64(2), EstimateStorageSize(nil, BoolValue(true)))
+		require.Equal(t, uint64(9), EstimateStorageSize(nil, UInt64Value(1)))
+		require.Equal(t, uint64(33), EstimateStorageSize(nil, NewUInt256ValueFromUint64(1)))
+		require.Equal(t, uint64(6), EstimateStorageSize(nil, NewStringValue("hello")))
+		require.Equal(t, uint64(2), EstimateStorageSize(nil, NewIntValueFromInt64(0)))
+		require.Equal(t, uint64(3), EstimateStorageSize(nil, NewIntValueFromInt64(256)))
+	})
+
+	t.Run("containers", func(t *testing.T) {
+
+		t.Parallel()
+
+		array := NewArrayValueUnownedNonCopying(
+			UInt8Value(1),
+			NewStringValue("ab"),
+		)
+
+		// array header + UInt8 + String
+		require.Equal(t, uint64(9+2+3), EstimateStorageSize(nil, array))
+
+		dictionary := NewDictionaryValueUnownedNonCopying(
+			NewStringValue("a"),
+			BoolValue(true),
+		)
+
+		// dictionary header + String key + Bool value
+		require.Equal(t, uint64(9+2+2), EstimateStorageSize(nil, dictionary))
+
+		fields := NewStringValueOrderedMap()
+		fields.Set("xs", array)
+
+		composite := NewCompositeValue(
+			utils.TestLocation,
+			"Test",
+			common.CompositeKindStructure,
+			fields,
+			nil,
+		)
+
+		// composite header, type ID, field name, array
+		require.Equal(t,
+			uint64(1+8+len("Test")+len(utils.TestLocation.ID())+len("xs"))+EstimateStorageSize(nil, array),
+			EstimateStorageSize(nil, composite),
+		)
+	})
+}
+
+func TestCompositeValueEqual(t *testing.T) {
+
+	t.Parallel()
+
+	newComposite := func(kind common.CompositeKind, identifier string, fieldValue Value) *CompositeValue {
+		fields := NewStringValueOrderedMap()
+		fields.Set("a", fieldValue)
+		fields.Set("b", NewStringValue("b"))
+		return NewCompositeValue(
+			utils.TestLocation,
+			identifier,
+			kind,
+			fields,
+			nil,
+		)
+	}
+
+	t.Run("equal", func(t *testing.T) {
+
+		t.Parallel()
+
+		require.True(t,
+			bool(newComposite(common.CompositeKindStructure, "S", UInt8Value(1)).
+				Equal(nil, newComposite(common.CompositeKindStructure, "S", UInt8Value(1)))),
+		)
+	})
+
+	t.Run("nested", func(t *testing.T) {
+
+		t.Parallel()
+
+		inner := newComposite(common.CompositeKindStructure, "T", UInt8Value(1))
+		otherInner := newComposite(common.CompositeKindStructure, "T", UInt8Value(1))
+
+		require.True(t,
+			bool(newComposite(common.CompositeKindStructure, "S", NewSomeValueOwningNonCopying(inner)).
+				Equal(nil, newComposite(common.CompositeKindStructure, "S", otherInner))),
+		)
+	})
+
+	t.Run("different field value", func(t *testing.T) {
+
+		t.Parallel()
+
+		require.False(t,
+			bool(newComposite(common.CompositeKindStructure, "S", UInt8Value(1)).
+				Equal(nil, newComposite(common.CompositeKindStructure, "S", UInt8Value(2)))),
+		)
+	})
+
+	t.Run("different type", func(t *testing.T) {
+
+		t.Parallel()
+
+		require.False(t,
+			bool(newComposite(common.CompositeKindStructure, "S", UInt8Value(1)).
+				Equal(nil, newComposite(common.CompositeKindStructure, "T", UInt8Value(1)))),
+		)
+	})
+
+	t.Run("array field", func(t *testing.T) {
+
+		t.Parallel()
+
+		newArray := func(values ...Value) *ArrayValue {
+			return NewArrayValueUnownedNonCopying(values...)
+		}
+
+		require.True(t,
+			bool(newComposite(common.CompositeKindStructure, "S", newArray(UInt8Value(1), UInt8Value(2))).
+				Equal(nil, newComposite(common.CompositeKindStructure, "S", newArray(UInt8Value(1), UInt8Value(2))))),
+		)
+
+		require.False(t,
+			bool(newComposite(common.CompositeKindStructure, "S", newArray(UInt8Value(1), UInt8Value(2))).
+				Equal(nil, newComposite(common.CompositeKindStructure, "S", newArray(UInt8Value(1), UInt8Value(3))))),
+		)
+
+		require.False(t,
+			bool(newComposite(common.CompositeKindStructure, "S", newArray(UInt8Value(1), UInt8Value(2))).
+				Equal(nil, newComposite(common.CompositeKindStructure, "S", newArray(UInt8Value(1))))),
+		)
+
+		// Nested arrays of composites
+
+		require.True(t,
+			bool(newComposite(
+				common.CompositeKindStructure,
+				"S",
+				newArray(newArray(newComposite(common.CompositeKindStructure, "T", UInt8Value(1)))),
+			).Equal(nil, newComposite(
+				common.CompositeKindStructure,
+				"S",
+				newArray(newArray(newComposite(common.CompositeKindStructure, "T", UInt8Value(1)))),
+			))),
+		)
+
+		require.False(t,
+			bool(newComposite(
+				common.CompositeKindStructure,
+				"S",
+				newArray(newArray(newComposite(common.CompositeKindStructure, "T", UInt8Value(1)))),
+			).Equal(nil, newComposite(
+				common.CompositeKindStructure,
+				"S",
+				newArray(newArray(newComposite(common.CompositeKindStructure, "T", UInt8Value(2)))),
+			))),
+		)
+	})
+
+	t.Run("dictionary field", func(t *testing.T) {
+
+		t.Parallel()
+
+		newDictionary := func(keysAndValues ...Value) *DictionaryValue {
+			return NewDictionaryValueUnownedNonCopying(keysAndValues...)
+		}
+
+		// Entries are compared independent of their order
+
+		require.True(t,
+			bool(newComposite(
+				common.CompositeKindStructure,
+				"S",
+				newDictionary(
+					NewStringValue("x"), UInt8Value(1),
+					NewStringValue("y"), NewArrayValueUnownedNonCopying(UInt8Value(2)),
+				),
+			).Equal(nil, newComposite(
+				common.CompositeKindStructure,
+				"S",
+				newDictionary(
+					NewStringValue("y"), NewArrayValueUnownedNonCopying(UInt8Value(2)),
+					NewStringValue("x"), UInt8Value(1),
+				),
+			))),
+		)
+
+		require.False(t,
+			bool(newComposite(
+				common.CompositeKindStructure,
+				"S",
+				newDictionary(NewStringValue("x"), UInt8Value(1)),
+			).Equal(nil, newComposite(
+				common.CompositeKindStructure,
+				"S",
+				newDictionary(NewStringValue("x"), UInt8Value(2)),
+			))),
+		)
+
+		require.False(t,
+			bool(newComposite(
+				common.CompositeKindStructure,
+				"S",
+				newDictionary(NewStringValue("x"), UInt8Value(1)),
+			).Equal(nil, newComposite(
+				common.CompositeKindStructure,
+				"S",
+				newDictionary(NewStringValue("y"), UInt8Value(1)),
+			))),
+		)
+
+		require.False(t,
+			bool(newComposite(
+				common.CompositeKindStructure,
+				"S",
+				newDictionary(NewStringValue("x"), UInt8Value(1)),
+			).Equal(nil, newComposite(
+				common.CompositeKindStructure,
+				"S",
+				NewArrayValueUnownedNonCopying(UInt8Value(1)),
+			))),
+		)
+	})
+
+	t.Run("resource", func(t *testing.T) {
+
+		t.Parallel()
+
+		require.PanicsWithValue(t,
+			ResourceComparisonError{
+				TypeID: "S.test.R",
+			},
+			func() {
+				newComposite(common.CompositeKindResource, "R", UInt8Value(1)).
+					Equal(nil, newComposite(common.CompositeKindResource, "R", UInt8Value(1)))
+			},
+		)
+	})
+}
+
+func TestCompositeValueGetSetField(t *testing.T) {
+
+	t.Parallel()
+
+	newComposite := func(kind common.CompositeKind) *CompositeValue {
+		fields := NewStringValueOrderedMap()
+		fields.Set("a", UInt8Value(1))
+		return NewCompositeValue(
+			utils.TestLocation,
+			"Test",
+			kind,
+			fields,
+			nil,
+		)
+	}
+
+	t.Run("get", func(t *testing.T) {
+
+		t.Parallel()
+
+		composite := newComposite(common.CompositeKindStructure)
+
+		value, ok := composite.GetField(nil, "a")
+		require.True(t, ok)
+		assert.Equal(t, UInt8Value(1), value)
+
+		_, ok = composite.GetField(nil, "b")
+		require.False(t, ok)
+	})
+
+	t.Run("set structure", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := &Interpreter{Location: common.StringLocation("other")}
+
+		composite := newComposite(common.CompositeKindStructure)
+
+		composite.SetField(inter, ReturnEmptyLocationRange, "a", UInt8Value(2))
+
+		value, ok := composite.GetField(inter, "a")
+		require.True(t, ok)
+		assert.Equal(t, UInt8Value(2), value)
+		assert.True(t, composite.IsModified())
+	})
+
+	t.Run("set resource in defining location", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := &Interpreter{Location: utils.TestLocation}
+
+		composite := newComposite(common.CompositeKindResource)
+
+		composite.SetField(inter, ReturnEmptyLocationRange, "a", UInt8Value(2))
+
+		value, ok := composite.GetField(inter, "a")
+		require.True(t, ok)
+		assert.Equal(t, UInt8Value(2), value)
+	})
+
+	t.Run("set resource in other location", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := &Interpreter{Location: common.StringLocation("other")}
+
+		composite := newComposite(common.CompositeKindResource)
+
+		assert.PanicsWithValue(t,
+			ResourceFieldAssignmentError{
+				TypeID:        composite.TypeID(),
+				FieldName:     "a",
+				LocationRange: ReturnEmptyLocationRange(),
+			},
+			func() {
+				composite.SetField(inter, ReturnEmptyLocationRange, "a", UInt8Value(2))
+			},
+		)
+
+		value, ok := composite.GetField(inter, "a")
+		require.True(t, ok)
+		assert.Equal(t, UInt8Value(1), value)
+	})
+}
+
+func TestFindOrphanedResources(t *testing.T) {
+
+	t.Parallel()
+
+	newResource := func() *CompositeValue {
+		return NewCompositeValue(
+			utils.TestLocation,
+			"R",
+			common.CompositeKindResource,
+			NewStringValueOrderedMap(),
+			nil,
+		)
+	}
+
+	newHolder := func(reference *EphemeralReferenceValue) *CompositeValue {
+		fields := NewStringValueOrderedMap()
+		fields.Set("ref", reference)
+
+		return NewCompositeValue(
+			utils.TestLocation,
+			"S",
+			common.CompositeKindStructure,
+			fields,
+			nil,
+		)
+	}
+
+	pathA := PathValue{Domain: common.PathDomainStorage, Identifier: "a"}
+	pathB := PathValue{Domain: common.PathDomainStorage, Identifier: "b"}
+	pathC := PathValue{Domain: common.PathDomainStorage, Identifier: "c"}
+
+	t.Run("stored", func(t *testing.T) {
+
+		t.Parallel()
+
+		resource := newResource()
+
+		snapshot := NewDictionaryValueUnownedNonCopying(
+			pathA, resource,
+			pathB, newHolder(&EphemeralReferenceValue{Value: resource}),
+		)
+
+		require.Empty(t, FindOrphanedResources(nil, snapshot))
+	})
+
+	t.Run("nested stored", func(t *testing.T) {
+
+		t.Parallel()
+
+		resource := newResource()
+
+		snapshot := NewDictionaryValueUnownedNonCopying(
+			pathA, NewArrayValueUnownedNonCopying(NewSomeValueOwningNonCopying(resource)),
+			pathB, newHolder(&EphemeralReferenceValue{Value: resource}),
+		)
+
+		require.Empty(t, FindOrphanedResources(nil, snapshot))
+	})
+
+	t.Run("orphaned", func(t *testing.T) {
+
+		t.Parallel()
+
+		resource := newResource()
+
+		snapshot := NewDictionaryValueUnownedNonCopying(
+			pathA, newResource(),
+			pathB, newHolder(&EphemeralReferenceValue{Value: resource}),
+			pathC, NewArrayValueUnownedNonCopying(
+				newHolder(&EphemeralReferenceValue{Value: resource}),
+				newHolder(&EphemeralReferenceValue{Value: newResource()}),
+			),
+		)
+
+		require.Equal(t,
+			[]PathValue{pathB, pathC},
+			FindOrphanedResources(nil, snapshot),
+		)
+	})
+}
+
+func TestCountValuesByKind(t *testing.T) {
+
+	t.Parallel()
+
+	fields := NewStringValueOrderedMap()
+	fields.Set("balance", UInt64Value(42))
+	fields.Set("owner", NewSomeValueOwningNonCopying(NewAddressValueFromBytes([]byte{0x1})))
+	fields.Set("tags", NewArrayValueUnownedNonCopying(
+		NewStringValue("a"),
+		NewStringValue("b"),
+	))
+
+	composite := NewCompositeValue(
+		utils.TestLocation,
+		"Vault",
+		common.CompositeKindResource,
+		fields,
+		nil,
+	)
+
+	root := NewDictionaryValueUnownedNonCopying(
+		PathValue{Domain: common.PathDomainStorage, Identifier: "vault"}, composite,
+		PathValue{Domain: common.PathDomainStorage, Identifier: "count"}, UInt64Value(1),
+		PathValue{Domain: common.PathDomainStorage, Identifier: "nothing"}, NilValue{},
+	)
+
+	require.Equal(t,
+		map[string]uint64{
+			"Dictionary": 1,
+			"Path":       3,
+			"Composite":  1,
+			"UInt64":     2,
+			"Some":       1,
+			"Address":    1,
+			"Array":      1,
+			"String":     2,
+			"Nil":        1,
+		},
+		CountValuesByKind(nil, root),
+	)
+}
+
+func TestDiffValues(t *testing.T) {
+
+	t.Parallel()
+
+	newComposite := func(fields map[string]Value) *CompositeValue {
+		fieldMap := NewStringValueOrderedMap()
+		for _, name := range []string{"a", "b", "c"} {
+			if value, ok := fields[name]; ok {
+				fieldMap.Set(name, value)
+			}
+		}
+
+		return NewCompositeValue(
+			utils.TestLocation,
+			"S",
+			common.CompositeKindStructure,
+			fieldMap,
+			nil,
+		)
+	}
+
+	t.Run("equal", func(t *testing.T) {
+
+		t.Parallel()
+
+		a := newComposite(map[string]Value{
+			"a": NewIntValueFromInt64(1),
+			"b": NewArrayValueUnownedNonCopying(NewStringValue("x")),
+		})
+		b := newComposite(map[string]Value{
+			"a": NewIntValueFromInt64(1),
+			"b": NewArrayValueUnownedNonCopying(NewStringValue("x")),
+		})
+
+		require.Empty(t, DiffValues(nil, a, b))
+	})
+
+	t.Run("nested", func(t *testing.T) {
+
+		t.Parallel()
+
+		a := newComposite(map[string]Value{
+			"a": NewIntValueFromInt64(1),
+			"b": NewArrayValueUnownedNonCopying(
+				NewStringValue("x"),
+				NewStringValue("y"),
+			),
+			"c": NewDictionaryValueUnownedNonCopying(
+				NewStringValue("k"), BoolValue(true),
+				NewStringValue("removed"), BoolValue(true),
+			),
+		})
+		b := newComposite(map[string]Value{
+			"a": NewIntValueFromInt64(2),
+			"b": NewArrayValueUnownedNonCopying(
+				NewStringValue("x"),
+			),
+			"c": NewDictionaryValueUnownedNonCopying(
+				NewStringValue("k"), BoolValue(false),
+				NewStringValue("added"), BoolValue(true),
+			),
+		})
+
+		require.Equal(t,
+			[]ValueDifference{
+				{Path: ".a", OldValue: "1", NewValue: "2"},
+				{Path: ".b[1]", OldValue: `"y"`, NewValue: ""},
+				{Path: `.c["k"]`, OldValue: "true", NewValue: "false"},
+				{Path: `.c["removed"]`, OldValue: "true", NewValue: ""},
+				{Path: `.c["added"]`, OldValue: "", NewValue: "true"},
+			},
+			DiffValues(nil, a, b),
+		)
+	})
+
+	t.Run("type mismatch", func(t *testing.T) {
+
+		t.Parallel()
+
+		a := newComposite(map[string]Value{
+			"a": UInt8Value(1),
+		})
+		b := newComposite(map[string]Value{
+			"a": NewStringValue("1"),
+		})
+
+		require.Equal(t,
+			[]ValueDifference{
+				{Path: ".a", OldValue: "UInt8(1)", NewValue: `"1"`},
+			},
+			DiffValues(nil, a, b),
+		)
+	})
+}
+
+func TestCheckedIntegerConversion(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("Int128 to Int8", func(t *testing.T) {
+
+		t.Parallel()
+
+		value, ok := NewInt128ValueFromInt64(math.MaxInt8).ToInt8Checked()
+		require.True(t, ok)
+		require.Equal(t, Int8Value(math.MaxInt8), value)
+
+		value, ok = NewInt128ValueFromInt64(math.MinInt8).ToInt8Checked()
+		require.True(t, ok)
+		require.Equal(t, Int8Value(math.MinInt8), value)
+
+		_, ok = NewInt128ValueFromInt64(math.MaxInt8 + 1).ToInt8Checked()
+		require.False(t, ok)
+
+		_, ok = NewInt128ValueFromInt64(math.MinInt8 - 1).ToInt8Checked()
+		require.False(t, ok)
+	})
+
+	t.Run("Int256 to Int64", func(t *testing.T) {
+
+		t.Parallel()
+
+		value, ok := NewInt256ValueFromInt64(math.MaxInt64).ToInt64Checked()
+		require.True(t, ok)
+		require.Equal(t, Int64Value(math.MaxInt64), value)
+
+		value, ok = NewInt256ValueFromInt64(math.MinInt64).ToInt64Checked()
+		require.True(t, ok)
+		require.Equal(t, Int64Value(math.MinInt64), value)
+
+		aboveMax := new(big.Int).Add(big.NewInt(math.MaxInt64), big.NewInt(1))
+		_, ok = NewInt256ValueFromBigInt(aboveMax).ToInt64Checked()
+		require.False(t, ok)
+
+		belowMin := new(big.Int).Sub(big.NewInt(math.MinInt64), big.NewInt(1))
+		_, ok = NewInt256ValueFromBigInt(belowMin).ToInt64Checked()
+		require.False(t, ok)
+	})
+
+	t.Run("Int256 to Int128", func(t *testing.T) {
+
+		t.Parallel()
+
+		value, ok := NewInt256ValueFromBigInt(sema.Int128TypeMaxIntBig).ToInt128Checked()
+		require.True(t, ok)
+		require.Equal(t, NewInt128ValueFromBigInt(sema.Int128TypeMaxIntBig), value)
+
+		aboveMax := new(big.Int).Add(sema.Int128TypeMaxIntBig, big.NewInt(1))
+		_, ok = NewInt256ValueFromBigInt(aboveMax).ToInt128Checked()
+		require.False(t, ok)
+
+		belowMin := new(big.Int).Sub(sema.Int128TypeMinIntBig, big.NewInt(1))
+		_, ok = NewInt256ValueFromBigInt(belowMin).ToInt128Checked()
+		require.False(t, ok)
+	})
+}
+
+func TestDictionaryValueMutationDuringIteration(t *testing.T) {
+
+	t.Parallel()
+
+	newDictionary := func() *DictionaryValue {
+		return NewDictionaryValueUnownedNonCopying(
+			NewStringValue("a"), NewIntValueFromInt64(1),
+			NewStringValue("b"), NewIntValueFromInt64(2),
+		)
+	}
+
+	t.Run("insert", func(t *testing.T) {
+
+		t.Parallel()
+
+		dictionary := newDictionary()
+
+		assert.PanicsWithValue(t,
+			ContainerMutatedDuringIterationError{},
+			func() {
+				dictionary.ForEachKey(func(_ Value) bool {
+					dictionary.Insert(nil, ReturnEmptyLocationRange, NewStringValue("c"), NewIntValueFromInt64(3))
+					return true
+				})
+			},
+		)
+
+		// The iteration is over, so the dictionary can be mutated again
+
+		dictionary.Insert(nil, ReturnEmptyLocationRange, NewStringValue("c"), NewIntValueFromInt64(3))
+		assert.Equal(t, 3, dictionary.Count())
+	})
+
+	t.Run("remove", func(t *testing.T) {
+
+		t.Parallel()
+
+		dictionary := newDictionary()
+
+		assert.PanicsWithValue(t,
+			ContainerMutatedDuringIterationError{},
+			func() {
+				dictionary.ForEachKey(func(_ Value) bool {
+					dictionary.Remove(nil, ReturnEmptyLocationRange, NewStringValue("b"))
+					return true
+				})
+			},
+		)
+	})
+
+	t.Run("update", func(t *testing.T) {
+
+		t.Parallel()
+
+		dictionary := newDictionary()
+
+		require.NotPanics(t, func() {
+			dictionary.ForEachKey(func(key Value) bool {
+				dictionary.Insert(nil, ReturnEmptyLocationRange, key, NewIntValueFromInt64(3))
+				return true
+			})
+		})
+	})
+}
+
+func TestContainsResource(t *testing.T) {
+
+	t.Parallel()
+
+	newComposite := func(kind common.CompositeKind, identifier string, fieldValue Value) *CompositeValue {
+		fields := NewStringValueOrderedMap()
+		if fieldValue != nil {
+			fields.Set("value", fieldValue)
+		}
+
+		return NewCompositeValue(
+			utils.TestLocation,
+			identifier,
+			kind,
+			fields,
+			nil,
+		)
+	}
+
+	newResource := func() *CompositeValue {
+		return newComposite(common.CompositeKindResource, "R", nil)
+	}
+
+	newStruct := func(fieldValue Value) *CompositeValue {
+		return newComposite(common.CompositeKindStructure, "S", fieldValue)
+	}
+
+	for name, value := range map[string]Value{
+		"resource": newResource(),
+		"optional": NewSomeValueOwningNonCopying(newResource()),
+		"array": NewArrayValueUnownedNonCopying(
+			NewIntValueFromInt64(1),
+			newResource(),
+		),
+		"dictionary": NewDictionaryValueUnownedNonCopying(
+			NewStringValue("a"), newStruct(nil),
+			NewStringValue("b"), newResource(),
+		),
+		"struct": newStruct(newResource()),
+		"nested": NewArrayValueUnownedNonCopying(
+			newStruct(
+				NewSomeValueOwningNonCopying(
+					NewDictionaryValueUnownedNonCopying(
+						NewIntValueFromInt64(1), newResource(),
+					),
+				),
+			),
+		),
+		"deferred dictionary": &DictionaryValue{
+			Keys:    NewArrayValueUnownedNonCopying(NewStringValue("a")),
+			Entries: NewStringValueOrderedMap(),
+			DeferredKeys: func() *orderedmap.StringStructOrderedMap {
+				keys := orderedmap.NewStringStructOrderedMap()
+				keys.Set("a", struct{}{})
+				return keys
+			}(),
+		},
+	} {
+		value := value
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			require.True(t, ContainsResource(nil, value))
+		})
+	}
+
+	for name, value := range map[string]Value{
+		"int":      NewIntValueFromInt64(1),
+		"string":   NewStringValue("test"),
+		"nil":      NilValue{},
+		"optional": NewSomeValueOwningNonCopying(NewIntValueFromInt64(1)),
+		"array": NewArrayValueUnownedNonCopying(
+			NewIntValueFromInt64(1),
+			newStruct(NewStringValue("test")),
+		),
+		"dictionary": NewDictionaryValueUnownedNonCopying(
+			NewStringValue("a"), newStruct(nil),
+		),
+		"reference": &EphemeralReferenceValue{Value: newResource()},
+		"struct":    newStruct(&EphemeralReferenceValue{Value: newResource()}),
+	} {
+		value := value
+
+		t.Run("no "+name, func(t *testing.T) {
+			t.Parallel()
+
+			require.False(t, ContainsResource(nil, value))
+		})
+	}
+}
+
+func TestCollectCompositeTypes(t *testing.T) {
+
+	t.Parallel()
+
+	newComposite := func(kind common.CompositeKind, identifier string, fieldValue Value) *CompositeValue {
+		fields := NewStringValueOrderedMap()
+		if fieldValue != nil {
+			fields.Set("value", fieldValue)
+		}
+
+		return NewCompositeValue(
+			utils.TestLocation,
+			identifier,
+			kind,
+			fields,
+			nil,
+		)
+	}
+
+	newResource := func() *CompositeValue {
+		return newComposite(common.CompositeKindResource, "R", nil)
+	}
+
+	newStruct := func(fieldValue Value) *CompositeValue {
+		return newComposite(common.CompositeKindStructure, "S", fieldValue)
+	}
+
+	value := NewArrayValueUnownedNonCopying(
+		NewIntValueFromInt64(1),
+		newStruct(newResource()),
+		NewSomeValueOwningNonCopying(newResource()),
+		NewDictionaryValueUnownedNonCopying(
+			NewStringValue("a"), newStruct(nil),
+			NewStringValue("b"), newResource(),
+		),
+		newStruct(&EphemeralReferenceValue{Value: newResource()}),
+	)
+
+	resourceTypeID := utils.TestLocation.TypeID("R")
+	structTypeID := utils.TestLocation.TypeID("S")
+
+	assert.Equal(t,
+		map[sema.TypeID]uint64{
+			resourceTypeID: 3,
+			structTypeID:   3,
+		},
+		CollectCompositeTypes(nil, value),
+	)
+
+	assert.Empty(t, CollectCompositeTypes(nil, NewIntValueFromInt64(1)))
+}
+
+func TestHashValue(t *testing.T) {
+
+	t.Parallel()
+
+	hashValue := func(t *testing.T, value Value) []byte {
+		digest, err := HashValue(nil, value, sema.HashAlgorithmSHA3_256)
+		require.NoError(t, err)
+		return digest
+	}
+
+	t.Run("algorithms", func(t *testing.T) {
+
+		t.Parallel()
+
+		for algorithm, length := range map[sema.HashAlgorithm]int{
+			sema.HashAlgorithmSHA2_256: 32,
+			sema.HashAlgorithmSHA2_384: 48,
+			sema.HashAlgorithmSHA3_256: 32,
+			sema.HashAlgorithmSHA3_384: 48,
+		} {
+			digest, err := HashValue(nil, NewStringValue("test"), algorithm)
+			require.NoError(t, err)
+			assert.Len(t, digest, length)
+		}
+
+		_, err := HashValue(nil, NewStringValue("test"), sema.HashAlgorithmUnknown)
+		require.ErrorAs(t, err, &UnsupportedHashAlgorithmError{})
+	})
+
+	t.Run("dictionary order", func(t *testing.T) {
+
+		t.Parallel()
+
+		assert.Equal(t,
+			hashValue(t, NewDictionaryValueUnownedNonCopying(
+				NewStringValue("a"), NewIntValueFromInt64(1),
+				NewStringValue("b"), NewIntValueFromInt64(2),
+			)),
+			hashValue(t, NewDictionaryValueUnownedNonCopying(
+				NewStringValue("b"), NewIntValueFromInt64(2),
+				NewStringValue("a"), NewIntValueFromInt64(1),
+			)),
+		)
+	})
+
+	t.Run("distinct values", func(t *testing.T) {
+
+		t.Parallel()
+
+		values := []Value{
+			NewIntValueFromInt64(1),
+			UInt8Value(1),
+			NewStringValue("1"),
+			NewStringValue("12"),
+			NewSomeValueOwningNonCopying(NewIntValueFromInt64(1)),
+			NilValue{},
+			VoidValue{},
+			BoolValue(true),
+			NewArrayValueUnownedNonCopying(NewStringValue("12")),
+			NewArrayValueUnownedNonCopying(NewStringValue("1"), NewStringValue("2")),
+			NewTupleValue(NewStringValue("1"), NewStringValue("2")),
+			NewDictionaryValueUnownedNonCopying(NewStringValue("1"), NewStringValue("2")),
+			newTestCompositeValue(common.Address{}),
+			NewAddressValueFromBytes([]byte{1}),
+			PathValue{Domain: common.PathDomainStorage, Identifier: "1"},
+			PathValue{Domain: common.PathDomainPublic, Identifier: "1"},
+		}
+
+		digests := map[string]int{}
+		for i, value := range values {
+			digest := string(hashValue(t, value))
+			if other, ok := digests[digest]; ok {
+				t.Errorf("values %d and %d have the same hash", other, i)
+			}
+			digests[digest] = i
+		}
+	})
+
+	t.Run("equal values", func(t *testing.T) {
+
+		t.Parallel()
+
+		newStruct := func() *CompositeValue {
+			fields := NewStringValueOrderedMap()
+			fields.Set("a", NewIntValueFromInt64(1))
+			fields.Set("b", NewArrayValueUnownedNonCopying(NewStringValue("test")))
+
+			return NewCompositeValue(
+				utils.TestLocation,
+				"S",
+				common.CompositeKindStructure,
+				fields,
+				nil,
+			)
+		}
+
+		assert.Equal(t,
+			hashValue(t, newStruct()),
+			hashValue(t, newStruct()),
+		)
+	})
+
+	t.Run("resource", func(t *testing.T) {
+
+		t.Parallel()
+
+		resource := NewCompositeValue(
+			utils.TestLocation,
+			"R",
+			common.CompositeKindResource,
+			NewStringValueOrderedMap(),
+			nil,
+		)
+
+		for _, value := range []Value{
+			resource,
+			NewArrayValueUnownedNonCopying(resource),
+			NewSomeValueOwningNonCopying(resource),
+		} {
+			_, err := HashValue(nil, value, sema.HashAlgorithmSHA3_256)
+			require.ErrorAs(t, err, &NonHashableValueError{})
+		}
+	})
+
+	t.Run("reference", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := HashValue(
+			nil,
+			&EphemeralReferenceValue{Value: NewStringValue("test")},
+			sema.HashAlgorithmSHA3_256,
+		)
+		require.ErrorAs(t, err, &NonHashableValueError{})
+	})
+}
+
+func TestImportCache(t *testing.T) {
+
+	t.Parallel()
+
+	owner := common.Address{0x1}
+
+	newContract := func() *CompositeValue {
+		// Values decoded from storage are unmodified
+
+		name := NewStringValue("test")
+		name.SetModified(false)
+
+		resourceFields := NewStringValueOrderedMap()
+		resourceFields.Set("name", name)
+
+		resource := NewCompositeValue(
+			utils.TestLocation,
+			"R",
+			common.CompositeKindResource,
+			resourceFields,
+			&owner,
+		)
+
+		resource.SetModified(false)
+
+		resources := NewArrayValueUnownedNonCopying(resource)
+		resources.SetModified(false)
+
+		fields := NewStringValueOrderedMap()
+		fields.Set("resources", resources)
+
+		contract := NewCompositeValue(
+			utils.TestLocation,
+			"C",
+			common.CompositeKindContract,
+			fields,
+			&owner,
+		)
+		contract.SetOwner(&owner)
+		contract.SetModified(false)
+
+		return contract
+	}
+
+	data := []byte{0x1, 0x2}
+
+	cache := NewImportCache()
+
+	_, ok := cache.Get(utils.TestLocation, data)
+	require.False(t, ok)
+
+	contract := newContract()
+	cache.Set(utils.TestLocation, data, contract)
+
+	_, ok = cache.Get(utils.TestLocation, []byte{0x3})
+	require.False(t, ok)
+
+	first, ok := cache.Get(utils.TestLocation, data)
+	require.True(t, ok)
+
+	second, ok := cache.Get(utils.TestLocation, data)
+	require.True(t, ok)
+
+	// The cached values are equal to the original value,
+	// retain the owner, and are unmodified
+
+	assert.Equal(t, contract, first)
+	assert.Equal(t, &owner, first.GetOwner())
+	assert.False(t, first.IsModified())
+
+	// The cached values are not shared,
+	// neither with the original value, nor with each other,
+	// not even nested resources
+
+	assert.NotSame(t, contract, first)
+	assert.NotSame(t, first, second)
+
+	getResource := func(value Value) *CompositeValue {
+		resources, ok := value.(*CompositeValue).Fields.Get("resources")
+		require.True(t, ok)
+		return resources.(*ArrayValue).Values[0].(*CompositeValue)
+	}
+
+	getResource(first).Fields.Set("name", NewStringValue("changed"))
+
+	name, ok := getResource(second).Fields.Get("name")
+	require.True(t, ok)
+	assert.Equal(t, "test", name.(*StringValue).Str)
+
+	// Mutating the original value does not affect the cache
+
+	getResource(contract).Fields.Set("name", NewStringValue("changed"))
+
+	third, ok := cache.Get(utils.TestLocation, data)
+	require.True(t, ok)
+
+	name, ok = getResource(third).Fields.Get("name")
+	require.True(t, ok)
+	assert.Equal(t, "test", name.(*StringValue).Str)
+
+	cache.Invalidate(utils.TestLocation)
+
+	_, ok = cache.Get(utils.TestLocation, data)
+	require.False(t, ok)
+}
@@ -76,6 +76,25 @@ func (a *VariableActivation) Set(name string, value *Variable) {
 	a.entries[name] = value
 }
 
+// Variables returns all variables which are visible in the activation,
+// i.e. the variables of the activation and the variables of its parents,
+// unless they are shadowed.
+//
+func (a *VariableActivation) Variables() map[string]*Variable {
+	variables := map[string]*Variable{}
+
+	for current := a; current != nil; current = current.Parent {
+		for name, variable := range current.entries {
+			if _, ok := variables[name]; ok {
+				continue
+			}
+			variables[name] = variable
+		}
+	}
+
+	return variables
+}
+
 // Activations is a stack of activation records.
 // Each entry represents a new activation record.
 //
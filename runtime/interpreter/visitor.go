@@ -25,6 +25,7 @@ type Visitor interface {
 	VisitBoolValue(interpreter *Interpreter, value BoolValue)
 	VisitStringValue(interpreter *Interpreter, value *StringValue)
 	VisitArrayValue(interpreter *Interpreter, value *ArrayValue) bool
+	VisitTupleValue(interpreter *Interpreter, value *TupleValue) bool
 	VisitIntValue(interpreter *Interpreter, value IntValue)
 	VisitInt8Value(interpreter *Interpreter, value Int8Value)
 	VisitInt16Value(interpreter *Interpreter, value Int16Value)
@@ -71,6 +72,7 @@ type EmptyVisitor struct {
 	BoolValueVisitor                 func(interpreter *Interpreter, value BoolValue)
 	StringValueVisitor               func(interpreter *Interpreter, value *StringValue)
 	ArrayValueVisitor                func(interpreter *Interpreter, value *ArrayValue) bool
+	TupleValueVisitor                func(interpreter *Interpreter, value *TupleValue) bool
 	IntValueVisitor                  func(interpreter *Interpreter, value IntValue)
 	Int8ValueVisitor                 func(interpreter *Interpreter, value Int8Value)
 	Int16ValueVisitor                func(interpreter *Interpreter, value Int16Value)
@@ -154,6 +156,13 @@ func (v EmptyVisitor) VisitArrayValue(interpreter *Interpreter, value *ArrayValu
 	return v.ArrayValueVisitor(interpreter, value)
 }
 
+func (v EmptyVisitor) VisitTupleValue(interpreter *Interpreter, value *TupleValue) bool {
+	if v.TupleValueVisitor == nil {
+		return true
+	}
+	return v.TupleValueVisitor(interpreter, value)
+}
+
 func (v EmptyVisitor) VisitIntValue(interpreter *Interpreter, value IntValue) {
 	if v.IntValueVisitor == nil {
 		return
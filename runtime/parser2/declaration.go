@@ -252,6 +252,21 @@ func parseVariableDeclaration(
 	p.next()
 
 	p.skipSpaceAndComments(true)
+
+	return parseVariableDeclarationRemainder(p, access, startPos, isLet, docString)
+}
+
+// parseVariableDeclarationRemainder parses a variable declaration
+// after the `let` or `var` keyword, starting at the identifier.
+//
+func parseVariableDeclarationRemainder(
+	p *parser,
+	access ast.Access,
+	startPos ast.Position,
+	isLet bool,
+	docString string,
+) *ast.VariableDeclaration {
+
 	if !p.current.Is(lexer.TokenIdentifier) {
 		panic(fmt.Errorf(
 			"expected identifier after start of variable declaration, got %s",
@@ -3784,6 +3784,43 @@ func TestParseInterface(t *testing.T) {
 	}
 }
 
+func TestParseInterfaceFunctionRequirementDocStrings(t *testing.T) {
+
+	t.Parallel()
+
+	result, errs := ParseProgram(`
+      struct interface Test {
+          /// A
+          fun a()
+          /// B
+          fun b(): Int
+
+          /// C
+          fun c() {}
+          /// D
+          fun d(): Int
+          /// E
+          let e: Int
+      }
+	`)
+	require.Empty(t, errs)
+
+	var docStrings []string
+	for _, declaration := range result.InterfaceDeclarations()[0].Members.Declarations() {
+		switch declaration := declaration.(type) {
+		case *ast.FunctionDeclaration:
+			docStrings = append(docStrings, declaration.DocString)
+		case *ast.FieldDeclaration:
+			docStrings = append(docStrings, declaration.DocString)
+		}
+	}
+
+	require.Equal(t,
+		[]string{" A", " B", " C", " D", " E"},
+		docStrings,
+	)
+}
+
 func TestPragmaNoArguments(t *testing.T) {
 
 	t.Parallel()
@@ -22,6 +22,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/onflow/cadence/fixedpoint"
 	"github.com/onflow/cadence/runtime/ast"
 	"github.com/onflow/cadence/runtime/common"
 	"github.com/onflow/cadence/runtime/errors"
@@ -139,7 +140,69 @@ func (e *InvalidIntegerLiteralError) SecondaryError() string {
 		return "did you mean `0x` (hexadecimal), `0b` (binary), or `0o` (octal)?"
 	case InvalidNumberLiteralKindMissingDigits:
 		return "consider adding a 0"
+	case InvalidNumberLiteralKindConsecutiveUnderscores:
+		return "remove the extra underscores"
+	case InvalidNumberLiteralKindNonIntegralExponent:
+		return "consider using a fixed-point literal"
+	case InvalidNumberLiteralKindExponentOutOfRange:
+		return fmt.Sprintf("the exponent must be at most %d", maxNumberLiteralExponent)
 	}
 
 	panic(errors.NewUnreachableError())
 }
+
+// InvalidFixedPointLiteralError
+
+type InvalidFixedPointLiteralError struct {
+	Literal                      string
+	InvalidFixedPointLiteralKind InvalidNumberLiteralKind
+	ast.Range
+}
+
+func (*InvalidFixedPointLiteralError) isParseError() {}
+
+func (e *InvalidFixedPointLiteralError) Error() string {
+	return fmt.Sprintf(
+		"invalid fixed-point literal `%s`: %s",
+		e.Literal,
+		e.InvalidFixedPointLiteralKind.Description(),
+	)
+}
+
+func (e *InvalidFixedPointLiteralError) SecondaryError() string {
+	switch e.InvalidFixedPointLiteralKind {
+	case InvalidNumberLiteralKindLeadingUnderscore:
+		return "remove the leading underscore"
+	case InvalidNumberLiteralKindTrailingUnderscore:
+		return "remove the trailing underscore"
+	case InvalidNumberLiteralKindConsecutiveUnderscores:
+		return "remove the extra underscores"
+	}
+
+	return ""
+}
+
+// FixedPointOverflowError is reported when a fixed-point literal in exponent notation
+// does not fit into any fixed-point type, or loses precision beyond the fixed-point scale
+//
+type FixedPointOverflowError struct {
+	Literal string
+	ast.Range
+}
+
+func (*FixedPointOverflowError) isParseError() {}
+
+func (e *FixedPointOverflowError) Error() string {
+	return fmt.Sprintf(
+		"fixed-point literal `%s` overflows",
+		e.Literal,
+	)
+}
+
+func (e *FixedPointOverflowError) SecondaryError() string {
+	return fmt.Sprintf(
+		"fixed-point values have at most %d fractional digits and an integer part of at most %d",
+		fixedpoint.Fix64Scale,
+		uint64(fixedpoint.UFix64TypeMaxInt),
+	)
+}
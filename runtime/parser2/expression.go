@@ -21,9 +21,11 @@ package parser2
 import (
 	"fmt"
 	"math/big"
+	"strconv"
 	"strings"
 	"unicode/utf8"
 
+	"github.com/onflow/cadence/fixedpoint"
 	"github.com/onflow/cadence/runtime/ast"
 	"github.com/onflow/cadence/runtime/errors"
 	"github.com/onflow/cadence/runtime/parser2/lexer"
@@ -410,8 +412,9 @@ func init() {
 
 	defineExpr(literalExpr{
 		tokenType: lexer.TokenFixedPointNumberLiteral,
-		nullDenotation: func(_ *parser, token lexer.Token) ast.Expression {
+		nullDenotation: func(p *parser, token lexer.Token) ast.Expression {
 			return parseFixedPointLiteral(
+				p,
 				token.Value.(string),
 				token.Range,
 			)
@@ -430,6 +433,18 @@ func init() {
 		},
 	})
 
+	defineExpr(literalExpr{
+		tokenType: lexer.TokenRawString,
+		nullDenotation: func(p *parser, token lexer.Token) ast.Expression {
+			parsedString, errs := parseRawStringLiteral(token.Value.(string))
+			p.report(errs...)
+			return &ast.StringExpression{
+				Value: parsedString,
+				Range: token.Range,
+			}
+		},
+	})
+
 	defineExpr(prefixExpr{
 		tokenType:    lexer.TokenMinus,
 		bindingPower: exprLeftBindingPowerUnaryPrefix,
@@ -762,6 +777,9 @@ func defineIdentifierExpression() {
 			case keywordFun:
 				return parseFunctionExpression(p, token)
 
+			case keywordSwitch:
+				return parseSwitchExpression(p, token)
+
 			default:
 				return &ast.IdentifierExpression{
 					Identifier: tokenToIdentifier(token),
@@ -784,6 +802,126 @@ func parseFunctionExpression(p *parser, token lexer.Token) *ast.FunctionExpressi
 	}
 }
 
+// parseSwitchExpression parses a switch expression.
+// The `switch` keyword has already been consumed.
+//
+//     switchExpression : `switch` expression `{` switchExpressionCase* `}`
+//
+func parseSwitchExpression(p *parser, startToken lexer.Token) *ast.SwitchExpression {
+
+	expression := parseExpression(p, lowestBindingPower)
+
+	p.mustOne(lexer.TokenBraceOpen)
+
+	cases := parseSwitchExpressionCases(p)
+
+	endToken := p.mustOne(lexer.TokenBraceClose)
+
+	return &ast.SwitchExpression{
+		Expression: expression,
+		Cases:      cases,
+		Range: ast.Range{
+			StartPos: startToken.StartPos,
+			EndPos:   endToken.EndPos,
+		},
+	}
+}
+
+// parseSwitchExpressionCases parses cases of a switch expression.
+// Cases may optionally be separated by semicolons.
+//
+//     switchExpressionCases : ( switchExpressionCase `;`? )*
+//
+func parseSwitchExpressionCases(p *parser) (cases []*ast.SwitchExpressionCase) {
+
+	reportUnexpected := func() {
+		p.report(fmt.Errorf(
+			"unexpected token: got %s, expected %q or %q",
+			p.current.Type,
+			keywordCase,
+			keywordDefault,
+		))
+		p.next()
+	}
+
+	for {
+		p.skipSpaceAndComments(true)
+
+		switch p.current.Type {
+		case lexer.TokenIdentifier:
+
+			var switchCase *ast.SwitchExpressionCase
+
+			switch p.current.Value {
+			case keywordCase:
+				switchCase = parseSwitchExpressionCase(p, true)
+
+			case keywordDefault:
+				switchCase = parseSwitchExpressionCase(p, false)
+
+			default:
+				reportUnexpected()
+				continue
+			}
+
+			cases = append(cases, switchCase)
+
+			p.skipSpaceAndComments(true)
+			if p.current.Is(lexer.TokenSemicolon) {
+				p.next()
+			}
+
+		case lexer.TokenBraceClose, lexer.TokenEOF:
+			return
+
+		default:
+			reportUnexpected()
+		}
+	}
+}
+
+// parseSwitchExpressionCase parses a switch expression case (hasExpression == true)
+// or default case (hasExpression == false)
+//
+//     switchExpressionCase : `case` expression `:` expression
+//                          | `default` `:` expression
+//
+func parseSwitchExpressionCase(p *parser, hasExpression bool) *ast.SwitchExpressionCase {
+
+	startPos := p.current.StartPos
+
+	// Skip the keyword
+	p.next()
+
+	var expression ast.Expression
+	if hasExpression {
+		expression = parseExpression(p, lowestBindingPower)
+	} else {
+		p.skipSpaceAndComments(true)
+	}
+
+	if !p.current.Is(lexer.TokenColon) {
+		p.report(fmt.Errorf(
+			"expected %s, got %s",
+			lexer.TokenColon,
+			p.current.Type,
+		))
+	}
+
+	p.next()
+
+	result := parseExpression(p, lowestBindingPower)
+
+	return &ast.SwitchExpressionCase{
+		Expression: expression,
+		Result:     result,
+		Range: ast.Range{
+			StartPos: startPos,
+			EndPos:   result.EndPosition(),
+		},
+	}
+}
+
 func defineCastingExpression() {
 
 	setExprIdentifierLeftBindingPower(keywordAs, exprLeftBindingPowerCasting)
@@ -952,10 +1090,33 @@ func parseArgument(p *parser) *ast.Argument {
 func defineNestedExpression() {
 	setExprNullDenotation(
 		lexer.TokenParenOpen,
-		func(p *parser, token lexer.Token) ast.Expression {
+		func(p *parser, startToken lexer.Token) ast.Expression {
 			expression := parseExpression(p, lowestBindingPower)
-			p.mustOne(lexer.TokenParenClose)
-			return expression
+
+			// If the expression is followed by a comma,
+			// it is the first element of a tuple expression, e.g. `(1, "a")`
+
+			if !p.current.Is(lexer.TokenComma) {
+				p.mustOne(lexer.TokenParenClose)
+				return expression
+			}
+
+			elements := []ast.Expression{expression}
+			for p.current.Is(lexer.TokenComma) {
+				p.mustOne(lexer.TokenComma)
+				element := parseExpression(p, lowestBindingPower)
+				elements = append(elements, element)
+			}
+
+			endToken := p.mustOne(lexer.TokenParenClose)
+
+			return &ast.TupleExpression{
+				Elements: elements,
+				Range: ast.Range{
+					StartPos: startToken.StartPos,
+					EndPos:   endToken.EndPos,
+				},
+			}
 		},
 	)
 }
@@ -1335,6 +1496,105 @@ func parseStringLiteral(literal string) (result string, errs []error) {
 	return
 }
 
+const rawStringLiteralDelimiter = `"""`
+
+// parseRawStringLiteral parses a raw string literal, i.e. a string literal
+// which is delimited by three quotes. Escape sequences are not processed.
+//
+// If the literal spans multiple lines, its indentation is removed,
+// see trimRawStringIndentation.
+//
+func parseRawStringLiteral(literal string) (result string, errs []error) {
+
+	if !strings.HasPrefix(literal, rawStringLiteralDelimiter) {
+		errs = append(errs, fmt.Errorf(
+			"invalid start of raw string literal: expected '%s'",
+			rawStringLiteralDelimiter,
+		))
+		return
+	}
+
+	content := literal[len(rawStringLiteralDelimiter):]
+
+	if strings.HasSuffix(content, rawStringLiteralDelimiter) {
+		content = content[:len(content)-len(rawStringLiteralDelimiter)]
+	} else {
+		errs = append(errs, fmt.Errorf(
+			"invalid end of raw string literal: missing '%s'",
+			rawStringLiteralDelimiter,
+		))
+	}
+
+	return trimRawStringIndentation(content), errs
+}
+
+// trimRawStringIndentation removes the indentation of the content of a multi-line raw string literal:
+//
+// - The first line is removed if it is blank, i.e. if the opening delimiter is followed by a line break
+// - The last line is removed if it is blank, i.e. if the closing delimiter is on its own line
+// - The longest common leading whitespace (spaces and tabs) of all non-blank lines
+//   is removed from all lines, and blank lines become empty
+//
+// The content of single-line raw string literals is returned unchanged.
+//
+func trimRawStringIndentation(content string) string {
+
+	if !strings.Contains(content, "\n") {
+		return content
+	}
+
+	isBlank := func(line string) bool {
+		return strings.TrimLeft(line, " \t\r") == ""
+	}
+
+	lines := strings.Split(content, "\n")
+
+	if isBlank(lines[0]) {
+		lines = lines[1:]
+	}
+
+	lastIndex := len(lines) - 1
+	if lastIndex >= 0 && isBlank(lines[lastIndex]) {
+		lines = lines[:lastIndex]
+	}
+
+	var indentation string
+	haveIndentation := false
+
+	for _, line := range lines {
+		if isBlank(line) {
+			continue
+		}
+
+		lineIndentation := line[:len(line)-len(strings.TrimLeft(line, " \t"))]
+
+		if !haveIndentation {
+			indentation = lineIndentation
+			haveIndentation = true
+			continue
+		}
+
+		commonLength := 0
+		for commonLength < len(indentation) &&
+			commonLength < len(lineIndentation) &&
+			indentation[commonLength] == lineIndentation[commonLength] {
+
+			commonLength++
+		}
+		indentation = indentation[:commonLength]
+	}
+
+	for i, line := range lines {
+		if isBlank(line) {
+			lines[i] = ""
+		} else {
+			lines[i] = line[len(indentation):]
+		}
+	}
+
+	return strings.Join(lines, "\n")
+}
+
 // parseStringLiteralContent parses the string literalExpr contents, excluding start and end quotes
 //
 func parseStringLiteralContent(s string) (result string, errs []error) {
@@ -1486,6 +1746,14 @@ func parseIntegerLiteral(p *parser, literal, text string, kind IntegerLiteralKin
 		)
 	}
 
+	// decimal literals may have an exponent, e.g. `1e3`
+
+	var exponent string
+	var hasExponent bool
+	if kind == IntegerLiteralKindDecimal {
+		text, exponent, hasExponent = splitExponent(text)
+	}
+
 	// check literal has no leading underscore
 
 	if strings.HasPrefix(text, "_") {
@@ -1497,6 +1765,11 @@ func parseIntegerLiteral(p *parser, literal, text string, kind IntegerLiteralKin
 		report(InvalidNumberLiteralKindTrailingUnderscore)
 	}
 
+	// check literal has no consecutive underscores
+	if strings.Contains(text, "__") {
+		report(InvalidNumberLiteralKindConsecutiveUnderscores)
+	}
+
 	withoutUnderscores := strings.Replace(text, "_", "", -1)
 
 	var value *big.Int
@@ -1524,6 +1797,29 @@ func parseIntegerLiteral(p *parser, literal, text string, kind IntegerLiteralKin
 		value = new(big.Int)
 	}
 
+	// apply the exponent, if any.
+	// the result must remain integral, e.g. `1e3` or `1500e-2`, but not `15e-2`
+
+	if hasExponent {
+		exp, ok := parseExponent(exponent)
+		if !ok {
+			report(InvalidNumberLiteralKindExponentOutOfRange)
+		} else if exp >= 0 {
+			value.Mul(value, pow10(uint(exp)))
+		} else {
+			quotient, remainder := new(big.Int).QuoRem(
+				value,
+				pow10(uint(-exp)),
+				new(big.Int),
+			)
+			if remainder.Sign() != 0 {
+				report(InvalidNumberLiteralKindNonIntegralExponent)
+			} else {
+				value = quotient
+			}
+		}
+	}
+
 	return &ast.IntegerExpression{
 		Value: value,
 		Base:  base,
@@ -1544,11 +1840,87 @@ func parseFixedPointPart(part string) (integer *big.Int, scale uint) {
 	return integer, scale
 }
 
-func parseFixedPointLiteral(text string, tokenRange ast.Range) *ast.FixedPointExpression {
-	parts := strings.Split(text, ".")
+// maxNumberLiteralExponent is the maximum magnitude of the exponent of a number literal.
+// It bounds the size of the value that needs to be computed by the parser
+//
+const maxNumberLiteralExponent = 1000
+
+// splitExponent splits the given decimal or fixed-point literal text
+// into the mantissa and the exponent, e.g. `1.5e-3` into `1.5` and `-3`
+//
+func splitExponent(text string) (mantissa string, exponent string, hasExponent bool) {
+	index := strings.IndexAny(text, "eE")
+	if index < 0 {
+		return text, "", false
+	}
+	return text[:index], text[index+1:], true
+}
+
+// parseExponent parses the given exponent, which has an optional sign.
+// It returns false if the exponent is invalid or its magnitude exceeds maxNumberLiteralExponent
+//
+func parseExponent(exponent string) (int, bool) {
+	exp, err := strconv.Atoi(exponent)
+	if err != nil ||
+		exp > maxNumberLiteralExponent ||
+		exp < -maxNumberLiteralExponent {
+
+		return 0, false
+	}
+	return exp, true
+}
+
+func pow10(exp uint) *big.Int {
+	return new(big.Int).Exp(
+		big.NewInt(10),
+		new(big.Int).SetUint64(uint64(exp)),
+		nil,
+	)
+}
+
+func parseFixedPointLiteral(p *parser, text string, tokenRange ast.Range) *ast.FixedPointExpression {
+	mantissa, exponent, hasExponent := splitExponent(text)
+	parts := strings.Split(mantissa, ".")
+
+	report := func(invalidKind InvalidNumberLiteralKind) {
+		p.report(
+			&InvalidFixedPointLiteralError{
+				Literal:                      text,
+				InvalidFixedPointLiteralKind: invalidKind,
+				Range:                        tokenRange,
+			},
+		)
+	}
+
+	// check both parts have no leading, trailing, or consecutive underscores,
+	// e.g. `1_.5`, `1._5`, or `1__0.5`
+
+	for _, part := range parts {
+		switch {
+		case strings.HasPrefix(part, "_"):
+			report(InvalidNumberLiteralKindLeadingUnderscore)
+		case strings.HasSuffix(part, "_"):
+			report(InvalidNumberLiteralKindTrailingUnderscore)
+		case strings.Contains(part, "__"):
+			report(InvalidNumberLiteralKindConsecutiveUnderscores)
+		}
+	}
+
 	integer, _ := parseFixedPointPart(parts[0])
 	fractional, scale := parseFixedPointPart(parts[1])
 
+	if hasExponent {
+		integer, fractional, scale = applyFixedPointExponent(
+			p,
+			text,
+			tokenRange,
+			integer,
+			fractional,
+			scale,
+			exponent,
+		)
+	}
+
 	return &ast.FixedPointExpression{
 		Negative:        false,
 		UnsignedInteger: integer,
@@ -1557,3 +1929,90 @@ func parseFixedPointLiteral(text string, tokenRange ast.Range) *ast.FixedPointEx
 		Range:           tokenRange,
 	}
 }
+
+// applyFixedPointExponent converts the fixed-point literal with the given exponent,
+// e.g. `1.5e3`, to its exact scaled representation, i.e. `1500.0`.
+//
+// A FixedPointOverflowError is reported if the result does not fit into any fixed-point type,
+// i.e. if the integer part is too large or it has more fractional digits than the fixed-point scale.
+//
+func applyFixedPointExponent(
+	p *parser,
+	text string,
+	tokenRange ast.Range,
+	integer *big.Int,
+	fractional *big.Int,
+	scale uint,
+	exponent string,
+) (
+	resultInteger *big.Int,
+	resultFractional *big.Int,
+	resultScale uint,
+) {
+	reportOverflow := func() (*big.Int, *big.Int, uint) {
+		p.report(
+			&FixedPointOverflowError{
+				Literal: text,
+				Range:   tokenRange,
+			},
+		)
+		return integer, fractional, scale
+	}
+
+	exp, ok := parseExponent(exponent)
+	if !ok {
+		return reportOverflow()
+	}
+
+	// mantissa = integer * 10^scale + fractional
+
+	mantissa := new(big.Int).Mul(integer, pow10(scale))
+	mantissa.Add(mantissa, fractional)
+
+	// the value is mantissa * 10^(exp - scale)
+
+	shift := exp - int(scale)
+	if shift >= 0 {
+		resultInteger = mantissa.Mul(mantissa, pow10(uint(shift)))
+		resultFractional = new(big.Int)
+		resultScale = 1
+	} else {
+		resultScale = uint(-shift)
+		resultInteger, resultFractional = new(big.Int).QuoRem(
+			mantissa,
+			pow10(resultScale),
+			new(big.Int),
+		)
+
+		// remove trailing zeros of the fractional part,
+		// they do not contribute to the precision of the value
+
+		ten := big.NewInt(10)
+		remainder := new(big.Int)
+		for resultScale > 1 {
+			quotient, _ := new(big.Int).QuoRem(resultFractional, ten, remainder)
+			if remainder.Sign() != 0 {
+				break
+			}
+			resultFractional = quotient
+			resultScale--
+		}
+	}
+
+	// normalize zero values, so they are equal to the ones of literals without an exponent
+
+	if resultInteger.Sign() == 0 {
+		resultInteger = new(big.Int)
+	}
+	if resultFractional.Sign() == 0 {
+		resultFractional = new(big.Int)
+	}
+
+	if resultScale > fixedpoint.Fix64Scale ||
+		resultInteger.Cmp(fixedpoint.UFix64TypeMaxIntBig) > 0 {
+
+		return reportOverflow()
+	}
+
+	return resultInteger, resultFractional, resultScale
+}
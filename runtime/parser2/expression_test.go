@@ -2936,6 +2936,80 @@ func TestParseIntegerLiterals(t *testing.T) {
 			result,
 		)
 	})
+
+	t.Run("decimal with exponent", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := ParseExpression(`1500e-2`)
+		require.Empty(t, errs)
+
+		utils.AssertEqualWithDiff(t,
+			&ast.IntegerExpression{
+				Value: big.NewInt(15),
+				Base:  10,
+				Range: ast.Range{
+					StartPos: ast.Position{Line: 1, Column: 0, Offset: 0},
+					EndPos:   ast.Position{Line: 1, Column: 6, Offset: 6},
+				},
+			},
+			result,
+		)
+	})
+
+	t.Run("decimal with non-integral exponent", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, errs := ParseExpression(`15e-2`)
+		utils.AssertEqualWithDiff(t,
+			[]error{
+				&InvalidIntegerLiteralError{
+					Literal:                   "15e-2",
+					IntegerLiteralKind:        IntegerLiteralKindDecimal,
+					InvalidIntegerLiteralKind: InvalidNumberLiteralKindNonIntegralExponent,
+					Range: ast.Range{
+						StartPos: ast.Position{Line: 1, Column: 0, Offset: 0},
+						EndPos:   ast.Position{Line: 1, Column: 4, Offset: 4},
+					},
+				},
+			},
+			errs,
+		)
+	})
+
+	t.Run("decimal with consecutive underscores", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := ParseExpression(`1__000`)
+		utils.AssertEqualWithDiff(t,
+			[]error{
+				&InvalidIntegerLiteralError{
+					Literal:                   "1__000",
+					IntegerLiteralKind:        IntegerLiteralKindDecimal,
+					InvalidIntegerLiteralKind: InvalidNumberLiteralKindConsecutiveUnderscores,
+					Range: ast.Range{
+						StartPos: ast.Position{Line: 1, Column: 0, Offset: 0},
+						EndPos:   ast.Position{Line: 1, Column: 5, Offset: 5},
+					},
+				},
+			},
+			errs,
+		)
+
+		utils.AssertEqualWithDiff(t,
+			&ast.IntegerExpression{
+				Value: big.NewInt(1000),
+				Base:  10,
+				Range: ast.Range{
+					StartPos: ast.Position{Line: 1, Column: 0, Offset: 0},
+					EndPos:   ast.Position{Line: 1, Column: 5, Offset: 5},
+				},
+			},
+			result,
+		)
+	})
 }
 
 func TestParseFixedPoint(t *testing.T) {
@@ -3015,6 +3089,261 @@ func TestParseFixedPoint(t *testing.T) {
 			result,
 		)
 	})
+
+	t.Run("leading underscore in fractional part", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := ParseExpression("1._5")
+		utils.AssertEqualWithDiff(t,
+			[]error{
+				&InvalidFixedPointLiteralError{
+					Literal:                      "1._5",
+					InvalidFixedPointLiteralKind: InvalidNumberLiteralKindLeadingUnderscore,
+					Range: ast.Range{
+						StartPos: ast.Position{Line: 1, Column: 0, Offset: 0},
+						EndPos:   ast.Position{Line: 1, Column: 3, Offset: 3},
+					},
+				},
+			},
+			errs,
+		)
+
+		utils.AssertEqualWithDiff(t,
+			&ast.FixedPointExpression{
+				Negative:        false,
+				UnsignedInteger: big.NewInt(1),
+				Fractional:      big.NewInt(5),
+				Scale:           1,
+				Range: ast.Range{
+					StartPos: ast.Position{Line: 1, Column: 0, Offset: 0},
+					EndPos:   ast.Position{Line: 1, Column: 3, Offset: 3},
+				},
+			},
+			result,
+		)
+	})
+
+	t.Run("trailing underscore in integer part", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, errs := ParseExpression("1_.5")
+		utils.AssertEqualWithDiff(t,
+			[]error{
+				&InvalidFixedPointLiteralError{
+					Literal:                      "1_.5",
+					InvalidFixedPointLiteralKind: InvalidNumberLiteralKindTrailingUnderscore,
+					Range: ast.Range{
+						StartPos: ast.Position{Line: 1, Column: 0, Offset: 0},
+						EndPos:   ast.Position{Line: 1, Column: 3, Offset: 3},
+					},
+				},
+			},
+			errs,
+		)
+	})
+
+	t.Run("consecutive underscores", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := ParseExpression("1__000.0")
+		utils.AssertEqualWithDiff(t,
+			[]error{
+				&InvalidFixedPointLiteralError{
+					Literal:                      "1__000.0",
+					InvalidFixedPointLiteralKind: InvalidNumberLiteralKindConsecutiveUnderscores,
+					Range: ast.Range{
+						StartPos: ast.Position{Line: 1, Column: 0, Offset: 0},
+						EndPos:   ast.Position{Line: 1, Column: 7, Offset: 7},
+					},
+				},
+			},
+			errs,
+		)
+
+		utils.AssertEqualWithDiff(t,
+			&ast.FixedPointExpression{
+				Negative:        false,
+				UnsignedInteger: big.NewInt(1000),
+				Fractional:      big.NewInt(0),
+				Scale:           1,
+				Range: ast.Range{
+					StartPos: ast.Position{Line: 1, Column: 0, Offset: 0},
+					EndPos:   ast.Position{Line: 1, Column: 7, Offset: 7},
+				},
+			},
+			result,
+		)
+	})
+
+	t.Run("with exponent", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := ParseExpression("1.5e3")
+		require.Empty(t, errs)
+
+		utils.AssertEqualWithDiff(t,
+			&ast.FixedPointExpression{
+				Negative:        false,
+				UnsignedInteger: big.NewInt(1500),
+				Fractional:      big.NewInt(0),
+				Scale:           1,
+				Range: ast.Range{
+					StartPos: ast.Position{Line: 1, Column: 0, Offset: 0},
+					EndPos:   ast.Position{Line: 1, Column: 4, Offset: 4},
+				},
+			},
+			result,
+		)
+	})
+
+	t.Run("with negative exponent", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := ParseExpression("1.5e-3")
+		require.Empty(t, errs)
+
+		utils.AssertEqualWithDiff(t,
+			&ast.FixedPointExpression{
+				Negative:        false,
+				UnsignedInteger: big.NewInt(0),
+				Fractional:      big.NewInt(15),
+				Scale:           4,
+				Range: ast.Range{
+					StartPos: ast.Position{Line: 1, Column: 0, Offset: 0},
+					EndPos:   ast.Position{Line: 1, Column: 5, Offset: 5},
+				},
+			},
+			result,
+		)
+	})
+
+	t.Run("with exponent, trailing zeros", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := ParseExpression("1.2500e1")
+		require.Empty(t, errs)
+
+		utils.AssertEqualWithDiff(t,
+			&ast.FixedPointExpression{
+				Negative:        false,
+				UnsignedInteger: big.NewInt(12),
+				Fractional:      big.NewInt(5),
+				Scale:           1,
+				Range: ast.Range{
+					StartPos: ast.Position{Line: 1, Column: 0, Offset: 0},
+					EndPos:   ast.Position{Line: 1, Column: 7, Offset: 7},
+				},
+			},
+			result,
+		)
+	})
+
+	t.Run("with exponent, at precision boundary", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := ParseExpression("1.2345678E-1")
+		require.Empty(t, errs)
+
+		utils.AssertEqualWithDiff(t,
+			&ast.FixedPointExpression{
+				Negative:        false,
+				UnsignedInteger: big.NewInt(0),
+				Fractional:      big.NewInt(12345678),
+				Scale:           8,
+				Range: ast.Range{
+					StartPos: ast.Position{Line: 1, Column: 0, Offset: 0},
+					EndPos:   ast.Position{Line: 1, Column: 11, Offset: 11},
+				},
+			},
+			result,
+		)
+	})
+
+	t.Run("with exponent, beyond precision boundary", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, errs := ParseExpression("1.2345678e-2")
+		utils.AssertEqualWithDiff(t,
+			[]error{
+				&FixedPointOverflowError{
+					Literal: "1.2345678e-2",
+					Range: ast.Range{
+						StartPos: ast.Position{Line: 1, Column: 0, Offset: 0},
+						EndPos:   ast.Position{Line: 1, Column: 11, Offset: 11},
+					},
+				},
+			},
+			errs,
+		)
+	})
+
+	t.Run("with exponent, at range boundary", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := ParseExpression("1.84467440737e11")
+		require.Empty(t, errs)
+
+		utils.AssertEqualWithDiff(t,
+			&ast.FixedPointExpression{
+				Negative:        false,
+				UnsignedInteger: big.NewInt(184467440737),
+				Fractional:      big.NewInt(0),
+				Scale:           1,
+				Range: ast.Range{
+					StartPos: ast.Position{Line: 1, Column: 0, Offset: 0},
+					EndPos:   ast.Position{Line: 1, Column: 15, Offset: 15},
+				},
+			},
+			result,
+		)
+	})
+
+	t.Run("with exponent, beyond range boundary", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, errs := ParseExpression("1.84467440738e11")
+		utils.AssertEqualWithDiff(t,
+			[]error{
+				&FixedPointOverflowError{
+					Literal: "1.84467440738e11",
+					Range: ast.Range{
+						StartPos: ast.Position{Line: 1, Column: 0, Offset: 0},
+						EndPos:   ast.Position{Line: 1, Column: 15, Offset: 15},
+					},
+				},
+			},
+			errs,
+		)
+	})
+
+	t.Run("with exponent out of range", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, errs := ParseExpression("1.0e1001")
+		utils.AssertEqualWithDiff(t,
+			[]error{
+				&FixedPointOverflowError{
+					Literal: "1.0e1001",
+					Range: ast.Range{
+						StartPos: ast.Position{Line: 1, Column: 0, Offset: 0},
+						EndPos:   ast.Position{Line: 1, Column: 7, Offset: 7},
+					},
+				},
+			},
+			errs,
+		)
+	})
 }
 
 func TestParseLessThanOrTypeArguments(t *testing.T) {
@@ -4770,6 +5099,126 @@ func TestParseStringEscapes(t *testing.T) {
 	utils.AssertEqualWithDiff(t, expected, actual)
 }
 
+func TestParseRawString(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("single line", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := ParseExpression(`"""  {"a": "\n"}  """`)
+		assert.Empty(t, errs)
+
+		utils.AssertEqualWithDiff(t,
+			&ast.StringExpression{
+				Value: `  {"a": "\n"}  `,
+				Range: ast.Range{
+					StartPos: ast.Position{Line: 1, Column: 0, Offset: 0},
+					EndPos:   ast.Position{Line: 1, Column: 20, Offset: 20},
+				},
+			},
+			result,
+		)
+	})
+
+	t.Run("indentation", func(t *testing.T) {
+
+		t.Parallel()
+
+		type test struct {
+			name     string
+			literal  string
+			expected string
+		}
+
+		tests := []test{
+			{
+				name:     "empty",
+				literal:  `""""""`,
+				expected: "",
+			},
+			{
+				name:     "delimiters on own lines",
+				literal:  "\"\"\"\n    a\n      b\n    c\n    \"\"\"",
+				expected: "a\n  b\nc",
+			},
+			{
+				name:     "blank lines",
+				literal:  "\"\"\"\n    a\n\n  \n    b\n\"\"\"",
+				expected: "a\n\n\nb",
+			},
+			{
+				name:     "content after opening delimiter",
+				literal:  "\"\"\"a\n    b\"\"\"",
+				expected: "a\n    b",
+			},
+			{
+				name:     "tabs",
+				literal:  "\"\"\"\n\t\ta\n\t\t\tb\n\t\"\"\"",
+				expected: "a\n\tb",
+			},
+			{
+				name:     "mixed spaces and tabs",
+				literal:  "\"\"\"\n\t a\n  b\n\"\"\"",
+				expected: "\t a\n  b",
+			},
+			{
+				name:     "no escape sequences",
+				literal:  "\"\"\"\n  \\n \\u{1F600} \"\n\"\"\"",
+				expected: "\\n \\u{1F600} \"",
+			},
+		}
+
+		for _, test := range tests {
+
+			test := test
+
+			t.Run(test.name, func(t *testing.T) {
+
+				t.Parallel()
+
+				result, errs := ParseExpression(test.literal)
+				assert.Empty(t, errs)
+
+				require.IsType(t, &ast.StringExpression{}, result)
+
+				assert.Equal(t,
+					test.expected,
+					result.(*ast.StringExpression).Value,
+				)
+			})
+		}
+	})
+
+	t.Run("invalid, missing end", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := ParseExpression(`"""test""`)
+		utils.AssertEqualWithDiff(t,
+			[]error{
+				&SyntaxError{
+					Message: "invalid end of raw string literal: missing '\"\"\"'",
+					Pos:     ast.Position{Offset: 9, Line: 1, Column: 9},
+				},
+			},
+			errs,
+		)
+
+		utils.AssertEqualWithDiff(t,
+			&ast.StringExpression{
+				Value: `test""`,
+				Range: ast.Range{
+					StartPos: ast.Position{Line: 1, Column: 0, Offset: 0},
+					EndPos:   ast.Position{Line: 1, Column: 8, Offset: 8},
+				},
+			},
+			result,
+		)
+	})
+}
+
 func TestParseStringWithUnicode(t *testing.T) {
 
 	t.Parallel()
@@ -5474,3 +5923,122 @@ func TestParseInvalidNegativeIntegerLiteralWithIncorrectPrefix(t *testing.T) {
 
 	require.Error(t, err)
 }
+
+func TestParseTupleExpression(t *testing.T) {
+
+	t.Parallel()
+
+	result, errs := ParseExpression(`(a, "b")`)
+	require.Empty(t, errs)
+
+	utils.AssertEqualWithDiff(t,
+		&ast.TupleExpression{
+			Elements: []ast.Expression{
+				&ast.IdentifierExpression{
+					Identifier: ast.Identifier{
+						Identifier: "a",
+						Pos:        ast.Position{Line: 1, Column: 1, Offset: 1},
+					},
+				},
+				&ast.StringExpression{
+					Value: "b",
+					Range: ast.Range{
+						StartPos: ast.Position{Line: 1, Column: 4, Offset: 4},
+						EndPos:   ast.Position{Line: 1, Column: 6, Offset: 6},
+					},
+				},
+			},
+			Range: ast.Range{
+				StartPos: ast.Position{Line: 1, Column: 0, Offset: 0},
+				EndPos:   ast.Position{Line: 1, Column: 7, Offset: 7},
+			},
+		},
+		result,
+	)
+}
+
+func TestParseSwitchExpression(t *testing.T) {
+
+	t.Parallel()
+
+	result, errs := ParseExpression(`switch x { case 1: "a"; default: "b" }`)
+	require.Empty(t, errs)
+
+	utils.AssertEqualWithDiff(t,
+		&ast.SwitchExpression{
+			Expression: &ast.IdentifierExpression{
+				Identifier: ast.Identifier{
+					Identifier: "x",
+					Pos:        ast.Position{Line: 1, Column: 7, Offset: 7},
+				},
+			},
+			Cases: []*ast.SwitchExpressionCase{
+				{
+					Expression: &ast.IntegerExpression{
+						Value: big.NewInt(1),
+						Base:  10,
+						Range: ast.Range{
+							StartPos: ast.Position{Line: 1, Column: 16, Offset: 16},
+							EndPos:   ast.Position{Line: 1, Column: 16, Offset: 16},
+						},
+					},
+					Result: &ast.StringExpression{
+						Value: "a",
+						Range: ast.Range{
+							StartPos: ast.Position{Line: 1, Column: 19, Offset: 19},
+							EndPos:   ast.Position{Line: 1, Column: 21, Offset: 21},
+						},
+					},
+					Range: ast.Range{
+						StartPos: ast.Position{Line: 1, Column: 11, Offset: 11},
+						EndPos:   ast.Position{Line: 1, Column: 21, Offset: 21},
+					},
+				},
+				{
+					Result: &ast.StringExpression{
+						Value: "b",
+						Range: ast.Range{
+							StartPos: ast.Position{Line: 1, Column: 33, Offset: 33},
+							EndPos:   ast.Position{Line: 1, Column: 35, Offset: 35},
+						},
+					},
+					Range: ast.Range{
+						StartPos: ast.Position{Line: 1, Column: 24, Offset: 24},
+						EndPos:   ast.Position{Line: 1, Column: 35, Offset: 35},
+					},
+				},
+			},
+			Range: ast.Range{
+				StartPos: ast.Position{Line: 1, Column: 0, Offset: 0},
+				EndPos:   ast.Position{Line: 1, Column: 37, Offset: 37},
+			},
+		},
+		result,
+	)
+}
+
+func TestParseSwitchExpressionCasesOnSeparateLines(t *testing.T) {
+
+	t.Parallel()
+
+	result, errs := ParseProgram(`
+      let x = switch y {
+          case 1: "a"
+          case 2: "b"
+          default: "c"
+      }
+    `)
+	require.Empty(t, errs)
+
+	declarations := result.Declarations()
+	require.Len(t, declarations, 1)
+
+	variableDeclaration, ok := declarations[0].(*ast.VariableDeclaration)
+	require.True(t, ok)
+
+	switchExpression, ok := variableDeclaration.Value.(*ast.SwitchExpression)
+	require.True(t, ok)
+
+	require.Len(t, switchExpression.Cases, 3)
+	require.Nil(t, switchExpression.Cases[2].Expression)
+}
@@ -215,13 +215,19 @@ func parseFunctionParameterListAndRest(
 ) {
 	parameterList = parseParameterList(p)
 
-	p.skipSpaceAndComments(true)
+	// NOTE: if the function block is optional, only skip the trivia
+	// if the function declaration continues.
+	// Otherwise the trivia might contain the doc string of the next declaration
+
+	if !functionBlockIsOptional || isNextTokenAfterTrivia(p, lexer.TokenColon, lexer.TokenBraceOpen) {
+		p.skipSpaceAndComments(true)
+	}
+
 	if p.current.Is(lexer.TokenColon) {
 		// Skip the colon
 		p.next()
 		p.skipSpaceAndComments(true)
 		returnTypeAnnotation = parseTypeAnnotation(p)
-		p.skipSpaceAndComments(true)
 	} else {
 		positionBeforeMissingReturnType := parameterList.EndPos
 		returnType := &ast.NominalType{
@@ -236,12 +242,30 @@ func parseFunctionParameterListAndRest(
 		}
 	}
 
-	p.skipSpaceAndComments(true)
-
 	if !functionBlockIsOptional ||
-		p.current.Is(lexer.TokenBraceOpen) {
+		isNextTokenAfterTrivia(p, lexer.TokenBraceOpen) {
 
+		p.skipSpaceAndComments(true)
 		functionBlock = parseFunctionBlock(p)
 	}
 	return
 }
+
+// isNextTokenAfterTrivia checks whether the next token after the current trivia
+// (space and comments) has one of the given types, without consuming the trivia.
+//
+func isNextTokenAfterTrivia(p *parser, tokenTypes ...lexer.TokenType) bool {
+	p.startBuffering()
+	defer p.replayBuffered()
+
+	p.skipSpaceAndComments(true)
+
+	// Lookahead the next token
+	for _, tokenType := range tokenTypes {
+		if p.current.Is(tokenType) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -32,6 +32,9 @@ const (
 	InvalidNumberLiteralKindTrailingUnderscore
 	InvalidNumberLiteralKindUnknownPrefix
 	InvalidNumberLiteralKindMissingDigits
+	InvalidNumberLiteralKindConsecutiveUnderscores
+	InvalidNumberLiteralKindNonIntegralExponent
+	InvalidNumberLiteralKindExponentOutOfRange
 )
 
 func (k InvalidNumberLiteralKind) Description() string {
@@ -44,6 +47,12 @@ func (k InvalidNumberLiteralKind) Description() string {
 		return "unknown prefix"
 	case InvalidNumberLiteralKindMissingDigits:
 		return "missing digits"
+	case InvalidNumberLiteralKindConsecutiveUnderscores:
+		return "consecutive underscores"
+	case InvalidNumberLiteralKindNonIntegralExponent:
+		return "exponent results in non-integral value"
+	case InvalidNumberLiteralKindExponentOutOfRange:
+		return "exponent out of range"
 	case InvalidNumberLiteralKindUnknown:
 		return "unknown"
 	}
@@ -13,11 +13,14 @@ func _() {
 	_ = x[InvalidNumberLiteralKindTrailingUnderscore-2]
 	_ = x[InvalidNumberLiteralKindUnknownPrefix-3]
 	_ = x[InvalidNumberLiteralKindMissingDigits-4]
+	_ = x[InvalidNumberLiteralKindConsecutiveUnderscores-5]
+	_ = x[InvalidNumberLiteralKindNonIntegralExponent-6]
+	_ = x[InvalidNumberLiteralKindExponentOutOfRange-7]
 }
 
-const _InvalidNumberLiteralKind_name = "InvalidNumberLiteralKindUnknownInvalidNumberLiteralKindLeadingUnderscoreInvalidNumberLiteralKindTrailingUnderscoreInvalidNumberLiteralKindUnknownPrefixInvalidNumberLiteralKindMissingDigits"
+const _InvalidNumberLiteralKind_name = "InvalidNumberLiteralKindUnknownInvalidNumberLiteralKindLeadingUnderscoreInvalidNumberLiteralKindTrailingUnderscoreInvalidNumberLiteralKindUnknownPrefixInvalidNumberLiteralKindMissingDigitsInvalidNumberLiteralKindConsecutiveUnderscoresInvalidNumberLiteralKindNonIntegralExponentInvalidNumberLiteralKindExponentOutOfRange"
 
-var _InvalidNumberLiteralKind_index = [...]uint8{0, 31, 72, 114, 151, 188}
+var _InvalidNumberLiteralKind_index = [...]uint16{0, 31, 72, 114, 151, 188, 234, 277, 319}
 
 func (i InvalidNumberLiteralKind) String() string {
 	if i >= InvalidNumberLiteralKind(len(_InvalidNumberLiteralKind_index)-1) {
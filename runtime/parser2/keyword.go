@@ -22,6 +22,7 @@ const (
 	keywordIf          = "if"
 	keywordElse        = "else"
 	keywordWhile       = "while"
+	keywordRepeat      = "repeat"
 	keywordBreak       = "break"
 	keywordContinue    = "continue"
 	keywordReturn      = "return"
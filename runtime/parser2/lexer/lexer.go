@@ -340,6 +340,26 @@ func (l *lexer) scanString(quote rune) {
 	}
 }
 
+// scanRawString scans the remainder of a raw string literal,
+// i.e. everything up to and including the closing three quotes.
+// Raw string literals may span multiple lines and have no escape sequences.
+//
+func (l *lexer) scanRawString() {
+	quotes := 0
+	for quotes < 3 {
+		switch l.next() {
+		case EOF:
+			// NOTE: invalid end of string handled by parser
+			l.backupOne()
+			return
+		case '"':
+			quotes++
+		default:
+			quotes = 0
+		}
+	}
+}
+
 func (l *lexer) scanBinaryRemainder() {
 	l.acceptWhile(func(r rune) bool {
 		return r == '0' || r == '1' || r == '_'
@@ -364,10 +384,14 @@ func (l *lexer) scanHexadecimalRemainder() {
 func (l *lexer) scanDecimalOrFixedPointRemainder() TokenType {
 	l.acceptWhile(isDecimalDigitOrUnderscore)
 	r := l.next()
-	if r == '.' {
+	switch r {
+	case '.':
 		l.scanFixedPointRemainder()
 		return TokenFixedPointNumberLiteral
-	} else {
+	case 'e', 'E':
+		l.scanExponentRemainder()
+		return TokenDecimalIntegerLiteral
+	default:
 		l.backupOne()
 		return TokenDecimalIntegerLiteral
 	}
@@ -381,8 +405,38 @@ func (l *lexer) scanFixedPointRemainder() {
 		return
 	}
 	l.acceptWhile(isDecimalDigitOrUnderscore)
+
+	r = l.next()
+	if r == 'e' || r == 'E' {
+		l.scanExponentRemainder()
+	} else {
+		l.backupOne()
+	}
+}
+
+// scanExponentRemainder scans the remainder of an exponent,
+// i.e. an optional sign followed by decimal digits.
+// The exponent marker (`e` or `E`) must already have been scanned.
+//
+func (l *lexer) scanExponentRemainder() {
+	r := l.next()
+	if r != '+' && r != '-' {
+		l.backupOne()
+	}
+
+	r = l.next()
+	if !isDecimalDigit(r) {
+		l.backupOne()
+		l.emitError(fmt.Errorf("missing exponent digits"))
+		return
+	}
+	l.acceptWhile(isDecimalDigit)
+}
+
+func isDecimalDigit(r rune) bool {
+	return r >= '0' && r <= '9'
 }
 
 func isDecimalDigitOrUnderscore(r rune) bool {
-	return (r >= '0' && r <= '9') || r == '_'
+	return isDecimalDigit(r) || r == '_'
 }
@@ -903,6 +903,119 @@ func TestLexString(t *testing.T) {
 	})
 }
 
+func TestLexRawString(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("valid, empty", func(t *testing.T) {
+		testLex(t,
+			`""""""`,
+			[]Token{
+				{
+					Type:  TokenRawString,
+					Value: `""""""`,
+					Range: ast.Range{
+						StartPos: ast.Position{Line: 1, Column: 0, Offset: 0},
+						EndPos:   ast.Position{Line: 1, Column: 5, Offset: 5},
+					},
+				},
+				{
+					Type: TokenEOF,
+					Range: ast.Range{
+						StartPos: ast.Position{Line: 1, Column: 6, Offset: 6},
+						EndPos:   ast.Position{Line: 1, Column: 6, Offset: 6},
+					},
+				},
+			},
+		)
+	})
+
+	t.Run("valid, multiple lines, quotes and backslashes", func(t *testing.T) {
+		testLex(t,
+			"\"\"\"\n  {\"a\": \"\\n\"}\n\"\"\"",
+			[]Token{
+				{
+					Type:  TokenRawString,
+					Value: "\"\"\"\n  {\"a\": \"\\n\"}\n\"\"\"",
+					Range: ast.Range{
+						StartPos: ast.Position{Line: 1, Column: 0, Offset: 0},
+						EndPos:   ast.Position{Line: 3, Column: 2, Offset: 20},
+					},
+				},
+				{
+					Type: TokenEOF,
+					Range: ast.Range{
+						StartPos: ast.Position{Line: 3, Column: 3, Offset: 21},
+						EndPos:   ast.Position{Line: 3, Column: 3, Offset: 21},
+					},
+				},
+			},
+		)
+	})
+
+	t.Run("invalid, missing end", func(t *testing.T) {
+		testLex(t,
+			`"""test""`,
+			[]Token{
+				{
+					Type:  TokenRawString,
+					Value: `"""test""`,
+					Range: ast.Range{
+						StartPos: ast.Position{Line: 1, Column: 0, Offset: 0},
+						EndPos:   ast.Position{Line: 1, Column: 8, Offset: 8},
+					},
+				},
+				{
+					Type: TokenEOF,
+					Range: ast.Range{
+						StartPos: ast.Position{Line: 1, Column: 9, Offset: 9},
+						EndPos:   ast.Position{Line: 1, Column: 9, Offset: 9},
+					},
+				},
+			},
+		)
+	})
+
+	t.Run("empty string followed by string", func(t *testing.T) {
+		testLex(t,
+			`"" "a"`,
+			[]Token{
+				{
+					Type:  TokenString,
+					Value: `""`,
+					Range: ast.Range{
+						StartPos: ast.Position{Line: 1, Column: 0, Offset: 0},
+						EndPos:   ast.Position{Line: 1, Column: 1, Offset: 1},
+					},
+				},
+				{
+					Type:  TokenSpace,
+					Value: Space{" ", false},
+					Range: ast.Range{
+						StartPos: ast.Position{Line: 1, Column: 2, Offset: 2},
+						EndPos:   ast.Position{Line: 1, Column: 2, Offset: 2},
+					},
+				},
+				{
+					Type:  TokenString,
+					Value: `"a"`,
+					Range: ast.Range{
+						StartPos: ast.Position{Line: 1, Column: 3, Offset: 3},
+						EndPos:   ast.Position{Line: 1, Column: 5, Offset: 5},
+					},
+				},
+				{
+					Type: TokenEOF,
+					Range: ast.Range{
+						StartPos: ast.Position{Line: 1, Column: 6, Offset: 6},
+						EndPos:   ast.Position{Line: 1, Column: 6, Offset: 6},
+					},
+				},
+			},
+		)
+	})
+}
+
 func TestLexBlockComment(t *testing.T) {
 
 	t.Parallel()
@@ -1712,6 +1825,83 @@ func TestLexFixedPoint(t *testing.T) {
 			},
 		)
 	})
+
+	t.Run("with exponent", func(t *testing.T) {
+		testLex(t,
+			"1.5e-3",
+			[]Token{
+				{
+					Type:  TokenFixedPointNumberLiteral,
+					Value: "1.5e-3",
+					Range: ast.Range{
+						StartPos: ast.Position{Line: 1, Column: 0, Offset: 0},
+						EndPos:   ast.Position{Line: 1, Column: 5, Offset: 5},
+					},
+				},
+				{
+					Type: TokenEOF,
+					Range: ast.Range{
+						StartPos: ast.Position{Line: 1, Column: 6, Offset: 6},
+						EndPos:   ast.Position{Line: 1, Column: 6, Offset: 6},
+					},
+				},
+			},
+		)
+	})
+
+	t.Run("integer with exponent", func(t *testing.T) {
+		testLex(t,
+			"15E+2",
+			[]Token{
+				{
+					Type:  TokenDecimalIntegerLiteral,
+					Value: "15E+2",
+					Range: ast.Range{
+						StartPos: ast.Position{Line: 1, Column: 0, Offset: 0},
+						EndPos:   ast.Position{Line: 1, Column: 4, Offset: 4},
+					},
+				},
+				{
+					Type: TokenEOF,
+					Range: ast.Range{
+						StartPos: ast.Position{Line: 1, Column: 5, Offset: 5},
+						EndPos:   ast.Position{Line: 1, Column: 5, Offset: 5},
+					},
+				},
+			},
+		)
+	})
+
+	t.Run("missing exponent digits", func(t *testing.T) {
+		testLex(t,
+			"1.5e",
+			[]Token{
+				{
+					Type:  TokenError,
+					Value: errors.New("missing exponent digits"),
+					Range: ast.Range{
+						StartPos: ast.Position{Line: 1, Column: 3, Offset: 3},
+						EndPos:   ast.Position{Line: 1, Column: 3, Offset: 3},
+					},
+				},
+				{
+					Type:  TokenFixedPointNumberLiteral,
+					Value: "1.5e",
+					Range: ast.Range{
+						StartPos: ast.Position{Line: 1, Column: 0, Offset: 0},
+						EndPos:   ast.Position{Line: 1, Column: 3, Offset: 3},
+					},
+				},
+				{
+					Type: TokenEOF,
+					Range: ast.Range{
+						StartPos: ast.Position{Line: 1, Column: 4, Offset: 4},
+						EndPos:   ast.Position{Line: 1, Column: 4, Offset: 4},
+					},
+				},
+			},
+		)
+	})
 }
 
 func TestLexLineComment(t *testing.T) {
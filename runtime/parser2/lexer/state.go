@@ -218,6 +218,10 @@ func numberState(l *lexer) stateFn {
 			l.scanFixedPointRemainder()
 			l.emitValue(TokenFixedPointNumberLiteral)
 
+		case 'e', 'E':
+			l.scanExponentRemainder()
+			l.emitValue(TokenDecimalIntegerLiteral)
+
 		case EOF:
 			l.backupOne()
 			l.emitValue(TokenDecimalIntegerLiteral)
@@ -290,6 +294,19 @@ func identifierState(l *lexer) stateFn {
 }
 
 func stringState(l *lexer) stateFn {
+	// A raw string literal starts with three quotes.
+	// Two quotes not followed by a third are an empty string
+
+	if l.acceptOne('"') {
+		if l.acceptOne('"') {
+			l.scanRawString()
+			l.emitValue(TokenRawString)
+		} else {
+			l.emitValue(TokenString)
+		}
+		return rootState
+	}
+
 	l.scanString('"')
 	l.emitValue(TokenString)
 	return rootState
@@ -81,6 +81,7 @@ const (
 	TokenAsExclamationMark
 	TokenAsQuestionMark
 	TokenPragma
+	TokenRawString
 	// NOTE: not an actual token, must be last item
 	TokenMax
 )
@@ -202,6 +203,8 @@ func (t TokenType) String() string {
 		return `'as?'`
 	case TokenPragma:
 		return `'#'`
+	case TokenRawString:
+		return "raw string"
 	default:
 		panic(errors.NewUnreachableError())
 	}
@@ -91,6 +91,13 @@ func parseStatement(p *parser) ast.Statement {
 			return parseSwitchStatement(p)
 		case keywordWhile:
 			return parseWhileStatement(p)
+		case keywordRepeat:
+			// The `repeat` keyword only introduces a repeat-while statement
+			// if it is followed by a block, otherwise it is an identifier,
+			// e.g. the name of a function in the invocation `repeat(3)`
+			if isNextTokenBraceOpen(p) {
+				return parseRepeatWhileStatement(p)
+			}
 		case keywordFor:
 			return parseForStatement(p)
 		case keywordEmit:
@@ -99,6 +106,10 @@ func parseStatement(p *parser) ast.Statement {
 			// The `fun` keyword is ambiguous: it either introduces a function expression
 			// or a function declaration, depending on if an identifier follows, or not.
 			return parseFunctionDeclarationOrFunctionExpressionStatement(p)
+		case keywordLet, keywordVar:
+			// The `let` and `var` keywords either introduce a variable declaration,
+			// or a tuple variable declaration, depending on if an open paren follows, or not.
+			return parseVariableDeclarationOrTupleVariableDeclaration(p)
 		}
 	}
 
@@ -141,6 +152,17 @@ func parseStatement(p *parser) ast.Statement {
 			Right: right,
 		}
 
+	case lexer.TokenColon:
+		// An identifier followed by a colon is the label of a loop statement
+
+		if identifierExpression, ok := expression.(*ast.IdentifierExpression); ok {
+			return parseLabeledStatement(p, identifierExpression.Identifier)
+		}
+
+		return &ast.ExpressionStatement{
+			Expression: expression,
+		}
+
 	default:
 		return &ast.ExpressionStatement{
 			Expression: expression,
@@ -215,11 +237,83 @@ func parseReturnStatement(p *parser) *ast.ReturnStatement {
 	}
 }
 
+// parseLabeledStatement parses a loop statement which is preceded by a label.
+// The label was already parsed as an identifier expression.
+//
+//     labeledStatement : identifier ':' ( whileStatement | repeatWhileStatement | forStatement )
+//
+func parseLabeledStatement(p *parser, label ast.Identifier) ast.Statement {
+
+	// Skip the colon
+	p.mustOne(lexer.TokenColon)
+
+	p.skipSpaceAndComments(true)
+
+	if p.current.Is(lexer.TokenIdentifier) {
+		switch p.current.Value {
+		case keywordWhile:
+			statement := parseWhileStatement(p)
+			statement.Label = &label
+			statement.StartPos = label.Pos
+			return statement
+
+		case keywordRepeat:
+			statement := parseRepeatWhileStatement(p)
+			statement.Label = &label
+			statement.StartPos = label.Pos
+			return statement
+
+		case keywordFor:
+			statement := parseForStatement(p)
+			statement.Label = &label
+			statement.StartPos = label.Pos
+			return statement
+		}
+	}
+
+	panic(fmt.Errorf(
+		"expected loop statement after label %q, got %s",
+		label.Identifier,
+		p.current.Type,
+	))
+}
+
+// parseJumpLabel parses the optional label of a break or continue statement,
+// which must be on the same line as the keyword.
+//
+func parseJumpLabel(p *parser) *ast.Identifier {
+	if p.skipSpaceAndComments(false) {
+		return nil
+	}
+
+	if !p.current.Is(lexer.TokenIdentifier) {
+		return nil
+	}
+
+	// The statement may be followed by another case of a switch statement
+
+	switch p.current.Value {
+	case keywordCase, keywordDefault:
+		return nil
+	}
+
+	label := tokenToIdentifier(p.current)
+	p.next()
+
+	return &label
+}
+
 func parseBreakStatement(p *parser) *ast.BreakStatement {
 	tokenRange := p.current.Range
 	p.next()
 
+	label := parseJumpLabel(p)
+	if label != nil {
+		tokenRange.EndPos = label.EndPosition()
+	}
+
 	return &ast.BreakStatement{
+		Label: label,
 		Range: tokenRange,
 	}
 }
@@ -228,7 +322,13 @@ func parseContinueStatement(p *parser) *ast.ContinueStatement {
 	tokenRange := p.current.Range
 	p.next()
 
+	label := parseJumpLabel(p)
+	if label != nil {
+		tokenRange.EndPos = label.EndPosition()
+	}
+
 	return &ast.ContinueStatement{
+		Label: label,
 		Range: tokenRange,
 	}
 }
@@ -337,6 +437,55 @@ func parseWhileStatement(p *parser) *ast.WhileStatement {
 	}
 }
 
+// isNextTokenBraceOpen checks whether the token to follow is an opening brace.
+func isNextTokenBraceOpen(p *parser) bool {
+	p.startBuffering()
+	defer p.replayBuffered()
+
+	// skip the current token
+	p.next()
+	p.skipSpaceAndComments(true)
+
+	return p.current.Is(lexer.TokenBraceOpen)
+}
+
+// parseRepeatWhileStatement parses a loop which tests the condition after each iteration.
+//
+//     repeatWhileStatement : 'repeat' block 'while' expression
+//
+func parseRepeatWhileStatement(p *parser) *ast.RepeatWhileStatement {
+
+	startPos := p.current.StartPos
+
+	// Skip the `repeat` keyword
+	p.next()
+
+	p.skipSpaceAndComments(true)
+
+	block := parseBlock(p)
+
+	p.skipSpaceAndComments(true)
+
+	if !p.current.IsString(lexer.TokenIdentifier, keywordWhile) {
+		panic(fmt.Errorf(
+			"expected keyword %q, got %s",
+			keywordWhile,
+			p.current.Type,
+		))
+	}
+
+	// Skip the `while` keyword
+	p.next()
+
+	expression := parseExpression(p, lowestBindingPower)
+
+	return &ast.RepeatWhileStatement{
+		Block:    block,
+		Test:     expression,
+		StartPos: startPos,
+	}
+}
+
 func parseForStatement(p *parser) *ast.ForStatement {
 
 	startPos := p.current.StartPos
@@ -348,6 +497,33 @@ func parseForStatement(p *parser) *ast.ForStatement {
 
 	p.skipSpaceAndComments(true)
 
+	var valueIdentifier *ast.Identifier
+
+	if p.current.Is(lexer.TokenComma) {
+		p.next()
+		p.skipSpaceAndComments(true)
+
+		secondIdentifier := mustIdentifier(p)
+		valueIdentifier = &secondIdentifier
+
+		p.skipSpaceAndComments(true)
+
+		if p.current.Is(lexer.TokenComma) {
+			p.report(fmt.Errorf(
+				"expected at most two identifiers in for-in statement, got %s",
+				p.current.Type,
+			))
+
+			// Skip the additional identifiers
+			for p.current.Is(lexer.TokenComma) {
+				p.next()
+				p.skipSpaceAndComments(true)
+				mustIdentifier(p)
+				p.skipSpaceAndComments(true)
+			}
+		}
+	}
+
 	if !p.current.IsString(lexer.TokenIdentifier, keywordIn) {
 		p.report(fmt.Errorf(
 			"expected keyword %q, got %s",
@@ -363,10 +539,11 @@ func parseForStatement(p *parser) *ast.ForStatement {
 	block := parseBlock(p)
 
 	return &ast.ForStatement{
-		Identifier: identifier,
-		Block:      block,
-		Value:      expression,
-		StartPos:   startPos,
+		Identifier:      identifier,
+		ValueIdentifier: valueIdentifier,
+		Block:           block,
+		Value:           expression,
+		StartPos:        startPos,
 	}
 }
 
@@ -634,3 +811,85 @@ func parseSwitchCase(p *parser, hasExpression bool) *ast.SwitchCase {
 		},
 	}
 }
+
+func parseVariableDeclarationOrTupleVariableDeclaration(p *parser) ast.Statement {
+
+	startPos := p.current.StartPos
+
+	isLet := p.current.Value == keywordLet
+
+	// Skip the `let` or `var` keyword
+	p.next()
+
+	p.skipSpaceAndComments(true)
+
+	if !p.current.Is(lexer.TokenParenOpen) {
+		return parseVariableDeclarationRemainder(p, ast.AccessNotSpecified, startPos, isLet, "")
+	}
+
+	// Skip the opening paren
+	p.next()
+
+	var identifiers []ast.Identifier
+
+	for {
+		p.skipSpaceAndComments(true)
+		if !p.current.Is(lexer.TokenIdentifier) {
+			panic(fmt.Errorf(
+				"expected identifier in tuple variable declaration, got %s",
+				p.current.Type,
+			))
+		}
+
+		identifiers = append(identifiers, tokenToIdentifier(p.current))
+
+		// Skip the identifier
+		p.next()
+		p.skipSpaceAndComments(true)
+
+		if !p.current.Is(lexer.TokenComma) {
+			break
+		}
+
+		// Skip the comma
+		p.next()
+	}
+
+	p.mustOne(lexer.TokenParenClose)
+
+	if len(identifiers) < 2 {
+		panic(fmt.Errorf(
+			"expected at least two identifiers in tuple variable declaration, got %d",
+			len(identifiers),
+		))
+	}
+
+	p.skipSpaceAndComments(true)
+
+	var typeAnnotation *ast.TypeAnnotation
+
+	if p.current.Is(lexer.TokenColon) {
+		// Skip the colon
+		p.next()
+		p.skipSpaceAndComments(true)
+
+		typeAnnotation = parseTypeAnnotation(p)
+	}
+
+	p.skipSpaceAndComments(true)
+	transfer := parseTransfer(p)
+	if transfer == nil {
+		panic(fmt.Errorf("expected transfer"))
+	}
+
+	value := parseExpression(p, lowestBindingPower)
+
+	return &ast.TupleVariableDeclaration{
+		IsConstant:     isLet,
+		Identifiers:    identifiers,
+		TypeAnnotation: typeAnnotation,
+		Value:          value,
+		Transfer:       transfer,
+		StartPos:       startPos,
+	}
+}
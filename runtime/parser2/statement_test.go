@@ -594,6 +594,153 @@ func TestParseWhileStatement(t *testing.T) {
 	})
 }
 
+func TestParseRepeatWhileStatement(t *testing.T) {
+
+	t.Parallel()
+
+	result, errs := ParseStatements("repeat { } while true")
+	require.Empty(t, errs)
+
+	utils.AssertEqualWithDiff(t,
+		[]ast.Statement{
+			&ast.RepeatWhileStatement{
+				Block: &ast.Block{
+					Statements: nil,
+					Range: ast.Range{
+						StartPos: ast.Position{Line: 1, Column: 7, Offset: 7},
+						EndPos:   ast.Position{Line: 1, Column: 9, Offset: 9},
+					},
+				},
+				Test: &ast.BoolExpression{
+					Value: true,
+					Range: ast.Range{
+						StartPos: ast.Position{Line: 1, Column: 17, Offset: 17},
+						EndPos:   ast.Position{Line: 1, Column: 20, Offset: 20},
+					},
+				},
+				StartPos: ast.Position{Line: 1, Column: 0, Offset: 0},
+			},
+		},
+		result,
+	)
+}
+
+func TestParseRepeatIdentifier(t *testing.T) {
+
+	t.Parallel()
+
+	// `repeat` only introduces a repeat-while statement if it is followed by a block
+
+	result, errs := ParseStatements("repeat(3)")
+	require.Empty(t, errs)
+
+	utils.AssertEqualWithDiff(t,
+		[]ast.Statement{
+			&ast.ExpressionStatement{
+				Expression: &ast.InvocationExpression{
+					InvokedExpression: &ast.IdentifierExpression{
+						Identifier: ast.Identifier{
+							Identifier: "repeat",
+							Pos:        ast.Position{Line: 1, Column: 0, Offset: 0},
+						},
+					},
+					Arguments: []*ast.Argument{
+						{
+							Label: "",
+							Expression: &ast.IntegerExpression{
+								Value: big.NewInt(3),
+								Base:  10,
+								Range: ast.Range{
+									StartPos: ast.Position{Line: 1, Column: 7, Offset: 7},
+									EndPos:   ast.Position{Line: 1, Column: 7, Offset: 7},
+								},
+							},
+						},
+					},
+					EndPos: ast.Position{Line: 1, Column: 8, Offset: 8},
+				},
+			},
+		},
+		result,
+	)
+}
+
+func TestParseLabeledWhileStatement(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("break", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := ParseStatements("outer: while true { break outer }")
+		require.Empty(t, errs)
+
+		utils.AssertEqualWithDiff(t,
+			[]ast.Statement{
+				&ast.WhileStatement{
+					Label: &ast.Identifier{
+						Identifier: "outer",
+						Pos:        ast.Position{Line: 1, Column: 0, Offset: 0},
+					},
+					Test: &ast.BoolExpression{
+						Value: true,
+						Range: ast.Range{
+							StartPos: ast.Position{Line: 1, Column: 13, Offset: 13},
+							EndPos:   ast.Position{Line: 1, Column: 16, Offset: 16},
+						},
+					},
+					Block: &ast.Block{
+						Statements: []ast.Statement{
+							&ast.BreakStatement{
+								Label: &ast.Identifier{
+									Identifier: "outer",
+									Pos:        ast.Position{Line: 1, Column: 26, Offset: 26},
+								},
+								Range: ast.Range{
+									StartPos: ast.Position{Line: 1, Column: 20, Offset: 20},
+									EndPos:   ast.Position{Line: 1, Column: 30, Offset: 30},
+								},
+							},
+						},
+						Range: ast.Range{
+							StartPos: ast.Position{Line: 1, Column: 18, Offset: 18},
+							EndPos:   ast.Position{Line: 1, Column: 32, Offset: 32},
+						},
+					},
+					StartPos: ast.Position{Line: 1, Column: 0, Offset: 0},
+				},
+			},
+			result,
+		)
+	})
+
+	t.Run("continue on next line", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := ParseStatements("outer: for x in xs { continue\nouter }")
+		require.Empty(t, errs)
+
+		require.Len(t, result, 1)
+		forStatement := result[0].(*ast.ForStatement)
+		require.Equal(t, "outer", forStatement.Label.Identifier)
+
+		statements := forStatement.Block.Statements
+		require.Len(t, statements, 2)
+		require.Nil(t, statements[0].(*ast.ContinueStatement).Label)
+		require.IsType(t, &ast.ExpressionStatement{}, statements[1])
+	})
+
+	t.Run("invalid statement after label", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, errs := ParseStatements("outer: x")
+		require.Len(t, errs, 1)
+	})
+}
+
 func TestParseAssignmentStatement(t *testing.T) {
 
 	t.Parallel()
@@ -805,6 +952,61 @@ func TestParseForStatement(t *testing.T) {
 			result,
 		)
 	})
+
+	t.Run("key and value", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := ParseStatements("for k, v in y { }")
+		require.Empty(t, errs)
+
+		utils.AssertEqualWithDiff(t,
+			[]ast.Statement{
+				&ast.ForStatement{
+					Identifier: ast.Identifier{
+						Identifier: "k",
+						Pos:        ast.Position{Line: 1, Column: 4, Offset: 4},
+					},
+					ValueIdentifier: &ast.Identifier{
+						Identifier: "v",
+						Pos:        ast.Position{Line: 1, Column: 7, Offset: 7},
+					},
+					Value: &ast.IdentifierExpression{
+						Identifier: ast.Identifier{
+							Identifier: "y",
+							Pos:        ast.Position{Line: 1, Column: 12, Offset: 12},
+						},
+					},
+					Block: &ast.Block{
+						Statements: nil,
+						Range: ast.Range{
+							StartPos: ast.Position{Line: 1, Column: 14, Offset: 14},
+							EndPos:   ast.Position{Line: 1, Column: 16, Offset: 16},
+						},
+					},
+					StartPos: ast.Position{Line: 1, Column: 0, Offset: 0},
+				},
+			},
+			result,
+		)
+	})
+
+	t.Run("too many identifiers", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, errs := ParseStatements("for a, b, c in y { }")
+
+		utils.AssertEqualWithDiff(t,
+			[]error{
+				&SyntaxError{
+					Message: "expected at most two identifiers in for-in statement, got ','",
+					Pos:     ast.Position{Offset: 8, Line: 1, Column: 8},
+				},
+			},
+			errs,
+		)
+	})
 }
 
 func TestParseEmit(t *testing.T) {
@@ -2289,3 +2491,132 @@ func TestParseSwapStatementInFunctionDeclaration(t *testing.T) {
 		result.Declarations(),
 	)
 }
+
+func TestParseTupleVariableDeclaration(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("without type annotation", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := ParseStatements("let (a, b) = c")
+		require.Empty(t, errs)
+
+		utils.AssertEqualWithDiff(t,
+			[]ast.Statement{
+				&ast.TupleVariableDeclaration{
+					IsConstant: true,
+					Identifiers: []ast.Identifier{
+						{
+							Identifier: "a",
+							Pos:        ast.Position{Line: 1, Column: 5, Offset: 5},
+						},
+						{
+							Identifier: "b",
+							Pos:        ast.Position{Line: 1, Column: 8, Offset: 8},
+						},
+					},
+					Transfer: &ast.Transfer{
+						Operation: ast.TransferOperationCopy,
+						Pos:       ast.Position{Line: 1, Column: 11, Offset: 11},
+					},
+					Value: &ast.IdentifierExpression{
+						Identifier: ast.Identifier{
+							Identifier: "c",
+							Pos:        ast.Position{Line: 1, Column: 13, Offset: 13},
+						},
+					},
+					StartPos: ast.Position{Line: 1, Column: 0, Offset: 0},
+				},
+			},
+			result,
+		)
+	})
+
+	t.Run("with type annotation", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := ParseStatements("var (a, b): @(@R, Int) <- c")
+		require.Empty(t, errs)
+
+		utils.AssertEqualWithDiff(t,
+			[]ast.Statement{
+				&ast.TupleVariableDeclaration{
+					IsConstant: false,
+					Identifiers: []ast.Identifier{
+						{
+							Identifier: "a",
+							Pos:        ast.Position{Line: 1, Column: 5, Offset: 5},
+						},
+						{
+							Identifier: "b",
+							Pos:        ast.Position{Line: 1, Column: 8, Offset: 8},
+						},
+					},
+					TypeAnnotation: &ast.TypeAnnotation{
+						IsResource: true,
+						Type: &ast.TupleType{
+							ElementTypeAnnotations: []*ast.TypeAnnotation{
+								{
+									IsResource: true,
+									Type: &ast.NominalType{
+										Identifier: ast.Identifier{
+											Identifier: "R",
+											Pos:        ast.Position{Line: 1, Column: 15, Offset: 15},
+										},
+									},
+									StartPos: ast.Position{Line: 1, Column: 14, Offset: 14},
+								},
+								{
+									IsResource: false,
+									Type: &ast.NominalType{
+										Identifier: ast.Identifier{
+											Identifier: "Int",
+											Pos:        ast.Position{Line: 1, Column: 18, Offset: 18},
+										},
+									},
+									StartPos: ast.Position{Line: 1, Column: 18, Offset: 18},
+								},
+							},
+							Range: ast.Range{
+								StartPos: ast.Position{Line: 1, Column: 13, Offset: 13},
+								EndPos:   ast.Position{Line: 1, Column: 21, Offset: 21},
+							},
+						},
+						StartPos: ast.Position{Line: 1, Column: 12, Offset: 12},
+					},
+					Transfer: &ast.Transfer{
+						Operation: ast.TransferOperationMove,
+						Pos:       ast.Position{Line: 1, Column: 23, Offset: 23},
+					},
+					Value: &ast.IdentifierExpression{
+						Identifier: ast.Identifier{
+							Identifier: "c",
+							Pos:        ast.Position{Line: 1, Column: 26, Offset: 26},
+						},
+					},
+					StartPos: ast.Position{Line: 1, Column: 0, Offset: 0},
+				},
+			},
+			result,
+		)
+	})
+
+	t.Run("one identifier", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, errs := ParseStatements("let (a) = c")
+		utils.AssertEqualWithDiff(t,
+			[]error{
+				&SyntaxError{
+					Message: "expected at least two identifiers in tuple variable declaration, got 1",
+					Pos:     ast.Position{Offset: 7, Line: 1, Column: 7},
+				},
+			},
+			errs,
+		)
+	})
+}
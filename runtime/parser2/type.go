@@ -574,6 +574,14 @@ func defineFunctionType() {
 		lexer.TokenParenOpen,
 		func(p *parser, startToken lexer.Token) ast.Type {
 
+			// A function type starts with the parenthesized parameter type list,
+			// e.g. `((Int): Bool)`. Otherwise, the type is a tuple type, e.g. `(Int, Bool)`
+
+			p.skipSpaceAndComments(true)
+			if !p.current.Is(lexer.TokenParenOpen) {
+				return parseTupleType(p, startToken)
+			}
+
 			parameterTypeAnnotations := parseParameterTypeAnnotations(p)
 
 			p.skipSpaceAndComments(true)
@@ -597,6 +605,45 @@ func defineFunctionType() {
 	)
 }
 
+// parseTupleType parses the element types of a tuple type,
+// e.g. `(Int, String)`. The opening parenthesis was already consumed.
+//
+func parseTupleType(p *parser, startToken lexer.Token) ast.Type {
+
+	var elementTypeAnnotations []*ast.TypeAnnotation
+
+	for {
+		p.skipSpaceAndComments(true)
+		elementTypeAnnotation := parseTypeAnnotation(p)
+		elementTypeAnnotations = append(elementTypeAnnotations, elementTypeAnnotation)
+
+		p.skipSpaceAndComments(true)
+		if !p.current.Is(lexer.TokenComma) {
+			break
+		}
+		// Skip the comma
+		p.next()
+	}
+
+	p.skipSpaceAndComments(true)
+	endToken := p.mustOne(lexer.TokenParenClose)
+
+	if len(elementTypeAnnotations) < 2 {
+		panic(fmt.Errorf(
+			"expected at least two element types in tuple type, got %d",
+			len(elementTypeAnnotations),
+		))
+	}
+
+	return &ast.TupleType{
+		ElementTypeAnnotations: elementTypeAnnotations,
+		Range: ast.Range{
+			StartPos: startToken.StartPos,
+			EndPos:   endToken.EndPos,
+		},
+	}
+}
+
 func parseParameterTypeAnnotations(p *parser) (typeAnnotations []*ast.TypeAnnotation) {
 
 	p.skipSpaceAndComments(true)
@@ -2754,3 +2754,64 @@ func TestParseConstantSizedSizedArrayWithTrailingUnderscoreSize(t *testing.T) {
 
 	require.IsType(t, &SyntaxError{}, errors[0])
 }
+
+func TestParseTupleType(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("two elements", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := ParseType("(Int, @R)")
+		require.Empty(t, errs)
+
+		utils.AssertEqualWithDiff(t,
+			&ast.TupleType{
+				ElementTypeAnnotations: []*ast.TypeAnnotation{
+					{
+						IsResource: false,
+						Type: &ast.NominalType{
+							Identifier: ast.Identifier{
+								Identifier: "Int",
+								Pos:        ast.Position{Line: 1, Column: 1, Offset: 1},
+							},
+						},
+						StartPos: ast.Position{Line: 1, Column: 1, Offset: 1},
+					},
+					{
+						IsResource: true,
+						Type: &ast.NominalType{
+							Identifier: ast.Identifier{
+								Identifier: "R",
+								Pos:        ast.Position{Line: 1, Column: 7, Offset: 7},
+							},
+						},
+						StartPos: ast.Position{Line: 1, Column: 6, Offset: 6},
+					},
+				},
+				Range: ast.Range{
+					StartPos: ast.Position{Line: 1, Column: 0, Offset: 0},
+					EndPos:   ast.Position{Line: 1, Column: 8, Offset: 8},
+				},
+			},
+			result,
+		)
+	})
+
+	t.Run("one element", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, errs := ParseType("(Int)")
+		utils.AssertEqualWithDiff(t,
+			[]error{
+				&SyntaxError{
+					Message: "expected at least two element types in tuple type, got 1",
+					Pos:     ast.Position{Offset: 5, Line: 1, Column: 5},
+				},
+			},
+			errs,
+		)
+	})
+}
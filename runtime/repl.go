@@ -20,6 +20,7 @@ package runtime
 
 import (
 	"fmt"
+	"math/rand"
 	"sort"
 
 	"github.com/onflow/cadence/runtime/ast"
@@ -116,6 +117,7 @@ func NewREPL(
 			defer func() { uuid++ }()
 			return uuid, nil
 		}),
+		interpreter.WithRandomSource(rand.Uint64),
 	)
 	if err != nil {
 		return nil, err
@@ -131,90 +133,120 @@ func NewREPL(
 	return repl, nil
 }
 
-func (r *REPL) handleCheckerError() bool {
-	err := r.checker.CheckerError()
-	if err == nil {
-		return true
-	}
-	if r.onError != nil {
-		r.onError(err, r.checker.Location, r.codes)
-	}
-	return false
-}
-
-func (r *REPL) execute(element ast.Element) {
-	result := element.Accept(r.inter)
-	expStatementRes, ok := result.(interpreter.ExpressionStatementResult)
-	if !ok {
-		return
-	}
-	if r.onResult == nil {
-		return
-	}
-	r.onResult(expStatementRes.Value)
-}
+func (r *REPL) check(element ast.Element, code string) error {
+	r.checker.ResetErrors()
+	r.checker.ResetHints()
 
-func (r *REPL) check(element ast.Element, code string) bool {
 	element.Accept(r.checker)
 	r.codes[r.checker.Location.ID()] = code
-	return r.handleCheckerError()
-}
-
-func (r *REPL) Accept(code string) (inputIsComplete bool) {
 
-	// TODO: detect if the input is complete
-	inputIsComplete = true
+	err := r.checker.CheckerError()
+	if err != nil {
+		return err
+	}
+	return nil
+}
 
-	var err error
-	result, errs := parser2.ParseStatements(code)
+// eval parses, checks, and executes the given code,
+// and calls the given function with the result of each expression statement.
+//
+// The code is checked and executed in a new scope,
+// so the declarations of the code can be discarded
+// if the code is invalid or fails to execute.
+// This ensures the state of the REPL is not corrupted by errors
+//
+func (r *REPL) eval(code string, onResult func(interpreter.Value)) (err error) {
+
+	elements, errs := parser2.ParseStatements(code)
 	if len(errs) > 0 {
-		err = parser2.Error{
+		return parser2.Error{
 			Code:   code,
 			Errors: errs,
 		}
 	}
 
-	if !inputIsComplete {
-		return
-	}
+	r.checker.EnterScope()
+	r.inter.EnterActivation()
 
-	if err != nil {
-		r.onError(err, r.checker.Location, r.codes)
-		return
-	}
-
-	r.checker.ResetErrors()
-	r.checker.ResetHints()
+	defer func() {
+		if err != nil {
+			r.inter.LeaveActivation()
+			r.checker.LeaveScope()
+		}
+	}()
 
-	for _, element := range result {
+	for _, element := range elements {
 
 		switch typedElement := element.(type) {
 		case ast.Declaration:
 			program := ast.NewProgram([]ast.Declaration{typedElement})
 
-			if !r.check(program, code) {
-				return
-			}
-
-			r.execute(typedElement)
+			err = r.check(program, code)
 
 		case ast.Statement:
 			r.checker.Program = nil
 
-			if !r.check(typedElement, code) {
-				return
-			}
-
-			r.execute(typedElement)
+			err = r.check(typedElement, code)
 
 		default:
 			panic(errors.NewUnreachableError())
 		}
+
+		if err != nil {
+			return err
+		}
+
+		var result ast.Repr
+		result, err = r.inter.Execute(element)
+		if err != nil {
+			return err
+		}
+
+		expStatementRes, ok := result.(interpreter.ExpressionStatementResult)
+		if !ok || onResult == nil {
+			continue
+		}
+		onResult(expStatementRes.Value)
+	}
+
+	return nil
+}
+
+func (r *REPL) Accept(code string) (inputIsComplete bool) {
+
+	// TODO: detect if the input is complete
+	inputIsComplete = true
+
+	err := r.eval(code, r.onResult)
+	if err != nil && r.onError != nil {
+		r.onError(err, r.checker.Location, r.codes)
 	}
 
 	return
 }
 
+// EvalLine parses, checks, and executes the given code,
+// e.g. a line entered into an interactive shell.
+//
+// Declarations of the code, e.g. variables, stay available to subsequently evaluated code.
+// If the code is invalid or fails to execute, all its declarations are discarded,
+// and the error is returned.
+//
+// The result is the value of the last expression statement of the code, if any
+//
+func (r *REPL) EvalLine(code string) (interpreter.Value, error) {
+	var result interpreter.Value
+
+	err := r.eval(code, func(value interpreter.Value) {
+		result = value
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
 type REPLSuggestion struct {
 	Name, Description string
 }
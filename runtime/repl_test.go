@@ -0,0 +1,144 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2020 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+
+package runtime
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/interpreter"
+	"github.com/onflow/cadence/runtime/parser2"
+	"github.com/onflow/cadence/runtime/sema"
+)
+
+func newTestREPL(t *testing.T) *REPL {
+	repl, err := NewREPL(
+		func(err error, _ common.Location, _ map[common.LocationID]string) {
+			t.Fatal(err)
+		},
+		nil,
+		nil,
+	)
+	require.NoError(t, err)
+	return repl
+}
+
+func TestREPLEvalLine(t *testing.T) {
+
+	t.Parallel()
+
+	repl := newTestREPL(t)
+
+	value, err := repl.EvalLine("let x = 1")
+	require.NoError(t, err)
+	assert.Nil(t, value)
+
+	value, err = repl.EvalLine("var y = x + 1")
+	require.NoError(t, err)
+	assert.Nil(t, value)
+
+	value, err = repl.EvalLine("fun double(_ n: Int): Int { return n * 2 }")
+	require.NoError(t, err)
+	assert.Nil(t, value)
+
+	value, err = repl.EvalLine("y = double(y); y")
+	require.NoError(t, err)
+	assert.Equal(t, interpreter.NewIntValueFromInt64(4), value)
+
+	value, err = repl.EvalLine("x + y")
+	require.NoError(t, err)
+	assert.Equal(t, interpreter.NewIntValueFromInt64(5), value)
+
+	value, err = repl.EvalLine("struct S { let n: Int; init(n: Int) { self.n = n } }")
+	require.NoError(t, err)
+	assert.Nil(t, value)
+
+	value, err = repl.EvalLine("S(n: x).n")
+	require.NoError(t, err)
+	assert.Equal(t, interpreter.NewIntValueFromInt64(1), value)
+}
+
+func TestREPLEvalLineErrors(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("parsing error", func(t *testing.T) {
+
+		t.Parallel()
+
+		repl := newTestREPL(t)
+
+		_, err := repl.EvalLine("let x = ")
+		require.IsType(t, parser2.Error{}, err)
+	})
+
+	t.Run("checking error", func(t *testing.T) {
+
+		t.Parallel()
+
+		repl := newTestREPL(t)
+
+		_, err := repl.EvalLine(`let x = 1; let y: Int = "two"`)
+		require.IsType(t, &sema.CheckerError{}, err)
+
+		// The declarations of the invalid line are discarded
+
+		_, err = repl.EvalLine("x")
+		require.IsType(t, &sema.CheckerError{}, err)
+
+		_, err = repl.EvalLine("y")
+		require.IsType(t, &sema.CheckerError{}, err)
+
+		// The names can be declared again
+
+		_, err = repl.EvalLine("let x = 2; let y = 3")
+		require.NoError(t, err)
+
+		value, err := repl.EvalLine("x + y")
+		require.NoError(t, err)
+		assert.Equal(t, interpreter.NewIntValueFromInt64(5), value)
+	})
+
+	t.Run("execution error", func(t *testing.T) {
+
+		t.Parallel()
+
+		repl := newTestREPL(t)
+
+		_, err := repl.EvalLine("let x = 1")
+		require.NoError(t, err)
+
+		_, err = repl.EvalLine("let y = [1][x]")
+		require.ErrorAs(t, err, &interpreter.ArrayIndexOutOfBoundsError{})
+
+		// The variable of the failed line is discarded,
+		// the variables of previous lines are kept
+
+		_, err = repl.EvalLine("y")
+		require.IsType(t, &sema.CheckerError{}, err)
+
+		value, err := repl.EvalLine("x")
+		require.NoError(t, err)
+		assert.Equal(t, interpreter.NewIntValueFromInt64(1), value)
+	})
+}
@@ -69,6 +69,22 @@ type Runtime interface {
 	// SetContractUpdateValidationEnabled configures if contract update validation is enabled.
 	//
 	SetContractUpdateValidationEnabled(enabled bool)
+
+	// SetImportCache sets the cache of decoded imported contract values,
+	// which is shared by all executions.
+	// Passing nil disables caching (default).
+	//
+	SetImportCache(cache *interpreter.ImportCache)
+
+	// SetMaxCompositeFields sets the maximum number of fields
+	// a composite or interface may declare.
+	// Zero means unlimited (default).
+	//
+	// The limit only applies to newly checked programs.
+	// Existing stored values are still decoded regardless of their number of fields,
+	// so existing state remains readable.
+	//
+	SetMaxCompositeFields(max int)
 }
 
 var typeDeclarations = append(
@@ -124,6 +140,8 @@ func reportMetric(
 type interpreterRuntime struct {
 	coverageReport                  *CoverageReport
 	contractUpdateValidationEnabled bool
+	importCache                     *interpreter.ImportCache
+	maxCompositeFields              int
 }
 
 type Option func(Runtime)
@@ -137,6 +155,28 @@ func WithContractUpdateValidationEnabled(enabled bool) Option {
 	}
 }
 
+// WithImportCache returns a runtime option
+// that configures the cache of decoded imported contract values.
+//
+// See interpreter.ImportCache for when entries should be invalidated.
+//
+func WithImportCache(cache *interpreter.ImportCache) Option {
+	return func(runtime Runtime) {
+		runtime.SetImportCache(cache)
+	}
+}
+
+// WithMaxCompositeFields returns a runtime option
+// that configures the maximum number of fields
+// a composite or interface may declare.
+// Existing stored values are not affected.
+//
+func WithMaxCompositeFields(max int) Option {
+	return func(runtime Runtime) {
+		runtime.SetMaxCompositeFields(max)
+	}
+}
+
 // NewInterpreterRuntime returns a interpreter-based version of the Flow runtime.
 func NewInterpreterRuntime(options ...Option) Runtime {
 	runtime := &interpreterRuntime{}
@@ -154,6 +194,14 @@ func (r *interpreterRuntime) SetContractUpdateValidationEnabled(enabled bool) {
 	r.contractUpdateValidationEnabled = enabled
 }
 
+func (r *interpreterRuntime) SetImportCache(cache *interpreter.ImportCache) {
+	r.importCache = cache
+}
+
+func (r *interpreterRuntime) SetMaxCompositeFields(max int) {
+	r.maxCompositeFields = max
+}
+
 func (r *interpreterRuntime) ExecuteScript(script Script, context Context) (cadence.Value, error) {
 	context.InitializeCodesAndPrograms()
 
@@ -704,6 +752,7 @@ func (r *interpreterRuntime) check(
 						}, nil
 					},
 				),
+				sema.WithMaxCompositeFields(r.maxCompositeFields),
 				sema.WithCheckHandler(func(location common.Location, check func()) {
 					reportMetric(
 						check,
@@ -797,6 +846,7 @@ func (r *interpreterRuntime) newInterpreter(
 		interpreter.WithImportLocationHandler(
 			r.importLocationHandler(context, functions, values, checkerOptions),
 		),
+		interpreter.WithImportCache(r.importCache),
 		interpreter.WithOnStatementHandler(
 			r.onStatementHandler(),
 		),
@@ -1041,6 +1091,8 @@ func (r *interpreterRuntime) standardLibraryFunctions(
 			GetCurrentBlock: r.newGetCurrentBlockFunction(context.Interface),
 			GetBlock:        r.newGetBlockFunction(context.Interface),
 			UnsafeRandom:    r.newUnsafeRandomFunction(context.Interface),
+
+			RecoverECDSAPublicKey: r.newRecoverECDSAPublicKeyFunction(context.Interface),
 		}),
 		stdlib.BuiltinFunctions...,
 	)
@@ -1335,10 +1387,9 @@ func (r *interpreterRuntime) loadContract(
 		switch location := compositeType.Location.(type) {
 
 		case common.AddressLocation:
-			storedValue = runtimeStorage.readValue(
-				location.Address,
-				formatContractKey(location.Name),
-				false,
+			storedValue = runtimeStorage.readContractValue(
+				location,
+				inter.ImportCache(),
 			)
 		}
 
@@ -1592,6 +1643,68 @@ func (r *interpreterRuntime) newUnsafeRandomFunction(runtimeInterface Interface)
 	}
 }
 
+func (r *interpreterRuntime) newRecoverECDSAPublicKeyFunction(runtimeInterface Interface) interpreter.HostFunction {
+	return func(invocation interpreter.Invocation) interpreter.Value {
+		signature, err := interpreter.ByteArrayValueToByteSlice(invocation.Arguments[0])
+		if err != nil {
+			panic(fmt.Errorf("recoverECDSAPublicKey: invalid signature argument: %w", err))
+		}
+
+		message, err := interpreter.ByteArrayValueToByteSlice(invocation.Arguments[1])
+		if err != nil {
+			panic(fmt.Errorf("recoverECDSAPublicKey: invalid message argument: %w", err))
+		}
+
+		hashAlgorithm := NewHashAlgorithmFromValue(invocation.Arguments[2])
+
+		signatureAlgorithm := NewSignatureAlgorithmFromValue(invocation.Arguments[3])
+
+		// Public keys can only be recovered from ECDSA signatures
+
+		if signatureAlgorithm != SignatureAlgorithmECDSA_P256 &&
+			signatureAlgorithm != SignatureAlgorithmECDSA_Secp256k1 {
+
+			panic(fmt.Errorf(
+				"recoverECDSAPublicKey: unsupported signature algorithm: %s",
+				signatureAlgorithm.Name(),
+			))
+		}
+
+		// Public key recovery is optional for runtime interfaces
+
+		publicKeyRecoverer, ok := runtimeInterface.(PublicKeyRecoverer)
+		if !ok {
+			panic(fmt.Errorf("recoverECDSAPublicKey: public key recovery is not supported"))
+		}
+
+		var publicKey []byte
+		wrapPanic(func() {
+			publicKey, err = publicKeyRecoverer.RecoverPublicKey(
+				signature,
+				message,
+				signatureAlgorithm,
+				hashAlgorithm,
+			)
+		})
+		if err != nil {
+			panic(err)
+		}
+
+		// The signature is invalid, no public key can be recovered
+
+		if publicKey == nil {
+			return interpreter.NilValue{}
+		}
+
+		return interpreter.NewSomeValueOwningNonCopying(
+			NewPublicKeyValue(&PublicKey{
+				PublicKey: publicKey,
+				SignAlgo:  signatureAlgorithm,
+			}),
+		)
+	}
+}
+
 func (r *interpreterRuntime) newAuthAccountContracts(
 	addressValue interpreter.AddressValue,
 	context Context,
@@ -2339,6 +2452,19 @@ func NewAccountKeyValue(accountKey *AccountKey) *interpreter.CompositeValue {
 	)
 }
 
+func NewSignatureAlgorithmFromValue(value interpreter.Value) SignatureAlgorithm {
+	signAlgoValue := value.(*interpreter.CompositeValue)
+
+	rawValue, ok := signAlgoValue.Fields.Get(sema.EnumRawValueFieldName)
+	if !ok {
+		panic("cannot find sign algorithm raw value")
+	}
+
+	signAlgoRawValue := rawValue.(interpreter.UInt8Value)
+
+	return SignatureAlgorithm(signAlgoRawValue.ToInt())
+}
+
 func NewHashAlgorithmFromValue(value interpreter.Value) HashAlgorithm {
 	hashAlgoValue := value.(*interpreter.CompositeValue)
 
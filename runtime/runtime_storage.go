@@ -151,6 +151,86 @@ func (s *runtimeStorage) readValue(
 	// Cache miss: Load and deserialize the stored value (if any)
 	// through the runtime interface
 
+	storedData := s.readData(address, key)
+
+	storedValue := s.decodeData(address, key, storedData)
+
+	if storedValue == nil {
+		s.cache[fullKey] = CacheEntry{
+			MustWrite: false,
+			Value:     nil,
+		}
+		return interpreter.NilValue{}
+	}
+
+	if !deferred {
+		s.cache[fullKey] = CacheEntry{
+			MustWrite: false,
+			Value:     storedValue,
+		}
+	}
+
+	return interpreter.NewSomeValueOwningNonCopying(storedValue)
+}
+
+// readContractValue reads the value of the contract with the given location.
+//
+// If an import cache is given, the stored data is looked up in it,
+// and the cached value is used instead of deserializing the stored data.
+// On a cache miss, the deserialized value is placed in the import cache.
+//
+func (s *runtimeStorage) readContractValue(
+	location common.AddressLocation,
+	importCache *interpreter.ImportCache,
+) interpreter.OptionalValue {
+
+	address := location.Address
+	key := formatContractKey(location.Name)
+
+	if importCache == nil {
+		return s.readValue(address, key, false)
+	}
+
+	fullKey := StorageKey{
+		Address: address,
+		Key:     key,
+	}
+
+	// Check cache. Return cached value, if any
+
+	if entry, ok := s.cache[fullKey]; ok {
+		if entry.Value == nil {
+			return interpreter.NilValue{}
+		}
+
+		return interpreter.NewSomeValueOwningNonCopying(entry.Value)
+	}
+
+	storedData := s.readData(address, key)
+
+	storedValue, ok := importCache.Get(location, storedData)
+	if !ok {
+		storedValue = s.decodeData(address, key, storedData)
+		if storedValue != nil {
+			importCache.Set(location, storedData, storedValue)
+		}
+	}
+
+	s.cache[fullKey] = CacheEntry{
+		MustWrite: false,
+		Value:     storedValue,
+	}
+
+	if storedValue == nil {
+		return interpreter.NilValue{}
+	}
+
+	return interpreter.NewSomeValueOwningNonCopying(storedValue)
+}
+
+// readData reads the stored data for the given key through the runtime interface.
+//
+func (s *runtimeStorage) readData(address common.Address, key string) []byte {
 	var storedData []byte
 	var err error
 	wrapPanic(func() {
@@ -159,19 +239,23 @@ func (s *runtimeStorage) readValue(
 	if err != nil {
 		panic(err)
 	}
+	return storedData
+}
+
+// decodeData deserializes the given stored data.
+// It returns nil if there is no stored data.
+//
+func (s *runtimeStorage) decodeData(address common.Address, key string, storedData []byte) interpreter.Value {
 
 	var version uint16
 	storedData, version = interpreter.StripMagic(storedData)
 
 	if len(storedData) == 0 {
-		s.cache[fullKey] = CacheEntry{
-			MustWrite: false,
-			Value:     nil,
-		}
-		return interpreter.NilValue{}
+		return nil
 	}
 
 	var storedValue interpreter.Value
+	var err error
 
 	reportMetric(
 		func() {
@@ -192,14 +276,7 @@ func (s *runtimeStorage) readValue(
 		panic(err)
 	}
 
-	if !deferred {
-		s.cache[fullKey] = CacheEntry{
-			MustWrite: false,
-			Value:     storedValue,
-		}
-	}
-
-	return interpreter.NewSomeValueOwningNonCopying(storedValue)
+	return storedValue
 }
 
 // writeValue is the StorageWriteHandlerFunc for the interpreter.
@@ -122,6 +122,12 @@ type testRuntimeInterface struct {
 		signatureAlgorithm SignatureAlgorithm,
 		hashAlgorithm HashAlgorithm,
 	) (bool, error)
+	recoverPublicKey func(
+		signature []byte,
+		message []byte,
+		signatureAlgorithm SignatureAlgorithm,
+		hashAlgorithm HashAlgorithm,
+	) ([]byte, error)
 	hash                   func(data []byte, hashAlgorithm HashAlgorithm) ([]byte, error)
 	setCadenceValue        func(owner Address, key string, value cadence.Value) (err error)
 	getStorageUsed         func(_ Address) (uint64, error)
@@ -353,6 +359,23 @@ func (i *testRuntimeInterface) Hash(data []byte, hashAlgorithm HashAlgorithm) ([
 	return i.hash(data, hashAlgorithm)
 }
 
+func (i *testRuntimeInterface) RecoverPublicKey(
+	signature []byte,
+	message []byte,
+	signatureAlgorithm SignatureAlgorithm,
+	hashAlgorithm HashAlgorithm,
+) ([]byte, error) {
+	if i.recoverPublicKey == nil {
+		return nil, nil
+	}
+	return i.recoverPublicKey(
+		signature,
+		message,
+		signatureAlgorithm,
+		hashAlgorithm,
+	)
+}
+
 func (i *testRuntimeInterface) HighLevelStorageEnabled() bool {
 	return i.setCadenceValue != nil
 }
@@ -6161,3 +6184,174 @@ func TestPanics(t *testing.T) {
 	)
 	assert.Error(t, err)
 }
+
+func TestRuntimeImportCache(t *testing.T) {
+
+	t.Parallel()
+
+	importCache := interpreter.NewImportCache()
+
+	runtime := NewInterpreterRuntime(
+		WithImportCache(importCache),
+	)
+
+	address := common.BytesToAddress([]byte{0xCA, 0xDE})
+
+	contract := []byte(`
+      pub contract Test {
+          pub var count: Int
+          pub let names: [String]
+
+          init() {
+              self.count = 0
+              self.names = []
+          }
+
+          pub fun increment() {
+              self.count = self.count + 1
+              self.names.append("test")
+          }
+      }
+    `)
+
+	incrementTransaction := []byte(`
+      import Test from 0xCADE
+
+      transaction {
+          prepare(signer: AuthAccount) {
+              Test.increment()
+          }
+      }
+    `)
+
+	failingIncrementTransaction := []byte(`
+      import Test from 0xCADE
+
+      transaction {
+          prepare(signer: AuthAccount) {
+              Test.increment()
+              panic("failed")
+          }
+      }
+    `)
+
+	script := []byte(`
+      import Test from 0xCADE
+
+      pub fun main(): [Int] {
+          return [Test.count, Test.names.length]
+      }
+    `)
+
+	deploy := utils.DeploymentTransaction("Test", contract)
+
+	var accountCode []byte
+	var valueDecoded int
+
+	runtimeInterface := &testRuntimeInterface{
+		getCode: func(_ Location) (bytes []byte, err error) {
+			return accountCode, nil
+		},
+		storage: newTestStorage(nil, nil),
+		getSigningAccounts: func() ([]Address, error) {
+			return []Address{address}, nil
+		},
+		resolveLocation: singleIdentifierLocationResolver(t),
+		getAccountContractCode: func(_ Address, _ string) (code []byte, err error) {
+			return accountCode, nil
+		},
+		updateAccountContractCode: func(_ Address, _ string, code []byte) error {
+			accountCode = code
+			return nil
+		},
+		emitEvent: func(event cadence.Event) error {
+			return nil
+		},
+		valueDecoded: func(duration time.Duration) {
+			valueDecoded++
+		},
+	}
+
+	nextTransactionLocation := newTransactionLocationGenerator()
+
+	executeTransaction := func(source []byte) error {
+		return runtime.ExecuteTransaction(
+			Script{
+				Source: source,
+			},
+			Context{
+				Interface: runtimeInterface,
+				Location:  nextTransactionLocation(),
+			},
+		)
+	}
+
+	executeScript := func() cadence.Value {
+		value, err := runtime.ExecuteScript(
+			Script{
+				Source: script,
+			},
+			Context{
+				Interface: runtimeInterface,
+				Location:  common.ScriptLocation{0x1},
+			},
+		)
+		require.NoError(t, err)
+		return value
+	}
+
+	expected := func(count, length int) cadence.Value {
+		return cadence.NewArray([]cadence.Value{
+			cadence.NewInt(count),
+			cadence.NewInt(length),
+		})
+	}
+
+	err := executeTransaction(deploy)
+	require.NoError(t, err)
+
+	// The first import decodes the contract value and caches it
+
+	valueDecoded = 0
+
+	assert.Equal(t, expected(0, 0), executeScript())
+	assert.Equal(t, 1, valueDecoded)
+	assert.Equal(t, 1, importCache.Len())
+
+	// The second import uses the cached contract value
+
+	assert.Equal(t, expected(0, 0), executeScript())
+	assert.Equal(t, 1, valueDecoded)
+
+	// Mutations of a failed transaction are not observed by later imports
+
+	err = executeTransaction(failingIncrementTransaction)
+	require.Error(t, err)
+	assert.Equal(t, 1, valueDecoded)
+
+	assert.Equal(t, expected(0, 0), executeScript())
+	assert.Equal(t, 1, valueDecoded)
+
+	// The stored data of the contract value changes when it is updated,
+	// so the updated contract value is decoded again
+
+	err = executeTransaction(incrementTransaction)
+	require.NoError(t, err)
+	assert.Equal(t, 1, valueDecoded)
+
+	assert.Equal(t, expected(1, 1), executeScript())
+	assert.Equal(t, 2, valueDecoded)
+	assert.Equal(t, 2, importCache.Len())
+
+	assert.Equal(t, expected(1, 1), executeScript())
+	assert.Equal(t, 2, valueDecoded)
+
+	// Invalidation removes all entries for the location
+
+	importCache.Invalidate(common.AddressLocation{
+		Address: address,
+		Name:    "Test",
+	})
+
+	assert.Equal(t, 0, importCache.Len())
+}
@@ -205,6 +205,19 @@ func (checker *Checker) checkBinaryExpressionArithmeticOrNonEqualityComparisonOr
 		)
 	}
 
+	if checker.possibleDivisionByZeroReported &&
+		rightIsNumber &&
+		(operation == ast.OperationDiv || operation == ast.OperationMod) &&
+		!isNonZeroNumberLiteral(expression.Right) {
+
+		checker.warn(
+			&PossibleDivisionByZeroError{
+				Operation: operation,
+				Range:     ast.NewRangeFromPositioned(expression.Right),
+			},
+		)
+	}
+
 	switch operationKind {
 	case BinaryOperationKindArithmetic,
 		BinaryOperationKindBitwise:
@@ -376,3 +389,24 @@ func (checker *Checker) checkBinaryExpressionNilCoalescing(
 	}
 	return leftInner
 }
+
+// isNonZeroNumberLiteral returns true if the given expression
+// is an integer or fixed-point literal which is not zero, or the negation of one
+//
+func isNonZeroNumberLiteral(expression ast.Expression) bool {
+	switch expression := expression.(type) {
+	case *ast.IntegerExpression:
+		return expression.Value.Sign() != 0
+
+	case *ast.FixedPointExpression:
+		return expression.UnsignedInteger.Sign() != 0 ||
+			expression.Fractional.Sign() != 0
+
+	case *ast.UnaryExpression:
+		return expression.Operation == ast.OperationMinus &&
+			isNonZeroNumberLiteral(expression.Expression)
+
+	default:
+		return false
+	}
+}
@@ -39,22 +39,28 @@ func (checker *Checker) visitStatements(statements []ast.Statement) {
 		// Is this statement unreachable? Report it once for this statement,
 		// but avoid noise and don't report it for all remaining unreachable statements
 
-		definitelyReturnedOrHalted :=
-			functionActivation.ReturnInfo.DefinitelyReturned ||
-				functionActivation.ReturnInfo.DefinitelyHalted
-
-		if definitelyReturnedOrHalted && !functionActivation.ReportedDeadCode {
+		if functionActivation.ReturnInfo.DefinitelyExited() && !functionActivation.ReportedDeadCode {
 
 			lastStatement := statements[len(statements)-1]
 
-			checker.report(
-				&UnreachableStatementError{
-					Range: ast.Range{
-						StartPos: statement.StartPosition(),
-						EndPos:   lastStatement.EndPosition(),
+			unreachableRange := ast.Range{
+				StartPos: statement.StartPosition(),
+				EndPos:   lastStatement.EndPosition(),
+			}
+
+			if checker.unreachableStatementIsWarning {
+				checker.warn(
+					&UnreachableStatementWarning{
+						Range: unreachableRange,
+					},
+				)
+			} else {
+				checker.report(
+					&UnreachableStatementError{
+						Range: unreachableRange,
 					},
-				},
-			)
+				)
+			}
 
 			functionActivation.ReportedDeadCode = true
 		}
@@ -19,6 +19,8 @@
 package sema
 
 import (
+	"fmt"
+
 	"github.com/onflow/cadence/runtime/ast"
 	"github.com/onflow/cadence/runtime/common"
 	"github.com/onflow/cadence/runtime/errors"
@@ -62,6 +64,14 @@ func (checker *Checker) visitCompositeDeclaration(declaration *ast.CompositeDecl
 	// NOTE: functions are checked separately
 	checker.checkFieldsAccessModifier(declaration.Members.Fields())
 
+	checker.checkPublicDocComment(
+		declaration.Access,
+		declaration.DeclarationKind(),
+		declaration.Identifier,
+		declaration.DocString,
+	)
+	checker.checkFieldsPublicDocComments(declaration.Members.Fields())
+
 	checker.checkNestedIdentifiers(declaration.Members)
 
 	// Activate new scopes for nested types
@@ -95,6 +105,10 @@ func (checker *Checker) visitCompositeDeclaration(declaration *ast.CompositeDecl
 		}
 
 		initializationInfo = NewInitializationInfo(compositeType, fieldMembers)
+
+		if checker.recursiveCompositeTypesReported {
+			checker.checkRecursiveCompositeType(declaration, compositeType)
+		}
 	}
 
 	checker.checkInitializers(
@@ -195,6 +209,117 @@ func (checker *Checker) visitCompositeDeclaration(declaration *ast.CompositeDecl
 	}
 }
 
+// checkRecursiveCompositeType reports if the given composite type contains itself,
+// i.e. if a field of the composite type has a type which (transitively) contains the composite type directly,
+// without any indirection.
+//
+// Values of such types would have an infinite size.
+// Optional types, reference types, variable-sized array types, and dictionary types
+// provide indirection, as their values may be empty.
+//
+func (checker *Checker) checkRecursiveCompositeType(
+	declaration *ast.CompositeDeclaration,
+	compositeType *CompositeType,
+) {
+	visited := map[*CompositeType]bool{
+		compositeType: true,
+	}
+
+	// path is the list of fields from the declared composite type
+	// to the currently visited composite type
+	var path []string
+
+	// fieldName is the field of the declared composite type
+	// from which the currently visited composite type is reached
+	var fieldName string
+
+	var visit func(ty *CompositeType) bool
+	visit = func(ty *CompositeType) bool {
+		for _, name := range ty.Fields {
+			member, ok := ty.Members.Get(name)
+			if !ok {
+				continue
+			}
+
+			if ty == compositeType {
+				fieldName = name
+			}
+
+			path = append(path, fmt.Sprintf("%s.%s", ty.QualifiedString(), name))
+
+			for _, containedType := range directlyContainedCompositeTypes(member.TypeAnnotation.Type) {
+				if containedType == compositeType {
+					return true
+				}
+
+				if visited[containedType] {
+					continue
+				}
+				visited[containedType] = true
+
+				if visit(containedType) {
+					return true
+				}
+			}
+
+			path = path[:len(path)-1]
+		}
+
+		return false
+	}
+
+	if !visit(compositeType) {
+		return
+	}
+
+	// Report the error at the field of the declaration which starts the cycle
+
+	var errorRange ast.Range
+	for _, field := range declaration.Members.Fields() {
+		if field.Identifier.Identifier == fieldName {
+			errorRange = ast.NewRangeFromPositioned(field.Identifier)
+			break
+		}
+	}
+
+	checker.report(
+		&RecursiveCompositeTypeError{
+			Type:  compositeType,
+			Path:  path,
+			Range: errorRange,
+		},
+	)
+}
+
+// directlyContainedCompositeTypes returns the composite types
+// which are contained in values of the given type without indirection.
+//
+func directlyContainedCompositeTypes(ty Type) []*CompositeType {
+	switch ty := ty.(type) {
+	case *CompositeType:
+		return []*CompositeType{ty}
+
+	case *ConstantSizedType:
+		if ty.Size == 0 {
+			return nil
+		}
+		return directlyContainedCompositeTypes(ty.Type)
+
+	case *TupleType:
+		var result []*CompositeType
+		for _, elementTypeAnnotation := range ty.ElementTypeAnnotations {
+			result = append(
+				result,
+				directlyContainedCompositeTypes(elementTypeAnnotation.Type)...,
+			)
+		}
+		return result
+
+	default:
+		return nil
+	}
+}
+
 // declareCompositeNestedTypes declares the types nested in a composite,
 // and the constructors for them if `declareConstructors` is true
 // and `kind` is `ContainerKindComposite`.
@@ -627,7 +752,7 @@ func (checker *Checker) declareCompositeMembersAndValue(
 		compositeType.Members = members
 		compositeType.Fields = fields
 		if checker.originsAndOccurrencesEnabled {
-			checker.memberOrigins[compositeType] = origins
+			checker.Elaboration.setMemberOrigins(compositeType, origins)
 		}
 	})()
 
@@ -757,12 +882,20 @@ func (checker *Checker) declareEnumConstructor(
 
 	memberCaseTypeAnnotation := NewTypeAnnotation(compositeType)
 
+	// The constructor of a nested enum is declared twice,
+	// for the container's members and for the nested declaration itself,
+	// so replace any previously declared cases instead of duplicating them
+
+	compositeType.EnumCases = make([]string, 0, len(enumCases))
+
 	for _, enumCase := range enumCases {
 		caseName := enumCase.Identifier.Identifier
 
 		if _, ok := constructorMembers.Get(caseName); ok {
 			continue
 		}
+		compositeType.EnumCases = append(compositeType.EnumCases, caseName)
+
 		constructorMembers.Set(
 			caseName,
 			&Member{
@@ -783,12 +916,13 @@ func (checker *Checker) declareEnumConstructor(
 					enumCase.Identifier.StartPosition(),
 					enumCase.Identifier.EndPosition(),
 					compositeType,
+					enumCase.DocString,
 				)
 		}
 	}
 
 	if checker.originsAndOccurrencesEnabled {
-		checker.memberOrigins[constructorType] = constructorOrigins
+		checker.Elaboration.setMemberOrigins(constructorType, constructorOrigins)
 	}
 
 	_, err := checker.valueActivations.Declare(variableDeclaration{
@@ -1069,8 +1203,12 @@ func (checker *Checker) checkCompositeConformance(
 	}
 }
 
-// TODO: return proper error
 func (checker *Checker) memberSatisfied(compositeMember, interfaceMember *Member) bool {
+	return memberSatisfied(compositeMember, interfaceMember, checker.accessCheckMode)
+}
+
+// TODO: return proper error
+func memberSatisfied(compositeMember, interfaceMember *Member, accessCheckMode AccessCheckMode) bool {
 
 	// Check declaration kind
 
@@ -1155,10 +1293,10 @@ func (checker *Checker) memberSatisfied(compositeMember, interfaceMember *Member
 
 	// Check access
 
-	effectiveInterfaceMemberAccess := checker.effectiveInterfaceMemberAccess(interfaceMember.Access)
-	effectiveCompositeMemberAccess := checker.effectiveCompositeMemberAccess(compositeMember.Access)
+	interfaceMemberAccess := effectiveInterfaceMemberAccess(interfaceMember.Access)
+	compositeMemberAccess := effectiveCompositeMemberAccess(compositeMember.Access, accessCheckMode)
 
-	return !effectiveCompositeMemberAccess.IsLessPermissiveThan(effectiveInterfaceMemberAccess)
+	return !compositeMemberAccess.IsLessPermissiveThan(interfaceMemberAccess)
 }
 
 // checkTypeRequirement checks conformance of a nested type declaration
@@ -1333,6 +1471,20 @@ func (checker *Checker) defaultMembersAndOrigins(
 		)
 	}
 
+	// Bound the number of fields, if configured,
+	// as the cost of decoding values is proportional to it
+
+	if checker.maxCompositeFields > 0 && len(fields) > checker.maxCompositeFields {
+		checker.report(
+			&TooManyFieldsError{
+				ContainerDeclarationKind: containerDeclarationKind,
+				FieldCount:               len(fields),
+				MaxFieldCount:            checker.maxCompositeFields,
+				Range:                    ast.NewRangeFromPositioned(fields[checker.maxCompositeFields]),
+			},
+		)
+	}
+
 	requireVariableKind := containerKind != ContainerKindInterface
 	requireNonPrivateMemberAccess := containerKind == ContainerKindInterface
 
@@ -1422,6 +1574,7 @@ func (checker *Checker) defaultMembersAndOrigins(
 					field.StartPos,
 					field.EndPos,
 					fieldTypeAnnotation.Type,
+					field.DocString,
 				)
 		}
 
@@ -1530,6 +1683,7 @@ func (checker *Checker) eventMembersAndOrigins(
 					parameter.StartPos,
 					parameter.EndPos,
 					typeAnnotation.Type,
+					"",
 				)
 		}
 	}
@@ -1729,6 +1883,14 @@ func (checker *Checker) checkSpecialFunction(
 				containerDeclarationKind,
 				specialFunction.Kind,
 			)
+
+			if interfaceType, ok := containerType.(*InterfaceType); ok {
+				recordInterfaceFunctionConditions(
+					interfaceType,
+					specialFunction.FunctionDeclaration.Identifier.Identifier,
+					specialFunction.FunctionDeclaration.FunctionBlock,
+				)
+			}
 		}
 
 	case ContainerKindComposite:
@@ -1967,6 +2129,8 @@ func (checker *Checker) checkNoDestructorNoResourceFields(
 		return
 	}
 
+	var fieldNames []string
+
 	for pair := members.Oldest(); pair != nil; pair = pair.Next() {
 		member := pair.Value
 		memberName := pair.Key
@@ -1977,17 +2141,23 @@ func (checker *Checker) checkNoDestructorNoResourceFields(
 			continue
 		}
 
-		checker.report(
-			&MissingDestructorError{
-				ContainerType:  containerType,
-				FirstFieldName: memberName,
-				FirstFieldPos:  fields[memberName].Identifier.Pos,
-			},
-		)
+		fieldNames = append(fieldNames, memberName)
+	}
 
-		// only report for first member
+	if len(fieldNames) == 0 {
 		return
 	}
+
+	firstFieldName := fieldNames[0]
+
+	checker.report(
+		&MissingDestructorError{
+			ContainerType:  containerType,
+			FirstFieldName: firstFieldName,
+			FirstFieldPos:  fields[firstFieldName].Identifier.Pos,
+			FieldNames:     fieldNames,
+		},
+	)
 }
 
 func (checker *Checker) checkDestructor(
@@ -36,6 +36,10 @@ func (checker *Checker) VisitIfStatement(statement *ast.IfStatement) ast.Repr {
 	case ast.Expression:
 		checker.visitConditional(test, thenElement, elseElement)
 
+		if checker.constantConditionsReported {
+			checker.reportConstantCondition(test)
+		}
+
 	case *ast.VariableDeclaration:
 		checker.checkConditionalBranches(
 			func() Type {
@@ -62,6 +66,10 @@ func (checker *Checker) VisitIfStatement(statement *ast.IfStatement) ast.Repr {
 
 func (checker *Checker) VisitConditionalExpression(expression *ast.ConditionalExpression) ast.Repr {
 
+	if checker.deepTernaryHintDepth > 0 {
+		checker.checkTernaryChainDepth(expression)
+	}
+
 	thenType, elseType := checker.visitConditional(expression.Test, expression.Then, expression.Else)
 
 	if thenType == nil || elseType == nil {
@@ -84,6 +92,48 @@ func (checker *Checker) VisitConditionalExpression(expression *ast.ConditionalEx
 	return resultType
 }
 
+// checkTernaryChainDepth reports a hint if the given conditional expression
+// is the start of a chain of conditional expressions nested in else-branches,
+// e.g. `a ? b : (c ? d : e)`, which is deeper than the configured depth.
+//
+// Only the outermost conditional expression of a chain is reported.
+//
+func (checker *Checker) checkTernaryChainDepth(expression *ast.ConditionalExpression) {
+
+	if _, ok := checker.ternaryElseBranches[expression]; ok {
+		return
+	}
+
+	depth := 1
+
+	current := expression
+	for {
+		elseExpression, ok := current.Else.(*ast.ConditionalExpression)
+		if !ok {
+			break
+		}
+
+		if checker.ternaryElseBranches == nil {
+			checker.ternaryElseBranches = map[*ast.ConditionalExpression]struct{}{}
+		}
+		checker.ternaryElseBranches[elseExpression] = struct{}{}
+
+		depth++
+		current = elseExpression
+	}
+
+	if depth <= checker.deepTernaryHintDepth {
+		return
+	}
+
+	checker.hint(
+		&DeepTernaryHint{
+			Depth: depth,
+			Range: ast.NewRangeFromPositioned(expression),
+		},
+	)
+}
+
 // visitConditional checks a conditional.
 // The test expression must be a boolean.
 // The "then" and "else" elements may be expressions, in which case their types are returned.
@@ -213,3 +263,152 @@ func (checker *Checker) checkBranch(
 		},
 	)()
 }
+
+// reportConstantCondition reports the given condition if it is always true or always false.
+//
+func (checker *Checker) reportConstantCondition(condition ast.Expression) {
+	value, ok := constantConditionValue(condition)
+	if !ok {
+		return
+	}
+
+	checker.warn(
+		&ConstantConditionWarning{
+			Value: value,
+			Range: ast.NewRangeFromPositioned(condition),
+		},
+	)
+}
+
+// constantConditionValue folds the given boolean expression
+// and returns its value, if it is always the same.
+//
+// Boolean literals, negations, and logical operations are folded.
+// Comparisons are folded if both operands are the same variable,
+// or if both operands are literals of the same kind.
+//
+func constantConditionValue(expression ast.Expression) (value bool, ok bool) {
+	switch expression := expression.(type) {
+	case *ast.BoolExpression:
+		return expression.Value, true
+
+	case *ast.UnaryExpression:
+		if expression.Operation != ast.OperationNegate {
+			return false, false
+		}
+
+		value, ok := constantConditionValue(expression.Expression)
+		return !value, ok
+
+	case *ast.BinaryExpression:
+		switch expression.Operation {
+		case ast.OperationAnd, ast.OperationOr:
+			return constantLogicalValue(expression)
+
+		case ast.OperationEqual,
+			ast.OperationNotEqual,
+			ast.OperationLess,
+			ast.OperationLessEqual,
+			ast.OperationGreater,
+			ast.OperationGreaterEqual:
+
+			return constantComparisonValue(expression)
+		}
+	}
+
+	return false, false
+}
+
+func constantLogicalValue(expression *ast.BinaryExpression) (value bool, ok bool) {
+	leftValue, leftOK := constantConditionValue(expression.Left)
+	rightValue, rightOK := constantConditionValue(expression.Right)
+
+	// The result of a logical operation is determined
+	// by a single operand which is false for `&&`, or true for `||`
+
+	determiningValue := expression.Operation == ast.OperationOr
+
+	if (leftOK && leftValue == determiningValue) ||
+		(rightOK && rightValue == determiningValue) {
+
+		return determiningValue, true
+	}
+
+	if leftOK && rightOK {
+		return !determiningValue, true
+	}
+
+	return false, false
+}
+
+func constantComparisonValue(expression *ast.BinaryExpression) (value bool, ok bool) {
+
+	// Compare the operands: the comparison is negative, zero, or positive.
+	// Booleans and strings can only be compared for equality
+
+	var comparison int
+	ordered := true
+
+	switch left := expression.Left.(type) {
+	case *ast.IdentifierExpression:
+		right, ok := expression.Right.(*ast.IdentifierExpression)
+		if !ok || left.Identifier.Identifier != right.Identifier.Identifier {
+			return false, false
+		}
+		comparison = 0
+
+	case *ast.IntegerExpression:
+		right, ok := expression.Right.(*ast.IntegerExpression)
+		if !ok {
+			return false, false
+		}
+		comparison = left.Value.Cmp(right.Value)
+
+	case *ast.BoolExpression:
+		right, ok := expression.Right.(*ast.BoolExpression)
+		if !ok {
+			return false, false
+		}
+		if left.Value != right.Value {
+			comparison = 1
+		}
+		ordered = false
+
+	case *ast.StringExpression:
+		right, ok := expression.Right.(*ast.StringExpression)
+		if !ok {
+			return false, false
+		}
+		if left.Value != right.Value {
+			comparison = 1
+		}
+		ordered = false
+
+	default:
+		return false, false
+	}
+
+	switch expression.Operation {
+	case ast.OperationEqual:
+		return comparison == 0, true
+	case ast.OperationNotEqual:
+		return comparison != 0, true
+	}
+
+	if !ordered {
+		return false, false
+	}
+
+	switch expression.Operation {
+	case ast.OperationLess:
+		return comparison < 0, true
+	case ast.OperationLessEqual:
+		return comparison <= 0, true
+	case ast.OperationGreater:
+		return comparison > 0, true
+	case ast.OperationGreaterEqual:
+		return comparison >= 0, true
+	}
+
+	return false, false
+}
@@ -40,6 +40,12 @@ func (checker *Checker) VisitIdentifierExpression(expression *ast.IdentifierExpr
 
 	checker.checkSelfVariableUseInInitializer(variable, identifier.Pos)
 
+	if checker.unusedFunctionsReported &&
+		variable.DeclarationKind == common.DeclarationKindFunction {
+
+		checker.recordFunctionReference(variable)
+	}
+
 	if checker.inInvocation {
 		checker.Elaboration.IdentifierInInvocationTypes[expression] = valueType
 	}
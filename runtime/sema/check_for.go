@@ -31,7 +31,8 @@ func (checker *Checker) VisitForStatement(statement *ast.ForStatement) ast.Repr
 	valueExpression := statement.Value
 	valueType := valueExpression.Accept(checker).(Type)
 
-	var elementType Type = InvalidType
+	var identifierType Type = InvalidType
+	var valueIdentifierType Type = InvalidType
 
 	if !valueType.IsInvalidType() {
 
@@ -46,8 +47,31 @@ func (checker *Checker) VisitForStatement(statement *ast.ForStatement) ast.Repr
 					Range: ast.NewRangeFromPositioned(valueExpression),
 				},
 			)
+		} else if statement.ValueIdentifier != nil {
+
+			// Loops with two bindings iterate over the entries of a dictionary,
+			// or over the indices and elements of an array
+
+			switch valueType := valueType.(type) {
+			case *DictionaryType:
+				identifierType = valueType.KeyType
+				valueIdentifierType = valueType.ValueType
+
+			case ArrayType:
+				identifierType = &IntType{}
+				valueIdentifierType = valueType.ElementType(false)
+
+			default:
+				checker.report(
+					&TypeMismatchWithDescriptionError{
+						ExpectedTypeDescription: "array or dictionary",
+						ActualType:              valueType,
+						Range:                   ast.NewRangeFromPositioned(valueExpression),
+					},
+				)
+			}
 		} else if arrayType, ok := valueType.(ArrayType); ok {
-			elementType = arrayType.ElementType(false)
+			identifierType = arrayType.ElementType(false)
 		} else {
 			checker.report(
 				&TypeMismatchWithDescriptionError{
@@ -59,20 +83,10 @@ func (checker *Checker) VisitForStatement(statement *ast.ForStatement) ast.Repr
 		}
 	}
 
-	identifier := statement.Identifier.Identifier
+	checker.declareForLoopVariable(statement.Identifier, identifierType)
 
-	variable, err := checker.valueActivations.Declare(variableDeclaration{
-		identifier:               identifier,
-		ty:                       elementType,
-		kind:                     common.DeclarationKindConstant,
-		pos:                      statement.Identifier.Pos,
-		isConstant:               true,
-		argumentLabels:           nil,
-		allowOuterScopeShadowing: false,
-	})
-	checker.report(err)
-	if checker.originsAndOccurrencesEnabled {
-		checker.recordVariableDeclarationOccurrence(identifier, variable)
+	if statement.ValueIdentifier != nil {
+		checker.declareForLoopVariable(*statement.ValueIdentifier, valueIdentifierType)
 	}
 
 	// The body of the loop will maybe be evaluated.
@@ -80,7 +94,7 @@ func (checker *Checker) VisitForStatement(statement *ast.ForStatement) ast.Repr
 	// returns are not definite, but only potential.
 
 	_ = checker.checkPotentiallyUnevaluated(func() Type {
-		checker.functionActivations.WithLoop(func() {
+		checker.withLoop(statement.Label, func() {
 			statement.Block.Accept(checker)
 		})
 
@@ -92,3 +106,19 @@ func (checker *Checker) VisitForStatement(statement *ast.ForStatement) ast.Repr
 
 	return nil
 }
+
+func (checker *Checker) declareForLoopVariable(identifier ast.Identifier, ty Type) {
+	variable, err := checker.valueActivations.Declare(variableDeclaration{
+		identifier:               identifier.Identifier,
+		ty:                       ty,
+		kind:                     common.DeclarationKindConstant,
+		pos:                      identifier.Pos,
+		isConstant:               true,
+		argumentLabels:           nil,
+		allowOuterScopeShadowing: false,
+	})
+	checker.report(err)
+	if checker.originsAndOccurrencesEnabled {
+		checker.recordVariableDeclarationOccurrence(identifier.Identifier, variable)
+	}
+}
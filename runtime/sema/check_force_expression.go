@@ -24,6 +24,15 @@ import (
 
 func (checker *Checker) VisitForceExpression(expression *ast.ForceExpression) ast.Repr {
 
+	if checker.forceUnwrapDisallowed {
+		checker.report(
+			&ForbiddenOperatorError{
+				Operator: "!",
+				Pos:      expression.EndPos,
+			},
+		)
+	}
+
 	valueType := expression.Expression.Accept(checker).(Type)
 
 	if valueType.IsInvalidType() {
@@ -19,6 +19,9 @@
 package sema
 
 import (
+	"fmt"
+	"strings"
+
 	"github.com/onflow/cadence/runtime/ast"
 	"github.com/onflow/cadence/runtime/common"
 	"github.com/onflow/cadence/runtime/errors"
@@ -61,6 +64,13 @@ func (checker *Checker) visitFunctionDeclaration(
 		true,
 	)
 
+	checker.checkPublicDocComment(
+		declaration.Access,
+		declaration.DeclarationKind(),
+		declaration.Identifier,
+		declaration.DocString,
+	)
+
 	// global functions were previously declared, see `declareFunctionDeclaration`
 
 	functionType := checker.Elaboration.FunctionDeclarationFunctionTypes[declaration]
@@ -74,6 +84,10 @@ func (checker *Checker) visitFunctionDeclaration(
 
 	checker.Elaboration.FunctionDeclarationFunctionTypes[declaration] = functionType
 
+	if checker.publicAuthAccountDisallowed {
+		checker.checkPublicAuthAccount(declaration, functionType)
+	}
+
 	checker.checkFunction(
 		declaration.ParameterList,
 		declaration.ReturnTypeAnnotation,
@@ -87,6 +101,73 @@ func (checker *Checker) visitFunctionDeclaration(
 	return nil
 }
 
+// checkPublicAuthAccount reports parameters and return types of the given function declaration
+// which contain `AuthAccount`, if the function is public.
+//
+func (checker *Checker) checkPublicAuthAccount(
+	declaration *ast.FunctionDeclaration,
+	functionType *FunctionType,
+) {
+	switch declaration.Access {
+	case ast.AccessPublic, ast.AccessPublicSettable:
+		break
+	default:
+		return
+	}
+
+	if declaration.ParameterList != nil {
+		for i, parameter := range declaration.ParameterList.Parameters {
+			parameterType := functionType.Parameters[i].TypeAnnotation.Type
+			if !containsAuthAccount(parameterType) {
+				continue
+			}
+
+			checker.report(
+				&LeakedAuthAccountError{
+					Type:  parameterType,
+					Range: ast.NewRangeFromPositioned(parameter.TypeAnnotation),
+				},
+			)
+		}
+	}
+
+	returnTypeAnnotation := declaration.ReturnTypeAnnotation
+	if returnTypeAnnotation != nil &&
+		functionType.ReturnTypeAnnotation != nil {
+
+		returnType := functionType.ReturnTypeAnnotation.Type
+		if containsAuthAccount(returnType) {
+			checker.report(
+				&LeakedAuthAccountError{
+					Type:  returnType,
+					Range: ast.NewRangeFromPositioned(returnTypeAnnotation),
+				},
+			)
+		}
+	}
+}
+
+// containsAuthAccount returns true if the given type is `AuthAccount`,
+// or an optional, array, dictionary, or reference type which contains `AuthAccount`.
+//
+func containsAuthAccount(ty Type) bool {
+	switch ty := ty.(type) {
+	case *OptionalType:
+		return containsAuthAccount(ty.Type)
+	case *VariableSizedType:
+		return containsAuthAccount(ty.Type)
+	case *ConstantSizedType:
+		return containsAuthAccount(ty.Type)
+	case *DictionaryType:
+		return containsAuthAccount(ty.KeyType) ||
+			containsAuthAccount(ty.ValueType)
+	case *ReferenceType:
+		return containsAuthAccount(ty.Type)
+	default:
+		return ty == AuthAccountType
+	}
+}
+
 func (checker *Checker) declareFunctionDeclaration(
 	declaration *ast.FunctionDeclaration,
 	functionType *FunctionType,
@@ -155,6 +236,8 @@ func (checker *Checker) checkFunction(
 
 			functionActivation := checker.functionActivations.Current()
 			functionActivation.InitializationInfo = initializationInfo
+			functionActivation.MissingReturnTypeAnnotationPos =
+				missingReturnTypeAnnotationPosition(returnTypeAnnotation)
 
 			if functionBlock != nil {
 				checker.visitFunctionBlock(
@@ -170,12 +253,39 @@ func (checker *Checker) checkFunction(
 			}
 
 			if initializationInfo != nil {
-				checker.checkFieldMembersInitialized(initializationInfo)
+				checker.checkFieldMembersInitialized(
+					initializationInfo,
+					functionType.Parameters,
+					functionBlock,
+				)
 			}
 		},
 	)
 }
 
+// missingReturnTypeAnnotationPosition returns the position after which the given
+// return type annotation is missing, or nil if the return type is annotated.
+//
+// The parser represents a missing return type annotation
+// as a nominal type with an empty identifier.
+//
+func missingReturnTypeAnnotationPosition(returnTypeAnnotation *ast.TypeAnnotation) *ast.Position {
+	if returnTypeAnnotation == nil {
+		return nil
+	}
+
+	nominalType, ok := returnTypeAnnotation.Type.(*ast.NominalType)
+	if !ok ||
+		nominalType.Identifier.Identifier != "" ||
+		len(nominalType.NestedIdentifiers) > 0 {
+
+		return nil
+	}
+
+	pos := returnTypeAnnotation.StartPos
+	return &pos
+}
+
 // checkFunctionExits checks that the given function block exits
 // with a return-type appropriate return statement.
 // The return is not needed if the function has a `Void` return type.
@@ -270,6 +380,8 @@ func (checker *Checker) declareParameters(
 			continue
 		}
 
+		checker.checkVariableShadowing(common.DeclarationKindParameter, identifier)
+
 		parameterType := parameters[i].TypeAnnotation.Type
 
 		variable := &Variable{
@@ -410,7 +522,17 @@ func (checker *Checker) VisitFunctionExpression(expression *ast.FunctionExpressi
 // checkFieldMembersInitialized checks that all fields that were required
 // to be initialized (as stated in the initialization info) have been initialized.
 //
-func (checker *Checker) checkFieldMembersInitialized(info *InitializationInfo) {
+// If multiple fields are not initialized, a single error is reported,
+// which lists all uninitialized fields.
+//
+func (checker *Checker) checkFieldMembersInitialized(
+	info *InitializationInfo,
+	parameters []*Parameter,
+	functionBlock *ast.FunctionBlock,
+) {
+	var uninitializedMembers []*Member
+	var uninitializedFields []*ast.FieldDeclaration
+
 	for pair := info.FieldMembers.Oldest(); pair != nil; pair = pair.Next() {
 		member := pair.Key
 		field := pair.Value
@@ -420,12 +542,224 @@ func (checker *Checker) checkFieldMembersInitialized(info *InitializationInfo) {
 			continue
 		}
 
+		uninitializedMembers = append(uninitializedMembers, member)
+		uninitializedFields = append(uninitializedFields, field)
+	}
+
+	switch len(uninitializedFields) {
+	case 0:
+		return
+
+	case 1:
+		field := uninitializedFields[0]
+
 		checker.report(
 			&FieldUninitializedError{
 				Name:          field.Identifier.Identifier,
 				Pos:           field.Identifier.Pos,
 				ContainerType: info.ContainerType,
+				Fixes: fieldInitializationFixes(
+					uninitializedMembers,
+					parameters,
+					functionBlock,
+				),
+			},
+		)
+
+	default:
+		names := make([]string, len(uninitializedFields))
+		for i, field := range uninitializedFields {
+			names[i] = field.Identifier.Identifier
+		}
+
+		firstIdentifier := uninitializedFields[0].Identifier
+
+		checker.report(
+			&MissingFieldInitializationError{
+				Names:         names,
+				ContainerType: info.ContainerType,
+				Fixes: fieldInitializationFixes(
+					uninitializedMembers,
+					parameters,
+					functionBlock,
+				),
+				Range: ast.NewRangeFromPositioned(firstIdentifier),
 			},
 		)
 	}
 }
+
+// fieldInitializationFixes returns the suggested fixes for the given uninitialized field members.
+//
+// Only fields for which the initializer has a parameter with the same name and type
+// can be initialized automatically: the single suggested fix assigns the parameters
+// to the fields at the end of the initializer.
+//
+func fieldInitializationFixes(
+	uninitializedMembers []*Member,
+	parameters []*Parameter,
+	functionBlock *ast.FunctionBlock,
+) []TextEdit {
+
+	if functionBlock == nil || functionBlock.Block == nil {
+		return nil
+	}
+
+	var sb strings.Builder
+
+	// Insert the assignments in front of the closing brace of the initializer,
+	// indented one level deeper than the brace
+
+	endPos := functionBlock.Block.EndPos
+	indentation := strings.Repeat(" ", endPos.Column)
+
+	for _, member := range uninitializedMembers {
+		name := member.Identifier.Identifier
+		fieldType := member.TypeAnnotation.Type
+
+		for _, parameter := range parameters {
+			if parameter.Identifier != name ||
+				!parameter.TypeAnnotation.Type.Equal(fieldType) {
+
+				continue
+			}
+
+			transferOperation := ast.TransferOperationCopy
+			if fieldType.IsResourceType() {
+				transferOperation = ast.TransferOperationMove
+			}
+
+			sb.WriteString(
+				fmt.Sprintf(
+					"    %s.%s %s %s\n%s",
+					SelfIdentifier,
+					name,
+					transferOperation.Operator(),
+					name,
+					indentation,
+				),
+			)
+
+			break
+		}
+	}
+
+	if sb.Len() == 0 {
+		return nil
+	}
+
+	return []TextEdit{
+		{
+			Insertion: sb.String(),
+			Range: ast.Range{
+				StartPos: endPos,
+				EndPos:   endPos,
+			},
+		},
+	}
+}
+
+func (checker *Checker) recordFunctionReference(variable *Variable) {
+	if checker.referencedFunctions == nil {
+		checker.referencedFunctions = map[*Variable]struct{}{}
+	}
+	checker.referencedFunctions[variable] = struct{}{}
+}
+
+// reportUnusedFunctions reports the global functions and the functions of composites
+// declared in the given program which are never referenced in the program.
+//
+func (checker *Checker) reportUnusedFunctions(program *ast.Program) {
+
+	entryPointDeclaration := FunctionEntryPointDeclaration(program)
+
+	for _, declaration := range program.FunctionDeclarations() {
+		if declaration == entryPointDeclaration {
+			continue
+		}
+
+		variable, ok := checker.Elaboration.GlobalValues.Get(declaration.Identifier.Identifier)
+		if !ok {
+			continue
+		}
+
+		if _, ok := checker.referencedFunctions[variable]; ok {
+			continue
+		}
+
+		checker.reportUnusedFunction(declaration)
+	}
+
+	// Functions of composites are only referenced through member expressions
+
+	referencedMembers := map[*Member]struct{}{}
+	for _, memberInfo := range checker.Elaboration.MemberExpressionMemberInfos {
+		if memberInfo.Member == nil {
+			continue
+		}
+		referencedMembers[memberInfo.Member] = struct{}{}
+	}
+
+	for _, declaration := range program.CompositeDeclarations() {
+		checker.reportUnusedCompositeFunctions(declaration, referencedMembers)
+	}
+}
+
+func (checker *Checker) reportUnusedCompositeFunctions(
+	declaration *ast.CompositeDeclaration,
+	referencedMembers map[*Member]struct{},
+) {
+	compositeType := checker.Elaboration.CompositeDeclarationTypes[declaration]
+
+	for _, functionDeclaration := range declaration.Members.Functions() {
+		name := functionDeclaration.Identifier.Identifier
+
+		member, ok := compositeType.Members.Get(name)
+		if !ok {
+			continue
+		}
+
+		if _, ok := referencedMembers[member]; ok {
+			continue
+		}
+
+		if isConformanceRequirement(compositeType, name) {
+			continue
+		}
+
+		checker.reportUnusedFunction(functionDeclaration)
+	}
+
+	for _, nestedDeclaration := range declaration.Members.Composites() {
+		checker.reportUnusedCompositeFunctions(nestedDeclaration, referencedMembers)
+	}
+}
+
+// isConformanceRequirement returns true if the member with the given name
+// is required by an interface the given composite type conforms to,
+// or by a type requirement it conforms to.
+//
+func isConformanceRequirement(compositeType *CompositeType, name string) bool {
+	for _, interfaceType := range compositeType.ExplicitInterfaceConformances {
+		if _, ok := interfaceType.Members.Get(name); ok {
+			return true
+		}
+	}
+
+	for _, typeRequirement := range compositeType.ImplicitTypeRequirementConformances {
+		if _, ok := typeRequirement.Members.Get(name); ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (checker *Checker) reportUnusedFunction(declaration *ast.FunctionDeclaration) {
+	checker.warn(
+		&UnusedFunctionError{
+			Name:  declaration.Identifier.Identifier,
+			Range: ast.NewRangeFromPositioned(declaration.Identifier),
+		},
+	)
+}
@@ -55,6 +55,14 @@ func (checker *Checker) VisitInterfaceDeclaration(declaration *ast.InterfaceDecl
 	// NOTE: functions are checked separately
 	checker.checkFieldsAccessModifier(declaration.Members.Fields())
 
+	checker.checkPublicDocComment(
+		declaration.Access,
+		declaration.DeclarationKind(),
+		declaration.Identifier,
+		declaration.DocString,
+	)
+	checker.checkFieldsPublicDocComments(declaration.Members.Fields())
+
 	checker.checkNestedIdentifiers(declaration.Members)
 
 	// Activate new scope for nested types
@@ -76,6 +84,11 @@ func (checker *Checker) VisitInterfaceDeclaration(declaration *ast.InterfaceDecl
 		nil,
 	)
 
+	checker.checkInterfaceInitializerParameters(
+		declaration.Members.Initializers(),
+		declaration.Members.FieldsByIdentifier(),
+	)
+
 	checker.checkUnknownSpecialFunctions(declaration.Members.SpecialFunctions())
 
 	checker.checkInterfaceFunctions(
@@ -187,11 +200,47 @@ func (checker *Checker) checkInterfaceFunctions(
 					declarationKind,
 					common.DeclarationKindFunction,
 				)
+
+				if interfaceType, ok := selfType.(*InterfaceType); ok {
+					recordInterfaceFunctionConditions(
+						interfaceType,
+						function.Identifier.Identifier,
+						function.FunctionBlock,
+					)
+				}
 			}
 		}()
 	}
 }
 
+// recordInterfaceFunctionConditions records the conditions of the given function requirement,
+// including the special functions `init` and `destroy`,
+// in the interface type, if the function requirement declares any
+//
+func recordInterfaceFunctionConditions(
+	interfaceType *InterfaceType,
+	functionName string,
+	functionBlock *ast.FunctionBlock,
+) {
+	var conditions FunctionConditions
+
+	if functionBlock.PreConditions != nil {
+		conditions.PreConditions = *functionBlock.PreConditions
+	}
+	if functionBlock.PostConditions != nil {
+		conditions.PostConditions = *functionBlock.PostConditions
+	}
+
+	if len(conditions.PreConditions) == 0 && len(conditions.PostConditions) == 0 {
+		return
+	}
+
+	if interfaceType.functionConditions == nil {
+		interfaceType.functionConditions = map[string]FunctionConditions{}
+	}
+	interfaceType.functionConditions[functionName] = conditions
+}
+
 // declareInterfaceType declares the type for the given interface declaration
 // and records it in the elaboration. It also recursively declares all types
 // for all nested declarations.
@@ -312,7 +361,7 @@ func (checker *Checker) declareInterfaceMembers(declaration *ast.InterfaceDeclar
 	interfaceType.Members = members
 	interfaceType.Fields = fields
 	if checker.originsAndOccurrencesEnabled {
-		checker.memberOrigins[interfaceType] = origins
+		checker.Elaboration.setMemberOrigins(interfaceType, origins)
 	}
 
 	// NOTE: determine initializer parameter types while nested types are in scope,
@@ -358,4 +407,150 @@ func (checker *Checker) checkInterfaceSpecialFunctionBlock(
 			},
 		)
 	}
+
+	if implementedKind == common.DeclarationKindInitializer {
+		checker.checkInterfaceInitializerBeforeExpressions(functionBlock)
+	}
+}
+
+// checkInterfaceInitializerBeforeExpressions reports an error for each access
+// of a field of `self` in a `before` expression of an initializer requirement's post-conditions.
+//
+// `before` expressions are evaluated when the initializer is entered,
+// at which point no field of the conforming composite is initialized yet.
+//
+// Other post-conditions of initializer requirements may access the fields,
+// as the implementation of the initializer must initialize them.
+//
+func (checker *Checker) checkInterfaceInitializerBeforeExpressions(functionBlock *ast.FunctionBlock) {
+
+	postConditions := functionBlock.PostConditions
+	if postConditions == nil {
+		return
+	}
+
+	postConditionsRewrite, ok := checker.Elaboration.PostConditionsRewrite[postConditions]
+	if !ok {
+		return
+	}
+
+	reporter := &beforeFieldAccessReporter{
+		checker: checker,
+	}
+	extractor := &ast.ExpressionExtractor{
+		MemberExtractor:   reporter,
+		FunctionExtractor: reporter,
+	}
+
+	for _, beforeStatement := range postConditionsRewrite.BeforeStatements {
+		variableDeclaration, ok := beforeStatement.(*ast.VariableDeclaration)
+		if !ok {
+			continue
+		}
+
+		extractor.Extract(variableDeclaration.Value)
+	}
+}
+
+// beforeFieldAccessReporter reports accesses of fields of `self` as uninitialized field accesses.
+// See `checkInterfaceInitializerBeforeExpressions`
+//
+type beforeFieldAccessReporter struct {
+	checker *Checker
+}
+
+func (r *beforeFieldAccessReporter) ExtractMember(
+	extractor *ast.ExpressionExtractor,
+	expression *ast.MemberExpression,
+) ast.ExpressionExtraction {
+
+	member := r.checker.accessedSelfMember(expression)
+	if member != nil && member.DeclarationKind == common.DeclarationKindField {
+		r.checker.report(
+			&UninitializedFieldAccessError{
+				Name: expression.Identifier.Identifier,
+				Pos:  expression.Identifier.Pos,
+			},
+		)
+	}
+
+	return extractor.ExtractMember(expression)
+}
+
+func (r *beforeFieldAccessReporter) ExtractFunction(
+	_ *ast.ExpressionExtractor,
+	expression *ast.FunctionExpression,
+) ast.ExpressionExtraction {
+
+	// NOTE: function expressions are not supported by the expression extractor, so return as-is
+
+	return ast.ExpressionExtraction{
+		RewrittenExpression: expression,
+	}
+}
+
+// checkInterfaceInitializerParameters reports a hint for each parameter of an initializer requirement
+// which does not correspond to any field of the interface.
+//
+// Conforming composites have no meaningful way to use such parameters,
+// unless they are used in the conditions of the initializer requirement
+//
+func (checker *Checker) checkInterfaceInitializerParameters(
+	initializers []*ast.SpecialFunctionDeclaration,
+	fields map[string]*ast.FieldDeclaration,
+) {
+	// NOTE: multiple initializers are reported when checking initializers
+
+	if len(initializers) != 1 {
+		return
+	}
+
+	functionDeclaration := initializers[0].FunctionDeclaration
+
+	parameterList := functionDeclaration.ParameterList
+	if parameterList == nil {
+		return
+	}
+
+	// Find the identifiers referenced in the conditions
+
+	identifierCollector := NewIdentifierCollector()
+
+	functionBlock := functionDeclaration.FunctionBlock
+	if functionBlock != nil {
+		for _, conditions := range []*ast.Conditions{
+			functionBlock.PreConditions,
+			functionBlock.PostConditions,
+		} {
+			if conditions == nil {
+				continue
+			}
+
+			for _, condition := range *conditions {
+				identifierCollector.Collect(condition.Test)
+				if condition.Message != nil {
+					identifierCollector.Collect(condition.Message)
+				}
+			}
+		}
+	}
+
+	for _, parameter := range parameterList.Parameters {
+		name := parameter.Identifier.Identifier
+
+		if _, ok := fields[name]; ok {
+			continue
+		}
+
+		if _, ok := identifierCollector.Identifiers[name]; ok {
+			continue
+		}
+
+		checker.hint(
+			&InitializerParameterWithoutFieldHint{
+				ParameterName: name,
+				Range:         ast.NewRangeFromPositioned(parameter.Identifier),
+			},
+		)
+	}
 }
@@ -32,9 +32,7 @@ func (checker *Checker) VisitMemberExpression(expression *ast.MemberExpression)
 		memberAccessType := accessedType
 
 		if expression.Optional {
-			if memberAccessOptionalType, ok := memberAccessType.(*OptionalType); ok {
-				memberAccessType = memberAccessOptionalType.Type
-			}
+			memberAccessType = UnwrapOptionalType(memberAccessType)
 		}
 
 		if checker.originsAndOccurrencesEnabled {
@@ -172,10 +170,13 @@ func (checker *Checker) visitMember(expression *ast.MemberExpression) (accessedT
 		// If the member expression is using optional chaining,
 		// check if the accessed type is optional
 
-		if optionalExpressionType, ok := accessedType.(*OptionalType); ok {
-			// The accessed type is optional, get the member from the wrapped type
+		if _, ok := accessedType.(*OptionalType); ok {
+			// The accessed type is optional, get the member from the wrapped type.
+			//
+			// Nested optional types are flattened, e.g. when accessing a member
+			// of the result of indexing into a dictionary with optional values
 
-			getMemberForType(optionalExpressionType.Type)
+			getMemberForType(UnwrapOptionalType(accessedType))
 			isOptional = true
 		} else {
 			// Optional chaining was used on a non-optional type, report an error
@@ -219,7 +220,7 @@ func (checker *Checker) visitMember(expression *ast.MemberExpression) (accessedT
 	} else {
 
 		if checker.originsAndOccurrencesEnabled {
-			origins := checker.memberOrigins[accessedType]
+			origins := checker.Elaboration.memberOrigins[accessedType]
 			origin := origins[identifier]
 			checker.Occurrences.Put(
 				identifierStartPosition,
@@ -0,0 +1,113 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2020 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sema
+
+import (
+	"github.com/onflow/cadence/runtime/ast"
+)
+
+func (checker *Checker) VisitRepeatWhileStatement(statement *ast.RepeatWhileStatement) ast.Repr {
+
+	checker.checkRepeatWhileBlock(statement)
+
+	testExpression := statement.Test
+	testType := testExpression.Accept(checker).(Type)
+
+	if !testType.IsInvalidType() &&
+		!IsSubType(testType, BoolType) {
+
+		checker.report(
+			&TypeMismatchError{
+				ExpectedType: BoolType,
+				ActualType:   testType,
+				Range:        ast.NewRangeFromPositioned(testExpression),
+			},
+		)
+	}
+
+	checker.reportResourceUsesInLoop(statement.StartPos, statement.EndPosition())
+
+	return nil
+}
+
+// checkRepeatWhileBlock checks the body of a repeat-while loop.
+//
+// The body of the loop is evaluated at least once.
+// That means that resource invalidations, returns, and field initializations
+// in the body are definite, unless the body potentially jumps,
+// i.e. a `break` or `continue` statement may skip the remainder of the body.
+// In that case they are only potential, like for other loops.
+//
+func (checker *Checker) checkRepeatWhileBlock(statement *ast.RepeatWhileStatement) {
+	functionActivation := checker.functionActivations.Current()
+
+	initialReturnInfo := functionActivation.ReturnInfo
+	temporaryReturnInfo := initialReturnInfo.Clone()
+	temporaryReturnInfo.MaybeJumped = false
+
+	var temporaryInitializedMembers *MemberSet
+	if functionActivation.InitializationInfo != nil {
+		initialInitializedMembers := functionActivation.InitializationInfo.InitializedFieldMembers
+		temporaryInitializedMembers = initialInitializedMembers.Clone()
+	}
+
+	initialResources := checker.resources
+	temporaryResources := initialResources.Clone()
+
+	_ = checker.checkBranch(
+		func() Type {
+			checker.withLoop(statement.Label, func() {
+				statement.Block.Accept(checker)
+			})
+
+			// ignored
+			return nil
+		},
+		temporaryReturnInfo,
+		temporaryInitializedMembers,
+		temporaryResources,
+	)
+
+	returnInfo := functionActivation.ReturnInfo
+
+	returnInfo.MaybeReturned = returnInfo.MaybeReturned ||
+		temporaryReturnInfo.MaybeReturned
+
+	if temporaryReturnInfo.MaybeJumped {
+		returnInfo.MaybeJumped = true
+
+		checker.resources.MergeBranches(temporaryResources, nil)
+
+		return
+	}
+
+	returnInfo.DefinitelyReturned = returnInfo.DefinitelyReturned ||
+		temporaryReturnInfo.DefinitelyReturned
+
+	returnInfo.DefinitelyHalted = returnInfo.DefinitelyHalted ||
+		temporaryReturnInfo.DefinitelyHalted
+
+	if functionActivation.InitializationInfo != nil {
+		functionActivation.InitializationInfo.InitializedFieldMembers = temporaryInitializedMembers
+	}
+
+	// Merging the same branch twice makes the invalidations definite
+
+	checker.resources.MergeBranches(temporaryResources, temporaryResources)
+}
@@ -62,6 +62,28 @@ func (checker *Checker) VisitReturnStatement(statement *ast.ReturnStatement) ast
 	// then the return statement should not have a return value
 
 	if returnType == VoidType {
+
+		// If explicit return types are required, and the function
+		// has no return type annotation, report the missing annotation instead
+
+		missingReturnTypeAnnotationPos := functionActivation.MissingReturnTypeAnnotationPos
+
+		if checker.explicitReturnTypesRequired &&
+			missingReturnTypeAnnotationPos != nil &&
+			!valueType.IsInvalidType() &&
+			valueType != VoidType {
+
+			checker.report(
+				&MissingReturnTypeAnnotationError{
+					ValueType:     valueType,
+					AnnotationPos: *missingReturnTypeAnnotationPos,
+					Range:         ast.NewRangeFromPositioned(statement.Expression),
+				},
+			)
+
+			return nil
+		}
+
 		checker.report(
 			&InvalidReturnValueError{
 				Range: ast.NewRangeFromPositioned(statement.Expression),
@@ -20,6 +20,7 @@ package sema
 
 import (
 	"github.com/onflow/cadence/runtime/ast"
+	"github.com/onflow/cadence/runtime/common"
 )
 
 func (checker *Checker) VisitSwitchStatement(statement *ast.SwitchStatement) ast.Repr {
@@ -49,10 +50,44 @@ func (checker *Checker) VisitSwitchStatement(statement *ast.SwitchStatement) ast
 		checker.visitSwitchCase(switchCase, defaultAllowed, testType, testTypeIsValid)
 	}
 
+	if testTypeIsValid {
+		var caseExpressions []ast.Expression
+		var defaultCaseRange *ast.Range
+
+		for _, switchCase := range statement.Cases {
+			if switchCase.Expression == nil {
+				defaultCaseRange = &switchCase.Range
+				continue
+			}
+			caseExpressions = append(caseExpressions, switchCase.Expression)
+		}
+
+		checker.checkSwitchExhaustiveness(
+			caseExpressions,
+			defaultCaseRange,
+			testType,
+			statement.Range,
+			checker.nonExhaustiveSwitchReported,
+		)
+	}
+
+	functionActivation := checker.functionActivations.Current()
+	initialDefinitelyJumped := functionActivation.ReturnInfo.DefinitelyJumped
+	initialMaybeBroke := functionActivation.ReturnInfo.MaybeBroke
+
 	checker.functionActivations.WithSwitch(func() {
 		checker.checkSwitchCasesStatements(statement.Cases)
 	})
 
+	// A `break` statement in a case only exits the switch statement,
+	// so if any case potentially breaks, the statements following the switch statement are reachable
+
+	returnInfo := functionActivation.ReturnInfo
+	if returnInfo.MaybeBroke {
+		returnInfo.DefinitelyJumped = initialDefinitelyJumped
+	}
+	returnInfo.MaybeBroke = initialMaybeBroke
+
 	return nil
 }
 
@@ -182,3 +217,132 @@ func (checker *Checker) checkSwitchCaseStatements(switchCase *ast.SwitchCase) {
 	}
 	block.Accept(checker)
 }
+
+// checkSwitchExhaustiveness checks switch statements and expressions over enums.
+//
+// If the switch has no default case and exhaustiveness is required,
+// an error is reported if not all enum cases are covered by the case expressions.
+// Case expressions cover the enum case which is their constant value, see enumCaseName.
+//
+// If the switch has a default case, but all enum cases are covered,
+// the default case is redundant and reported as a warning, or optionally as an error.
+//
+func (checker *Checker) checkSwitchExhaustiveness(
+	caseExpressions []ast.Expression,
+	defaultCaseRange *ast.Range,
+	testType Type,
+	switchRange ast.Range,
+	exhaustivenessRequired bool,
+) {
+
+	enumType, ok := testType.(*CompositeType)
+	if !ok || enumType.Kind != common.CompositeKindEnum {
+		return
+	}
+
+	coveredCases := make(map[string]bool, len(caseExpressions))
+
+	for _, caseExpression := range caseExpressions {
+		caseName, ok := checker.enumCaseName(caseExpression)
+		if !ok {
+			continue
+		}
+
+		coveredCases[caseName] = true
+	}
+
+	var missingCases []string
+
+	for _, enumCase := range enumType.EnumCases {
+		if !coveredCases[enumCase] {
+			missingCases = append(missingCases, enumCase)
+		}
+	}
+
+	if defaultCaseRange != nil {
+
+		// A default case covers all remaining cases.
+		// If there are none, the default case is redundant
+
+		if len(missingCases) == 0 {
+			checker.reportRedundantDefaultCase(enumType, *defaultCaseRange)
+		}
+		return
+	}
+
+	if !exhaustivenessRequired || len(missingCases) == 0 {
+		return
+	}
+
+	checker.report(
+		&NonExhaustiveSwitchError{
+			Type:         enumType,
+			MissingCases: missingCases,
+			Range:        switchRange,
+		},
+	)
+}
+
+// enumCaseName returns the name of the enum case which is the constant value of the given expression.
+//
+// The expression is either an enum case, e.g. `E.a`,
+// or a constant which is declared with an enum case, e.g. `c` for `let c = E.a`.
+// Other expressions, e.g. function calls, have no constant value,
+// so they are not considered to cover any case.
+//
+func (checker *Checker) enumCaseName(expression ast.Expression) (string, bool) {
+	switch expression := expression.(type) {
+	case *ast.MemberExpression:
+		memberInfo, ok := checker.Elaboration.MemberExpressionMemberInfos[expression]
+		if !ok {
+			return "", false
+		}
+
+		// Only members of the enum constructor are enum cases
+
+		if _, ok := memberInfo.AccessedType.(*SpecialFunctionType); !ok {
+			return "", false
+		}
+
+		return memberInfo.Member.Identifier.Identifier, true
+
+	case *ast.IdentifierExpression:
+		variable := checker.valueActivations.Find(expression.Identifier.Identifier)
+		if variable == nil {
+			return "", false
+		}
+
+		caseName, ok := checker.enumCaseConstants[variable]
+		return caseName, ok
+	}
+
+	return "", false
+}
+
+// recordEnumCaseConstant records the enum case of the given constant,
+// if it is declared with an enum case, see enumCaseName.
+//
+func (checker *Checker) recordEnumCaseConstant(variable *Variable, value ast.Expression) {
+	caseName, ok := checker.enumCaseName(value)
+	if !ok {
+		return
+	}
+
+	if checker.enumCaseConstants == nil {
+		checker.enumCaseConstants = map[*Variable]string{}
+	}
+	checker.enumCaseConstants[variable] = caseName
+}
+
+func (checker *Checker) reportRedundantDefaultCase(enumType *CompositeType, defaultCaseRange ast.Range) {
+	redundantDefaultCaseError := &RedundantDefaultCaseError{
+		Type:  enumType,
+		Range: defaultCaseRange,
+	}
+
+	if checker.redundantDefaultCaseIsError {
+		checker.report(redundantDefaultCaseError)
+	} else {
+		checker.warn(redundantDefaultCaseError)
+	}
+}
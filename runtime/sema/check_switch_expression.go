@@ -0,0 +1,231 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2020 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sema
+
+import (
+	"github.com/onflow/cadence/runtime/ast"
+	"github.com/onflow/cadence/runtime/common"
+)
+
+func (checker *Checker) VisitSwitchExpression(expression *ast.SwitchExpression) ast.Repr {
+
+	testType := expression.Expression.Accept(checker).(Type)
+
+	testTypeIsValid := !testType.IsInvalidType()
+
+	// The test expression must be equatable
+
+	if testTypeIsValid && !testType.IsEquatable() {
+		checker.report(
+			&NotEquatableTypeError{
+				Type:  testType,
+				Range: ast.NewRangeFromPositioned(expression.Expression),
+			},
+		)
+	}
+
+	// Check all case expressions
+
+	var caseExpressions []ast.Expression
+	var defaultCaseRange *ast.Range
+
+	caseCount := len(expression.Cases)
+
+	for i, switchCase := range expression.Cases {
+		caseExpression := switchCase.Expression
+
+		// If the case has no expression, it is a default case
+
+		if caseExpression == nil {
+
+			// Only one default case is allowed, as the last case
+			if i != caseCount-1 {
+				checker.report(
+					&SwitchDefaultPositionError{
+						Range: switchCase.Range,
+					},
+				)
+			}
+
+			defaultCaseRange = &switchCase.Range
+			continue
+		}
+
+		checker.checkSwitchCaseExpression(caseExpression, testType, testTypeIsValid)
+
+		caseExpressions = append(caseExpressions, caseExpression)
+	}
+
+	// A switch expression must produce a value,
+	// so it must either be an exhaustive switch over an enum,
+	// or have a default case
+
+	if testTypeIsValid {
+		checker.checkSwitchExhaustiveness(
+			caseExpressions,
+			defaultCaseRange,
+			testType,
+			expression.Range,
+			true,
+		)
+
+		if defaultCaseRange == nil && !isEnumType(testType) {
+			checker.report(
+				&MissingSwitchExpressionDefaultCaseError{
+					Type:  testType,
+					Range: expression.Range,
+				},
+			)
+		}
+	}
+
+	// Check all case results
+
+	caseResultTypes := make([]Type, caseCount)
+
+	checker.checkSwitchExpressionResults(expression.Cases, caseResultTypes)
+
+	// The type of the switch expression is the common super type of all case results
+
+	var resultType Type
+
+	for i, caseResultType := range caseResultTypes {
+
+		if resultType == nil {
+			resultType = caseResultType
+			continue
+		}
+
+		superType := commonSuperType(resultType, caseResultType)
+		if superType == nil {
+			checker.report(
+				&TypeMismatchError{
+					ExpectedType: resultType,
+					ActualType:   caseResultType,
+					Range:        ast.NewRangeFromPositioned(expression.Cases[i].Result),
+				},
+			)
+			continue
+		}
+
+		resultType = superType
+	}
+
+	if resultType == nil {
+		resultType = InvalidType
+	}
+
+	checker.Elaboration.SwitchExpressionCaseResultTypes[expression] = caseResultTypes
+	checker.Elaboration.SwitchExpressionResultTypes[expression] = resultType
+
+	return resultType
+}
+
+// checkSwitchExpressionResults checks the results of the given switch expression cases,
+// and stores the result types in the given slice.
+//
+func (checker *Checker) checkSwitchExpressionResults(
+	cases []*ast.SwitchExpressionCase,
+	resultTypes []Type,
+) {
+	caseCount := len(cases)
+	if caseCount == 0 {
+		return
+	}
+
+	// NOTE: always check results as if they're only *potentially* evaluated.
+	// However, a switch expression is exhaustive, i.e. one case will definitely be taken,
+	// so the last case's result must be checked directly as the "else".
+
+	if caseCount == 1 {
+		resultTypes[0] = checker.checkSwitchExpressionResult(cases[0])
+		return
+	}
+
+	_, _ = checker.checkConditionalBranches(
+		func() Type {
+			resultTypes[0] = checker.checkSwitchExpressionResult(cases[0])
+			return nil
+		},
+		func() Type {
+			checker.checkSwitchExpressionResults(cases[1:], resultTypes[1:])
+			return nil
+		},
+	)
+}
+
+func (checker *Checker) checkSwitchExpressionResult(switchCase *ast.SwitchExpressionCase) Type {
+	resultType := switchCase.Result.Accept(checker).(Type)
+
+	// A resource result is moved out of the case
+
+	checker.checkResourceMoveOperation(switchCase.Result, resultType)
+
+	return resultType
+}
+
+func isEnumType(ty Type) bool {
+	compositeType, ok := ty.(*CompositeType)
+	return ok && compositeType.Kind == common.CompositeKindEnum
+}
+
+// commonSuperType returns a common super type of the given types,
+// or nil if there is none, i.e. if a resource type and a non-resource type are given.
+//
+func commonSuperType(left, right Type) Type {
+
+	if left.IsInvalidType() || right.IsInvalidType() {
+		return InvalidType
+	}
+
+	if IsSubType(right, left) {
+		return left
+	}
+
+	if IsSubType(left, right) {
+		return right
+	}
+
+	// One of the types might be optional, e.g. `Int` and `Int?`,
+	// or `Int` and the type of `nil`
+
+	optionalLeft := &OptionalType{Type: left}
+	if IsSubType(right, optionalLeft) {
+		return optionalLeft
+	}
+
+	optionalRight := &OptionalType{Type: right}
+	if IsSubType(left, optionalRight) {
+		return optionalRight
+	}
+
+	leftIsResource := left.IsResourceType()
+	rightIsResource := right.IsResourceType()
+
+	switch {
+	case leftIsResource && rightIsResource:
+		return AnyResourceType
+
+	case !leftIsResource && !rightIsResource:
+		return AnyStructType
+
+	default:
+		return nil
+	}
+}
@@ -281,7 +281,7 @@ func (checker *Checker) declareTransactionDeclaration(declaration *ast.Transacti
 	transactionType.Members = members
 	transactionType.Fields = fields
 	if checker.originsAndOccurrencesEnabled {
-		checker.memberOrigins[transactionType] = origins
+		checker.Elaboration.setMemberOrigins(transactionType, origins)
 	}
 
 	if declaration.Prepare != nil {
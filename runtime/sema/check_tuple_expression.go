@@ -0,0 +1,39 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2020 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sema
+
+import "github.com/onflow/cadence/runtime/ast"
+
+func (checker *Checker) VisitTupleExpression(expression *ast.TupleExpression) ast.Repr {
+
+	// visit all elements, the type of the tuple is the types of all elements
+
+	elementTypes := make([]Type, len(expression.Elements))
+
+	for i, element := range expression.Elements {
+		elementType := element.Accept(checker).(Type)
+
+		elementTypes[i] = elementType
+
+		checker.checkVariableMove(element)
+		checker.checkResourceMoveOperation(element, elementType)
+	}
+
+	return NewTupleType(elementTypes)
+}
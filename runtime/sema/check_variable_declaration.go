@@ -211,6 +211,8 @@ func (checker *Checker) visitVariableDeclaration(declaration *ast.VariableDeclar
 
 	identifier := declaration.Identifier.Identifier
 
+	checker.checkVariableShadowing(declaration.DeclarationKind(), declaration.Identifier)
+
 	variable, err := checker.valueActivations.Declare(variableDeclaration{
 		identifier:               identifier,
 		ty:                       declarationType,
@@ -225,6 +227,120 @@ func (checker *Checker) visitVariableDeclaration(declaration *ast.VariableDeclar
 	if checker.originsAndOccurrencesEnabled {
 		checker.recordVariableDeclarationOccurrence(identifier, variable)
 	}
+
+	if declaration.IsConstant && !isOptionalBinding && variable != nil {
+		checker.recordEnumCaseConstant(variable, declaration.Value)
+	}
+}
+
+func (checker *Checker) VisitTupleVariableDeclaration(declaration *ast.TupleVariableDeclaration) ast.Repr {
+
+	// Determine the type of the value of the declaration
+	// and save it in the elaboration
+
+	valueType := declaration.Value.Accept(checker).(Type)
+
+	checker.Elaboration.TupleVariableDeclarationValueTypes[declaration] = valueType
+
+	// Determine the declaration type based on the value type and the optional type annotation
+
+	declarationType := valueType
+
+	if declaration.TypeAnnotation != nil {
+
+		typeAnnotation := checker.ConvertTypeAnnotation(declaration.TypeAnnotation)
+		checker.checkTypeAnnotation(typeAnnotation, declaration.TypeAnnotation)
+
+		declarationType = typeAnnotation.Type
+
+		if !valueType.IsInvalidType() &&
+			!declarationType.IsInvalidType() &&
+			!checker.checkTypeCompatibility(declaration.Value, valueType, declarationType) {
+
+			checker.report(
+				&TypeMismatchError{
+					ExpectedType: declarationType,
+					ActualType:   valueType,
+					Range:        ast.NewRangeFromPositioned(declaration.Value),
+				},
+			)
+		}
+	}
+
+	// The declaration type must be a tuple type,
+	// with an element for each declared variable
+
+	tupleType, isTuple := declarationType.(*TupleType)
+	if !isTuple {
+		if !declarationType.IsInvalidType() {
+			checker.report(
+				&TypeMismatchWithDescriptionError{
+					ExpectedTypeDescription: "tuple type",
+					ActualType:              declarationType,
+					Range:                   ast.NewRangeFromPositioned(declaration.Value),
+				},
+			)
+		}
+	} else if len(tupleType.ElementTypeAnnotations) != len(declaration.Identifiers) {
+
+		firstIdentifier := declaration.Identifiers[0]
+		lastIdentifier := declaration.Identifiers[len(declaration.Identifiers)-1]
+
+		checker.report(
+			&TupleVariableDeclarationCountError{
+				ExpectedCount: len(tupleType.ElementTypeAnnotations),
+				ActualCount:   len(declaration.Identifiers),
+				Range: ast.Range{
+					StartPos: firstIdentifier.StartPosition(),
+					EndPos:   lastIdentifier.EndPosition(),
+				},
+			},
+		)
+	}
+
+	checker.Elaboration.TupleVariableDeclarationTargetTypes[declaration] = tupleType
+
+	checker.checkTransfer(declaration.Transfer, declarationType)
+
+	checker.checkVariableMove(declaration.Value)
+
+	// The value is invalidated (if it has a resource type),
+	// its resource elements are tracked through the declared variables
+
+	checker.recordResourceInvalidation(
+		declaration.Value,
+		declarationType,
+		ResourceInvalidationKindMoveDefinite,
+	)
+
+	// Finally, declare a variable for each element in the current value activation
+
+	for i, identifier := range declaration.Identifiers {
+
+		var elementType Type = InvalidType
+		if isTuple && i < len(tupleType.ElementTypeAnnotations) {
+			elementType = tupleType.ElementTypeAnnotations[i].Type
+		}
+
+		checker.checkVariableShadowing(declaration.DeclarationKind(), identifier)
+
+		variable, err := checker.valueActivations.Declare(variableDeclaration{
+			identifier:               identifier.Identifier,
+			ty:                       elementType,
+			access:                   ast.AccessNotSpecified,
+			kind:                     declaration.DeclarationKind(),
+			pos:                      identifier.Pos,
+			isConstant:               declaration.IsConstant,
+			argumentLabels:           nil,
+			allowOuterScopeShadowing: true,
+		})
+		checker.report(err)
+		if checker.originsAndOccurrencesEnabled {
+			checker.recordVariableDeclarationOccurrence(identifier.Identifier, variable)
+		}
+	}
+
+	return nil
 }
 
 func (checker *Checker) checkVariableDeclarationUsability(declaration *ast.VariableDeclaration) {
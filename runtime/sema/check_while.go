@@ -44,8 +44,10 @@ func (checker *Checker) VisitWhileStatement(statement *ast.WhileStatement) ast.R
 	// That means that resource invalidations and
 	// returns are not definite, but only potential.
 
+	var maybeBroke bool
+
 	_ = checker.checkPotentiallyUnevaluated(func() Type {
-		checker.functionActivations.WithLoop(func() {
+		maybeBroke = checker.withLoop(statement.Label, func() {
 			statement.Block.Accept(checker)
 		})
 
@@ -53,11 +55,106 @@ func (checker *Checker) VisitWhileStatement(statement *ast.WhileStatement) ast.R
 		return nil
 	})
 
+	if checker.constantConditionsReported {
+		value, ok := constantConditionValue(testExpression)
+
+		// A loop with an always true condition is intentional
+		// if it is exited with a `break` statement
+
+		if ok && !(value && maybeBroke) {
+			checker.warn(
+				&ConstantConditionWarning{
+					Value: value,
+					Range: ast.NewRangeFromPositioned(testExpression),
+				},
+			)
+		}
+	}
+
 	checker.reportResourceUsesInLoop(statement.StartPos, statement.EndPosition())
 
 	return nil
 }
 
+// withLoop runs the given function in the context of a loop,
+// which declares the given label, if any.
+//
+// Labels may not shadow the labels of enclosing loops.
+//
+// Returns true if the loop is potentially exited with a `break` statement.
+//
+func (checker *Checker) withLoop(label *ast.Identifier, f func()) (maybeBroke bool) {
+	functionActivation := checker.functionActivations.Current()
+	returnInfo := functionActivation.ReturnInfo
+
+	// Only unlabeled `break` statements which are directly in the loop,
+	// i.e. not in a nested loop or switch statement, set `MaybeBroke`
+
+	initialMaybeBroke := returnInfo.MaybeBroke
+	returnInfo.MaybeBroke = false
+
+	// Labeled `break` statements record the label.
+	// Nested loops remove their own label when they are left,
+	// so the remaining labels are of this loop or of enclosing loops,
+	// i.e. the `break` statements exit this loop
+
+	initialBrokenLoopLabels := functionActivation.BrokenLoopLabels
+	functionActivation.BrokenLoopLabels = nil
+
+	if label == nil {
+		checker.functionActivations.WithLoop(f)
+	} else {
+		if functionActivation.HasLoopLabel(label.Identifier) {
+			checker.report(
+				&RedeclaredLabelError{
+					Name:  label.Identifier,
+					Range: ast.NewRangeFromPositioned(label),
+				},
+			)
+		}
+
+		checker.functionActivations.WithLabeledLoop(label.Identifier, f)
+	}
+
+	maybeBroke = returnInfo.MaybeBroke ||
+		len(functionActivation.BrokenLoopLabels) > 0
+
+	returnInfo.MaybeBroke = initialMaybeBroke
+
+	brokenLoopLabels := functionActivation.BrokenLoopLabels
+	functionActivation.BrokenLoopLabels = initialBrokenLoopLabels
+
+	for brokenLoopLabel := range brokenLoopLabels {
+		if label != nil && brokenLoopLabel == label.Identifier {
+			continue
+		}
+		functionActivation.recordBrokenLoopLabel(brokenLoopLabel)
+	}
+
+	return maybeBroke
+}
+
+// checkJumpLabel checks that the given label of a `break` or `continue` statement,
+// if any, is declared by an enclosing loop.
+//
+func (checker *Checker) checkJumpLabel(label *ast.Identifier) bool {
+	if label == nil {
+		return true
+	}
+
+	if !checker.functionActivations.Current().HasLoopLabel(label.Identifier) {
+		checker.report(
+			&UndefinedLabelError{
+				Name:  label.Identifier,
+				Range: ast.NewRangeFromPositioned(label),
+			},
+		)
+		return false
+	}
+
+	return true
+}
+
 func (checker *Checker) reportResourceUsesInLoop(startPos, endPos ast.Position) {
 
 	checker.resources.ForEach(func(resource interface{}, info ResourceInfo) {
@@ -123,6 +220,25 @@ func (checker *Checker) VisitBreakStatement(statement *ast.BreakStatement) ast.R
 				Range:            ast.NewRangeFromPositioned(statement),
 			},
 		)
+
+		return nil
+	}
+
+	if !checker.checkJumpLabel(statement.Label) {
+		return nil
+	}
+
+	returnInfo := checker.functionActivations.Current().ReturnInfo
+	returnInfo.DefinitelyJumped = true
+	returnInfo.MaybeJumped = true
+
+	// A labeled `break` statement always exits a loop,
+	// so it does not only exit an enclosing switch statement
+
+	if statement.Label == nil {
+		returnInfo.MaybeBroke = true
+	} else {
+		checker.functionActivations.Current().recordBrokenLoopLabel(statement.Label.Identifier)
 	}
 
 	return nil
@@ -139,7 +255,17 @@ func (checker *Checker) VisitContinueStatement(statement *ast.ContinueStatement)
 				Range:            ast.NewRangeFromPositioned(statement),
 			},
 		)
+
+		return nil
 	}
 
+	if !checker.checkJumpLabel(statement.Label) {
+		return nil
+	}
+
+	returnInfo := checker.functionActivations.Current().ReturnInfo
+	returnInfo.DefinitelyJumped = true
+	returnInfo.MaybeJumped = true
+
 	return nil
 }
@@ -21,6 +21,7 @@ package sema
 import (
 	"math"
 	"math/big"
+	"strings"
 
 	"github.com/rivo/uniseg"
 
@@ -85,6 +86,7 @@ type Checker struct {
 	PredeclaredTypes                   []TypeDeclaration
 	accessCheckMode                    AccessCheckMode
 	errors                             []error
+	warnings                           []Warning
 	hints                              []Hint
 	valueActivations                   *VariableActivations
 	resources                          *Resources
@@ -95,7 +97,6 @@ type Checker struct {
 	originsAndOccurrencesEnabled       bool
 	Occurrences                        *Occurrences
 	variableOrigins                    map[*Variable]*Origin
-	memberOrigins                      map[Type]map[string]*Origin
 	MemberAccesses                     *MemberAccesses
 	isChecked                          bool
 	inCreate                           bool
@@ -109,6 +110,27 @@ type Checker struct {
 	locationHandler                    LocationHandlerFunc
 	importHandler                      ImportHandlerFunc
 	checkHandler                       CheckHandlerFunc
+	variableShadowingReported          bool
+	forceUnwrapDisallowed              bool
+	redundantDefaultCaseIsError        bool
+	nonExhaustiveSwitchReported        bool
+	explicitReturnTypesRequired        bool
+	possibleDivisionByZeroReported     bool
+	warningsAsErrors                   bool
+	publicAuthAccountDisallowed        bool
+	unusedFunctionsReported            bool
+	constantConditionsReported         bool
+	referencedFunctions                map[*Variable]struct{}
+	publicDocCommentsRequired          bool
+	deepTernaryHintDepth               int
+	maxCompositeFields                 int
+	recursiveCompositeTypesReported    bool
+	unreachableStatementIsWarning      bool
+	// enum case names of constants which are declared with an enum case, e.g. `let c = E.a`
+	enumCaseConstants map[*Variable]string
+	// conditional expressions which are the else-branch of another conditional expression,
+	// i.e. which are part of a chain that is already checked for its depth
+	ternaryElseBranches map[*ast.ConditionalExpression]struct{}
 }
 
 type Option func(*Checker) error
@@ -204,7 +226,6 @@ func WithOriginsAndOccurrencesEnabled(enabled bool) Option {
 	return func(checker *Checker) error {
 		checker.originsAndOccurrencesEnabled = enabled
 		if enabled {
-			checker.memberOrigins = map[Type]map[string]*Origin{}
 			checker.variableOrigins = map[*Variable]*Origin{}
 			checker.Occurrences = NewOccurrences()
 			checker.MemberAccesses = NewMemberAccesses()
@@ -214,6 +235,192 @@ func WithOriginsAndOccurrencesEnabled(enabled bool) Option {
 	}
 }
 
+// WithVariableShadowingReported returns a checker option which enables/disables
+// if the shadowing of variables of outer scopes is reported as a warning.
+//
+func WithVariableShadowingReported(enabled bool) Option {
+	return func(checker *Checker) error {
+		checker.variableShadowingReported = enabled
+		return nil
+	}
+}
+
+// WithForceUnwrapDisallowed returns a checker option which enables/disables
+// if the force-unwrap operator (`!`) is reported as an error.
+//
+func WithForceUnwrapDisallowed(disallowed bool) Option {
+	return func(checker *Checker) error {
+		checker.forceUnwrapDisallowed = disallowed
+		return nil
+	}
+}
+
+// WithRedundantDefaultCaseReportedAsError returns a checker option which enables/disables
+// if redundant default cases of switch statements over enums are reported as errors.
+//
+// If disabled, redundant default cases are reported as warnings.
+//
+func WithRedundantDefaultCaseReportedAsError(enabled bool) Option {
+	return func(checker *Checker) error {
+		checker.redundantDefaultCaseIsError = enabled
+		return nil
+	}
+}
+
+// WithNonExhaustiveSwitchReported returns a checker option which enables/disables
+// if switch statements over enums without a default case,
+// which do not cover all enum cases, are reported as errors.
+//
+// Switch expressions over enums must always be exhaustive.
+//
+func WithNonExhaustiveSwitchReported(enabled bool) Option {
+	return func(checker *Checker) error {
+		checker.nonExhaustiveSwitchReported = enabled
+		return nil
+	}
+}
+
+// WithExplicitReturnTypesRequired returns a checker option which enables/disables
+// if functions which return a value must have an explicit return type annotation.
+//
+// If enabled, returning a value from a function without a return type annotation
+// is reported as a missing return type annotation.
+//
+func WithExplicitReturnTypesRequired(required bool) Option {
+	return func(checker *Checker) error {
+		checker.explicitReturnTypesRequired = required
+		return nil
+	}
+}
+
+// WithPossibleDivisionByZeroReported returns a checker option which enables/disables
+// if divisions and remainders are reported as warnings
+// when the divisor is not known to be non-zero.
+//
+// Currently only non-zero number literals are known to be non-zero.
+//
+func WithPossibleDivisionByZeroReported(enabled bool) Option {
+	return func(checker *Checker) error {
+		checker.possibleDivisionByZeroReported = enabled
+		return nil
+	}
+}
+
+// WithWarningsAsErrors returns a checker option which enables/disables
+// if warnings and hints are treated as errors,
+// i.e. if they are included in the errors and fail the checking of the program.
+//
+// The severity of the warnings and hints in the diagnostics is unchanged.
+//
+func WithWarningsAsErrors(enabled bool) Option {
+	return func(checker *Checker) error {
+		checker.warningsAsErrors = enabled
+		return nil
+	}
+}
+
+// WithPublicDocCommentsRequired returns a checker option which enables/disables
+// if public declarations without a doc comment are reported.
+//
+// If enabled, a public composite, interface, field, or function declaration
+// which has no doc comment is reported as a warning.
+//
+func WithPublicDocCommentsRequired(enabled bool) Option {
+	return func(checker *Checker) error {
+		checker.publicDocCommentsRequired = enabled
+		return nil
+	}
+}
+
+// WithDeepTernaryHintDepth returns a checker option which sets
+// the maximum depth of chained conditional expressions, e.g. `a ? b : (c ? d : e)`,
+// before a hint is reported which suggests to use a switch expression instead.
+//
+// A depth of zero, the default, disables the hint.
+//
+func WithDeepTernaryHintDepth(depth int) Option {
+	return func(checker *Checker) error {
+		checker.deepTernaryHintDepth = depth
+		return nil
+	}
+}
+
+// WithMaxCompositeFields returns a checker option which sets
+// the maximum number of fields a composite or interface declaration may declare.
+//
+// A limit of zero, the default, means the number of fields is unlimited.
+//
+func WithMaxCompositeFields(max int) Option {
+	return func(checker *Checker) error {
+		checker.maxCompositeFields = max
+		return nil
+	}
+}
+
+// WithPublicAuthAccountDisallowed returns a checker option which enables/disables
+// if `AuthAccount` is disallowed in the signatures of public functions.
+//
+// If enabled, a parameter or return type of a public function which is `AuthAccount`,
+// or contains `AuthAccount`, e.g. `AuthAccount?` or `[AuthAccount]`,
+// is reported as a leaked `AuthAccount`.
+//
+func WithPublicAuthAccountDisallowed(disallowed bool) Option {
+	return func(checker *Checker) error {
+		checker.publicAuthAccountDisallowed = disallowed
+		return nil
+	}
+}
+
+// WithUnusedFunctionsReported returns a checker option which enables/disables
+// if functions which are never referenced in the program are reported as warnings.
+//
+// Global functions and functions of composites are considered,
+// except for the entry point function of a script,
+// and functions which are required by a conformance of the composite.
+//
+func WithUnusedFunctionsReported(enabled bool) Option {
+	return func(checker *Checker) error {
+		checker.unusedFunctionsReported = enabled
+		return nil
+	}
+}
+
+// WithConstantConditionsReported returns a checker option which enables/disables
+// if conditions of `if` and `while` statements which are always true or always false
+// are reported as warnings.
+//
+// A `while` statement with an always true condition is not reported
+// if its body contains a `break` statement which exits it.
+//
+func WithConstantConditionsReported(enabled bool) Option {
+	return func(checker *Checker) error {
+		checker.constantConditionsReported = enabled
+		return nil
+	}
+}
+
+// WithRecursiveCompositeTypesReported returns a checker option which enables/disables
+// if composite types which contain themselves without indirection are reported as errors.
+//
+// Values of such types would have an infinite size, so they can never be constructed.
+//
+func WithRecursiveCompositeTypesReported(enabled bool) Option {
+	return func(checker *Checker) error {
+		checker.recursiveCompositeTypesReported = enabled
+		return nil
+	}
+}
+
+// WithUnreachableStatementReportedAsWarning returns a checker option which enables/disables
+// if unreachable statements are reported as warnings instead of errors.
+//
+func WithUnreachableStatementReportedAsWarning(enabled bool) Option {
+	return func(checker *Checker) error {
+		checker.unreachableStatementIsWarning = enabled
+		return nil
+	}
+}
+
 func NewChecker(program *ast.Program, location common.Location, options ...Option) (*Checker, error) {
 
 	if location == nil {
@@ -267,6 +474,21 @@ func (checker *Checker) SubChecker(program *ast.Program, location common.Locatio
 		WithCheckHandler(checker.checkHandler),
 		WithImportHandler(checker.importHandler),
 		WithLocationHandler(checker.locationHandler),
+		WithVariableShadowingReported(checker.variableShadowingReported),
+		WithForceUnwrapDisallowed(checker.forceUnwrapDisallowed),
+		WithRedundantDefaultCaseReportedAsError(checker.redundantDefaultCaseIsError),
+		WithNonExhaustiveSwitchReported(checker.nonExhaustiveSwitchReported),
+		WithExplicitReturnTypesRequired(checker.explicitReturnTypesRequired),
+		WithPossibleDivisionByZeroReported(checker.possibleDivisionByZeroReported),
+		WithWarningsAsErrors(checker.warningsAsErrors),
+		WithPublicAuthAccountDisallowed(checker.publicAuthAccountDisallowed),
+		WithUnusedFunctionsReported(checker.unusedFunctionsReported),
+		WithConstantConditionsReported(checker.constantConditionsReported),
+		WithPublicDocCommentsRequired(checker.publicDocCommentsRequired),
+		WithDeepTernaryHintDepth(checker.deepTernaryHintDepth),
+		WithMaxCompositeFields(checker.maxCompositeFields),
+		WithRecursiveCompositeTypesReported(checker.recursiveCompositeTypesReported),
+		WithUnreachableStatementReportedAsWarning(checker.unreachableStatementIsWarning),
 	)
 }
 
@@ -320,6 +542,37 @@ func (checker *Checker) declareTypeDeclaration(declaration TypeDeclaration) {
 	}
 }
 
+// checkVariableShadowing reports a warning if shadowing is reported
+// and the declaration with the given name shadows a variable of an outer scope.
+//
+// Variables of the same scope are redeclarations and are reported separately.
+// Built-in and predeclared values have no position and are not considered
+//
+func (checker *Checker) checkVariableShadowing(kind common.DeclarationKind, identifier ast.Identifier) {
+	if !checker.variableShadowingReported {
+		return
+	}
+
+	existingVariable := checker.valueActivations.Find(identifier.Identifier)
+	if existingVariable == nil ||
+		existingVariable.IsBaseValue ||
+		existingVariable.Pos == nil ||
+		existingVariable.Pos.Line < 1 ||
+		existingVariable.ActivationDepth == checker.valueActivations.Depth() {
+
+		return
+	}
+
+	checker.warn(
+		&VariableShadowingWarning{
+			Kind:        kind,
+			Name:        identifier.Identifier,
+			Pos:         identifier.Pos,
+			PreviousPos: existingVariable.Pos,
+		},
+	)
+}
+
 func (checker *Checker) IsChecked() bool {
 	return checker.isChecked
 }
@@ -347,10 +600,11 @@ func (checker *Checker) Check() error {
 }
 
 func (checker *Checker) CheckerError() *CheckerError {
-	if len(checker.errors) > 0 {
+	errs := checker.Errors()
+	if len(errs) > 0 {
 		return &CheckerError{
 			Location: checker.Location,
-			Errors:   checker.errors,
+			Errors:   errs,
 		}
 	}
 	return nil
@@ -363,6 +617,10 @@ func (checker *Checker) report(err error) {
 	checker.errors = append(checker.errors, err)
 }
 
+func (checker *Checker) warn(warning Warning) {
+	checker.warnings = append(checker.warnings, warning)
+}
+
 func (checker *Checker) hint(hint Hint) {
 	checker.hints = append(checker.hints, hint)
 }
@@ -469,6 +727,10 @@ func (checker *Checker) VisitProgram(program *ast.Program) ast.Repr {
 		checker.declareGlobalDeclaration(declaration)
 	}
 
+	if checker.unusedFunctionsReported {
+		checker.reportUnusedFunctions(program)
+	}
+
 	return nil
 }
 
@@ -623,6 +885,31 @@ func (checker *Checker) checkTypeCompatibility(expression ast.Expression, valueT
 			}
 		}
 
+	case *ast.TupleExpression:
+
+		// Tuple literals are compatible with tuple target types
+		// if each element is compatible with the target element type,
+		// e.g. integer literals may have a target element type other than `Int`
+
+		tupleValueType, isTupleValue := valueType.(*TupleType)
+		tupleTargetType, isTupleTarget := targetType.(*TupleType)
+
+		if isTupleValue && isTupleTarget &&
+			len(tupleValueType.ElementTypeAnnotations) == len(tupleTargetType.ElementTypeAnnotations) {
+
+			for i, element := range typedExpression.Elements {
+				if !checker.checkTypeCompatibility(
+					element,
+					tupleValueType.ElementTypeAnnotations[i].Type,
+					tupleTargetType.ElementTypeAnnotations[i].Type,
+				) {
+					return false
+				}
+			}
+
+			return true
+		}
+
 	case *ast.StringExpression:
 		unwrappedTargetType := UnwrapOptionalType(targetType)
 
@@ -838,6 +1125,19 @@ func (checker *Checker) inSwitch() bool {
 	return checker.functionActivations.Current().InSwitch()
 }
 
+// isSelfMember returns true if `self` is declared
+// and its type has a member with the given name
+//
+func (checker *Checker) isSelfMember(name string) bool {
+	self := checker.valueActivations.Find(SelfIdentifier)
+	if self == nil || self.Type == nil {
+		return false
+	}
+
+	_, ok := self.Type.GetMembers()[name]
+	return ok
+}
+
 func (checker *Checker) findAndCheckValueVariable(identifier ast.Identifier, recordOccurrence bool) *Variable {
 	variable := checker.valueActivations.Find(identifier.Identifier)
 	if variable == nil {
@@ -846,6 +1146,7 @@ func (checker *Checker) findAndCheckValueVariable(identifier ast.Identifier, rec
 				ExpectedKind: common.DeclarationKindVariable,
 				Name:         identifier.Identifier,
 				Pos:          identifier.StartPosition(),
+				IsSelfMember: checker.isSelfMember(identifier.Identifier),
 			},
 		)
 		return nil
@@ -877,6 +1178,9 @@ func (checker *Checker) ConvertType(t ast.Type) Type {
 	case *ast.FunctionType:
 		return checker.convertFunctionType(t)
 
+	case *ast.TupleType:
+		return checker.convertTupleType(t)
+
 	case *ast.OptionalType:
 		return checker.convertOptionalType(t)
 
@@ -1168,6 +1472,18 @@ func (checker *Checker) convertFunctionType(t *ast.FunctionType) Type {
 	}
 }
 
+func (checker *Checker) convertTupleType(t *ast.TupleType) Type {
+	elementTypeAnnotations := make([]*TypeAnnotation, len(t.ElementTypeAnnotations))
+
+	for i, elementTypeAnnotation := range t.ElementTypeAnnotations {
+		elementTypeAnnotations[i] = checker.ConvertTypeAnnotation(elementTypeAnnotation)
+	}
+
+	return &TupleType{
+		ElementTypeAnnotations: elementTypeAnnotations,
+	}
+}
+
 func (checker *Checker) convertConstantSizedType(t *ast.ConstantSizedType) Type {
 	elementType := checker.ConvertType(t.Type)
 
@@ -1254,6 +1570,8 @@ func (checker *Checker) convertNominalType(t *ast.NominalType) Type {
 
 	ty := variable.Type
 
+	checker.Elaboration.recordTypeReference(ty, t.Identifier.Pos)
+
 	var resolvedIdentifiers []ast.Identifier
 
 	for _, identifier := range t.NestedIdentifiers {
@@ -1290,6 +1608,8 @@ func (checker *Checker) convertNominalType(t *ast.NominalType) Type {
 			)
 			return InvalidType
 		}
+
+		checker.Elaboration.recordTypeReference(ty, identifier.Pos)
 	}
 
 	return ty
@@ -1383,6 +1703,7 @@ func (checker *Checker) recordFieldDeclarationOrigin(
 	identifier ast.Identifier,
 	startPos, endPos ast.Position,
 	fieldType Type,
+	docString string,
 ) *Origin {
 	if !checker.originsAndOccurrencesEnabled {
 		return nil
@@ -1396,6 +1717,7 @@ func (checker *Checker) recordFieldDeclarationOrigin(
 		DeclarationKind: common.DeclarationKindField,
 		StartPos:        &startPosition,
 		EndPos:          &endPosition,
+		DocString:       docString,
 	}
 
 	checker.Occurrences.Put(
@@ -1423,6 +1745,7 @@ func (checker *Checker) recordFunctionDeclarationOrigin(
 		DeclarationKind: common.DeclarationKindFunction,
 		StartPos:        &startPosition,
 		EndPos:          &endPosition,
+		DocString:       function.DocString,
 	}
 
 	checker.Occurrences.Put(
@@ -1725,6 +2048,13 @@ func (checker *Checker) checkPotentiallyUnevaluated(check TypeCheckFunc) Type {
 		functionActivation.ReturnInfo.MaybeReturned ||
 			temporaryReturnInfo.MaybeReturned
 
+	// NOTE: jumps are conservatively considered potential,
+	// even if they only target a loop inside the unevaluated code
+
+	functionActivation.ReturnInfo.MaybeJumped =
+		functionActivation.ReturnInfo.MaybeJumped ||
+			temporaryReturnInfo.MaybeJumped
+
 	// NOTE: the definitive return state does not change
 
 	checker.resources.MergeBranches(temporaryResources, nil)
@@ -1736,10 +2066,34 @@ func (checker *Checker) ResetErrors() {
 	checker.errors = nil
 }
 
+func (checker *Checker) ResetWarnings() {
+	checker.warnings = nil
+}
+
 func (checker *Checker) ResetHints() {
 	checker.hints = nil
 }
 
+// EnterScope enters a new value and type scope, which is nested in the current scope.
+// All declarations which are checked afterwards are declared in the new scope,
+// until the scope is left again using LeaveScope.
+//
+// This allows checking code incrementally, e.g. in a REPL,
+// and discarding the declarations of invalid code.
+//
+func (checker *Checker) EnterScope() {
+	checker.enterValueScope()
+	checker.typeActivations.Enter()
+}
+
+// LeaveScope leaves the current value and type scope,
+// i.e. it discards all declarations in the scope.
+//
+func (checker *Checker) LeaveScope() {
+	checker.typeActivations.Leave()
+	checker.leaveValueScope(false)
+}
+
 const invalidTypeDeclarationAccessModifierExplanation = "type declarations must be public"
 
 func (checker *Checker) checkDeclarationAccessModifier(
@@ -1862,6 +2216,50 @@ func (checker *Checker) checkFieldsAccessModifier(fields []*ast.FieldDeclaration
 	}
 }
 
+// checkPublicDocComment reports a warning if the declaration is public,
+// but has no doc comment, and doc comments are required for public declarations.
+//
+func (checker *Checker) checkPublicDocComment(
+	access ast.Access,
+	declarationKind common.DeclarationKind,
+	identifier ast.Identifier,
+	docString string,
+) {
+	if !checker.publicDocCommentsRequired {
+		return
+	}
+
+	switch access {
+	case ast.AccessPublic, ast.AccessPublicSettable:
+		break
+	default:
+		return
+	}
+
+	if strings.TrimSpace(docString) != "" {
+		return
+	}
+
+	checker.warn(
+		&MissingDocCommentWarning{
+			DeclarationKind: declarationKind,
+			Name:            identifier.Identifier,
+			Range:           ast.NewRangeFromPositioned(identifier),
+		},
+	)
+}
+
+func (checker *Checker) checkFieldsPublicDocComments(fields []*ast.FieldDeclaration) {
+	for _, field := range fields {
+		checker.checkPublicDocComment(
+			field.Access,
+			field.DeclarationKind(),
+			field.Identifier,
+			field.DocString,
+		)
+	}
+}
+
 // checkCharacterLiteral checks that the string literal is a valid character,
 // i.e. it has exactly one grapheme cluster.
 //
@@ -2187,6 +2585,10 @@ func (checker *Checker) effectiveMemberAccess(access ast.Access, containerKind C
 }
 
 func (checker *Checker) effectiveInterfaceMemberAccess(access ast.Access) ast.Access {
+	return effectiveInterfaceMemberAccess(access)
+}
+
+func effectiveInterfaceMemberAccess(access ast.Access) ast.Access {
 	if access == ast.AccessNotSpecified {
 		return ast.AccessPublic
 	} else {
@@ -2195,11 +2597,15 @@ func (checker *Checker) effectiveInterfaceMemberAccess(access ast.Access) ast.Ac
 }
 
 func (checker *Checker) effectiveCompositeMemberAccess(access ast.Access) ast.Access {
+	return effectiveCompositeMemberAccess(access, checker.accessCheckMode)
+}
+
+func effectiveCompositeMemberAccess(access ast.Access, accessCheckMode AccessCheckMode) ast.Access {
 	if access != ast.AccessNotSpecified {
 		return access
 	}
 
-	switch checker.accessCheckMode {
+	switch accessCheckMode {
 	case AccessCheckModeStrict, AccessCheckModeNotSpecifiedRestricted:
 		return ast.AccessPrivate
 
@@ -2294,6 +2700,42 @@ func (checker *Checker) convertInstantiationType(t *ast.InstantiationType) Type
 	return parameterizedType.Instantiate(typeArguments, checker.report)
 }
 
+// Errors returns the errors reported by the checker,
+// i.e. the diagnostics with severity error.
+//
+// If warnings are treated as errors, the warnings and hints are included,
+// as WarningError and HintError respectively.
+//
+func (checker *Checker) Errors() []error {
+	if !checker.warningsAsErrors ||
+		(len(checker.warnings) == 0 && len(checker.hints) == 0) {
+
+		return checker.errors
+	}
+
+	errs := make(
+		[]error,
+		0,
+		len(checker.errors)+len(checker.warnings)+len(checker.hints),
+	)
+
+	errs = append(errs, checker.errors...)
+
+	for _, warning := range checker.warnings {
+		errs = append(errs, &WarningError{Warning: warning})
+	}
+
+	for _, hint := range checker.hints {
+		errs = append(errs, &HintError{Hint: hint})
+	}
+
+	return errs
+}
+
+func (checker *Checker) Warnings() []Warning {
+	return checker.warnings
+}
+
 func (checker *Checker) Hints() []Hint {
 	return checker.hints
 }
@@ -19,6 +19,8 @@
 package sema
 
 import (
+	"math/big"
+
 	"github.com/onflow/cadence/runtime/common"
 	"github.com/onflow/cadence/runtime/errors"
 )
@@ -38,7 +40,11 @@ var HashAlgorithms = []CryptoAlgorithm{
 	HashAlgorithmSHA3_384,
 }
 
-var SignatureAlgorithmType = newNativeEnumType(SignatureAlgorithmTypeName, &UInt8Type{})
+var SignatureAlgorithmType = newBuiltinEnumType(
+	SignatureAlgorithmTypeName,
+	&UInt8Type{},
+	cryptoAlgorithmEnumCases(SignatureAlgorithms),
+)
 
 type SignatureAlgorithm uint8
 
@@ -81,6 +87,10 @@ func (algo SignatureAlgorithm) RawValue() uint8 {
 	panic(errors.NewUnreachableError())
 }
 
+func (algo SignatureAlgorithm) EnumCaseRawValue() uint64 {
+	return uint64(algo.RawValue())
+}
+
 func (algo SignatureAlgorithm) DocString() string {
 	switch algo {
 	case SignatureAlgorithmUnknown:
@@ -94,7 +104,11 @@ func (algo SignatureAlgorithm) DocString() string {
 	panic(errors.NewUnreachableError())
 }
 
-var HashAlgorithmType = newNativeEnumType(HashAlgorithmTypeName, &UInt8Type{})
+var HashAlgorithmType = newBuiltinEnumType(
+	HashAlgorithmTypeName,
+	&UInt8Type{},
+	cryptoAlgorithmEnumCases(HashAlgorithms),
+)
 
 type HashAlgorithm uint8
 
@@ -146,6 +160,10 @@ func (algo HashAlgorithm) RawValue() uint8 {
 	panic(errors.NewUnreachableError())
 }
 
+func (algo HashAlgorithm) EnumCaseRawValue() uint64 {
+	return uint64(algo.RawValue())
+}
+
 func (algo HashAlgorithm) DocString() string {
 	switch algo {
 	case HashAlgorithmUnknown:
@@ -163,11 +181,91 @@ func (algo HashAlgorithm) DocString() string {
 	panic(errors.NewUnreachableError())
 }
 
-func newNativeEnumType(identifier string, rawType Type) *CompositeType {
-	accountKeyType := &CompositeType{
+// NewBuiltinEnumType returns a new built-in enum type,
+// which allows host environments to declare enums like `SignatureAlgorithm`.
+//
+// The raw type must be a concrete integer type, e.g. `UInt8` or `Int`,
+// and the raw values of the cases must be contiguous, starting at zero,
+// like the raw values of enums declared in programs.
+// The raw values must also be in the range of the raw type.
+//
+// The constructor of the enum can be declared with stdlib.NewBuiltinEnumValue.
+//
+func NewBuiltinEnumType(identifier string, rawType Type, enumCases []BuiltinEnumCase) (*CompositeType, error) {
+
+	if !isBuiltinEnumRawType(rawType) {
+		return nil, &InvalidBuiltinEnumRawTypeError{
+			Identifier: identifier,
+			Type:       rawType,
+		}
+	}
+
+	var maxRawValue *big.Int
+	if rangedType, ok := rawType.(IntegerRangedType); ok {
+		maxRawValue = rangedType.MaxInt()
+	}
+
+	for i, enumCase := range enumCases {
+		rawValue := enumCase.EnumCaseRawValue()
+
+		if rawValue != uint64(i) {
+			return nil, &InvalidBuiltinEnumRawValueError{
+				Identifier:       identifier,
+				CaseName:         enumCase.Name(),
+				RawValue:         rawValue,
+				ExpectedRawValue: uint64(i),
+			}
+		}
+
+		if maxRawValue != nil &&
+			new(big.Int).SetUint64(rawValue).Cmp(maxRawValue) > 0 {
+
+			return nil, &BuiltinEnumRawValueOverflowError{
+				Identifier: identifier,
+				CaseName:   enumCase.Name(),
+				RawValue:   rawValue,
+				RawType:    rawType,
+			}
+		}
+	}
+
+	return newBuiltinEnumType(identifier, rawType, enumCases), nil
+}
+
+// isBuiltinEnumRawType returns true if the given type can be the raw type of a built-in enum.
+//
+// The abstract integer types have no values of their own,
+// so the raw values of the cases could not be constructed.
+//
+func isBuiltinEnumRawType(ty Type) bool {
+	switch ty.(type) {
+	case *IntegerType, *SignedIntegerType:
+		return false
+	}
+
+	return IsSubType(ty, &IntegerType{})
+}
+
+// newBuiltinEnumType returns a new built-in enum type, without validating the cases.
+//
+// NOTE: The crypto algorithm enums are declared using this function directly,
+// as their raw values start at one: zero is reserved for the unknown algorithm,
+// which is not a case of the enum. Their raw values must not change,
+// as they are stored.
+//
+func newBuiltinEnumType(identifier string, rawType Type, enumCases []BuiltinEnumCase) *CompositeType {
+	caseNames := make([]string, len(enumCases))
+	for i, enumCase := range enumCases {
+		caseNames[i] = enumCase.Name()
+	}
+
+	enumType := &CompositeType{
 		Identifier:  identifier,
 		EnumRawType: rawType,
 		Kind:        common.CompositeKindEnum,
+		EnumCases:   caseNames,
+
+		builtinEnumCases: enumCases,
 	}
 
 	// Members of the enum type are *not* the enum cases!
@@ -181,9 +279,19 @@ func newNativeEnumType(identifier string, rawType Type) *CompositeType {
 		),
 	}
 
-	accountKeyType.Members = GetMembersAsMap(members)
-	accountKeyType.Fields = getFieldNames(members)
-	return accountKeyType
+	enumType.Members = GetMembersAsMap(members)
+	enumType.Fields = getFieldNames(members)
+	return enumType
+}
+
+// cryptoAlgorithmEnumCases returns the given crypto algorithms as enum cases
+//
+func cryptoAlgorithmEnumCases(algorithms []CryptoAlgorithm) []BuiltinEnumCase {
+	enumCases := make([]BuiltinEnumCase, len(algorithms))
+	for i, algorithm := range algorithms {
+		enumCases[i] = algorithm
+	}
+	return enumCases
 }
 
 const SignatureAlgorithmTypeName = "SignatureAlgorithm"
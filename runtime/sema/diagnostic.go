@@ -0,0 +1,132 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2020 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sema
+
+import (
+	"sort"
+
+	"github.com/onflow/cadence/runtime/ast"
+)
+
+// Diagnostic is an error, warning, or hint reported by the checker.
+//
+// SuggestedFixes are optional edits of the program's code
+// which fix the reported problem, e.g. to be offered by an IDE.
+// Each edit is a separate fix.
+//
+type Diagnostic struct {
+	Severity       Severity
+	Message        string
+	SuggestedFixes []TextEdit
+	ast.Range
+}
+
+// TextEdit is a machine-applicable edit of a program's code.
+//
+// If the insertion is not empty, it is inserted at the start of the range.
+// Otherwise the code in the (inclusive) range is replaced with the replacement.
+//
+type TextEdit struct {
+	Replacement string
+	Insertion   string
+	ast.Range
+}
+
+// ApplyTo returns the given code with the edit applied
+//
+func (edit TextEdit) ApplyTo(code string) string {
+	startOffset := edit.StartPos.Offset
+
+	if edit.Insertion != "" {
+		return code[:startOffset] + edit.Insertion + code[startOffset:]
+	}
+
+	return code[:startOffset] + edit.Replacement + code[edit.EndPos.Offset+1:]
+}
+
+// HasSuggestedFixes is implemented by errors
+// which can suggest edits that fix the error
+//
+type HasSuggestedFixes interface {
+	SuggestedFixes() []TextEdit
+}
+
+// Diagnostics returns all errors, warnings, and hints reported by the checker,
+// sorted by their start position.
+//
+// Errors which have no position, e.g. errors of imported programs,
+// have an empty range and are returned first.
+//
+func (checker *Checker) Diagnostics() []Diagnostic {
+	diagnostics := make(
+		[]Diagnostic,
+		0,
+		len(checker.errors)+len(checker.warnings)+len(checker.hints),
+	)
+
+	for _, err := range checker.errors {
+		var r ast.Range
+		if positioned, ok := err.(ast.HasPosition); ok {
+			r = ast.NewRangeFromPositioned(positioned)
+		}
+
+		var suggestedFixes []TextEdit
+		if hasSuggestedFixes, ok := err.(HasSuggestedFixes); ok {
+			suggestedFixes = hasSuggestedFixes.SuggestedFixes()
+		}
+
+		diagnostics = append(
+			diagnostics,
+			Diagnostic{
+				Severity:       SeverityError,
+				Message:        err.Error(),
+				SuggestedFixes: suggestedFixes,
+				Range:          r,
+			},
+		)
+	}
+
+	for _, warning := range checker.warnings {
+		diagnostics = append(
+			diagnostics,
+			Diagnostic{
+				Severity: SeverityWarning,
+				Message:  warning.Warning(),
+				Range:    ast.NewRangeFromPositioned(warning),
+			},
+		)
+	}
+
+	for _, hint := range checker.hints {
+		diagnostics = append(
+			diagnostics,
+			Diagnostic{
+				Severity: SeverityHint,
+				Message:  hint.Hint(),
+				Range:    ast.NewRangeFromPositioned(hint),
+			},
+		)
+	}
+
+	sort.SliceStable(diagnostics, func(i, j int) bool {
+		return diagnostics[i].StartPos.Offset < diagnostics[j].StartPos.Offset
+	})
+
+	return diagnostics
+}
@@ -0,0 +1,89 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2020 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sema
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence/runtime/ast"
+	"github.com/onflow/cadence/runtime/common"
+)
+
+type testWarning struct {
+	ast.Range
+}
+
+func (*testWarning) Warning() string {
+	return "test warning"
+}
+
+func (*testWarning) isWarning() {}
+
+func TestCheckerWarnings(t *testing.T) {
+
+	t.Parallel()
+
+	checker, err := NewChecker(
+		ast.NewProgram(nil),
+		common.StringLocation("test"),
+	)
+	require.NoError(t, err)
+
+	warningRange := ast.Range{
+		StartPos: ast.Position{Offset: 1, Line: 1, Column: 1},
+		EndPos:   ast.Position{Offset: 2, Line: 1, Column: 2},
+	}
+
+	checker.warn(&testWarning{Range: warningRange})
+
+	// Warnings do not fail the checking of the program
+
+	err = checker.Check()
+	require.NoError(t, err)
+
+	assert.Empty(t, checker.Errors())
+	assert.Len(t, checker.Warnings(), 1)
+
+	assert.Equal(t,
+		[]Diagnostic{
+			{
+				Severity: SeverityWarning,
+				Message:  "test warning",
+				Range:    warningRange,
+			},
+		},
+		checker.Diagnostics(),
+	)
+
+	checker.ResetWarnings()
+
+	assert.Empty(t, checker.Diagnostics())
+}
+
+func TestSeverityName(t *testing.T) {
+
+	t.Parallel()
+
+	assert.Equal(t, "error", SeverityError.Name())
+	assert.Equal(t, "warning", SeverityWarning.Name())
+	assert.Equal(t, "hint", SeverityHint.Name())
+}
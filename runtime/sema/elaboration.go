@@ -19,6 +19,7 @@
 package sema
 
 import (
+	"sort"
 	"sync"
 
 	"github.com/onflow/cadence/runtime/ast"
@@ -30,12 +31,19 @@ type MemberInfo struct {
 	AccessedType Type
 }
 
+type typeReference struct {
+	ty  Type
+	pos ast.Position
+}
+
 type Elaboration struct {
 	lock                                   *sync.RWMutex
 	FunctionDeclarationFunctionTypes       map[*ast.FunctionDeclaration]*FunctionType
 	VariableDeclarationValueTypes          map[*ast.VariableDeclaration]Type
 	VariableDeclarationSecondValueTypes    map[*ast.VariableDeclaration]Type
 	VariableDeclarationTargetTypes         map[*ast.VariableDeclaration]Type
+	TupleVariableDeclarationValueTypes     map[*ast.TupleVariableDeclaration]Type
+	TupleVariableDeclarationTargetTypes    map[*ast.TupleVariableDeclaration]*TupleType
 	AssignmentStatementValueTypes          map[*ast.AssignmentStatement]Type
 	AssignmentStatementTargetTypes         map[*ast.AssignmentStatement]Type
 	CompositeDeclarationTypes              map[*ast.CompositeDeclaration]*CompositeType
@@ -51,6 +59,8 @@ type Elaboration struct {
 	ReturnStatementReturnTypes             map[*ast.ReturnStatement]Type
 	BinaryExpressionResultTypes            map[*ast.BinaryExpression]Type
 	BinaryExpressionRightTypes             map[*ast.BinaryExpression]Type
+	SwitchExpressionResultTypes            map[*ast.SwitchExpression]Type
+	SwitchExpressionCaseResultTypes        map[*ast.SwitchExpression][]Type
 	MemberExpressionMemberInfos            map[*ast.MemberExpression]MemberInfo
 	ArrayExpressionArgumentTypes           map[*ast.ArrayExpression][]Type
 	ArrayExpressionElementType             map[*ast.ArrayExpression]Type
@@ -75,6 +85,9 @@ type Elaboration struct {
 	TransactionTypes                    []*TransactionType
 	EffectivePredeclaredValues          map[string]ValueDeclaration
 	EffectivePredeclaredTypes           map[string]TypeDeclaration
+	TypeReferences                      map[Type][]ast.Position
+	typeReferenceSet                    map[typeReference]struct{}
+	memberOrigins                       map[Type]map[string]*Origin
 	isChecking                          bool
 }
 
@@ -85,6 +98,8 @@ func NewElaboration() *Elaboration {
 		VariableDeclarationValueTypes:          map[*ast.VariableDeclaration]Type{},
 		VariableDeclarationSecondValueTypes:    map[*ast.VariableDeclaration]Type{},
 		VariableDeclarationTargetTypes:         map[*ast.VariableDeclaration]Type{},
+		TupleVariableDeclarationValueTypes:     map[*ast.TupleVariableDeclaration]Type{},
+		TupleVariableDeclarationTargetTypes:    map[*ast.TupleVariableDeclaration]*TupleType{},
 		AssignmentStatementValueTypes:          map[*ast.AssignmentStatement]Type{},
 		AssignmentStatementTargetTypes:         map[*ast.AssignmentStatement]Type{},
 		CompositeDeclarationTypes:              map[*ast.CompositeDeclaration]*CompositeType{},
@@ -100,6 +115,8 @@ func NewElaboration() *Elaboration {
 		ReturnStatementReturnTypes:             map[*ast.ReturnStatement]Type{},
 		BinaryExpressionResultTypes:            map[*ast.BinaryExpression]Type{},
 		BinaryExpressionRightTypes:             map[*ast.BinaryExpression]Type{},
+		SwitchExpressionResultTypes:            map[*ast.SwitchExpression]Type{},
+		SwitchExpressionCaseResultTypes:        map[*ast.SwitchExpression][]Type{},
 		MemberExpressionMemberInfos:            map[*ast.MemberExpression]MemberInfo{},
 		ArrayExpressionArgumentTypes:           map[*ast.ArrayExpression][]Type{},
 		ArrayExpressionElementType:             map[*ast.ArrayExpression]Type{},
@@ -122,6 +139,9 @@ func NewElaboration() *Elaboration {
 		GlobalTypes:                            NewStringVariableOrderedMap(),
 		EffectivePredeclaredValues:             map[string]ValueDeclaration{},
 		EffectivePredeclaredTypes:              map[string]TypeDeclaration{},
+		TypeReferences:                         map[Type][]ast.Position{},
+		typeReferenceSet:                       map[typeReference]struct{}{},
+		memberOrigins:                          map[Type]map[string]*Origin{},
 	}
 }
 
@@ -160,3 +180,76 @@ func (e *Elaboration) FunctionEntryPointType() (*FunctionType, error) {
 	functionType := invokableType.InvocationFunctionType()
 	return functionType, nil
 }
+
+// recordTypeReference records that the given type is referenced at the given position.
+// A position is only recorded once per type, even if the type annotation is converted multiple times.
+//
+func (e *Elaboration) recordTypeReference(ty Type, pos ast.Position) {
+	reference := typeReference{ty: ty, pos: pos}
+	if _, ok := e.typeReferenceSet[reference]; ok {
+		return
+	}
+	e.typeReferenceSet[reference] = struct{}{}
+
+	e.TypeReferences[ty] = append(e.TypeReferences[ty], pos)
+}
+
+// ReferencesTo returns the positions of all references to the given type,
+// ordered by their position in the source.
+//
+func (e *Elaboration) ReferencesTo(ty Type) []ast.Position {
+	positions := e.TypeReferences[ty]
+
+	result := make([]ast.Position, len(positions))
+	copy(result, positions)
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Offset < result[j].Offset
+	})
+
+	return result
+}
+
+func (e *Elaboration) setMemberOrigins(ty Type, origins map[string]*Origin) {
+	e.memberOrigins[ty] = origins
+}
+
+// MemberOrigins returns the origins of the members of the given type,
+// i.e. their declaration positions and doc strings, keyed by member name.
+//
+// Origins are only recorded if the checker has origins and occurrences enabled.
+// The returned map is a copy and may be modified by the caller.
+//
+func (e *Elaboration) MemberOrigins(ty Type) map[string]*Origin {
+	origins := e.memberOrigins[ty]
+	if origins == nil {
+		return nil
+	}
+
+	result := make(map[string]*Origin, len(origins))
+	for name, origin := range origins {
+		result[name] = origin
+	}
+	return result
+}
+
+// removeTypeReferences removes all recorded type references in the given range.
+//
+func (e *Elaboration) removeTypeReferences(r ast.Range) {
+	for ty, positions := range e.TypeReferences {
+		var remaining []ast.Position
+		for _, pos := range positions {
+			if pos.Offset >= r.StartPos.Offset && pos.Offset <= r.EndPos.Offset {
+				delete(e.typeReferenceSet, typeReference{ty: ty, pos: pos})
+				continue
+			}
+			remaining = append(remaining, pos)
+		}
+
+		if len(remaining) == 0 {
+			delete(e.TypeReferences, ty)
+		} else {
+			e.TypeReferences[ty] = remaining
+		}
+	}
+}
@@ -172,12 +172,111 @@ func (n RedeclarationNote) Message() string {
 	return "previously declared here"
 }
 
+// ForbiddenOperatorError
+
+type ForbiddenOperatorError struct {
+	Operator string
+	Pos      ast.Position
+}
+
+func (e *ForbiddenOperatorError) Error() string {
+	return fmt.Sprintf(
+		"use of the `%s` operator is not allowed",
+		e.Operator,
+	)
+}
+
+func (*ForbiddenOperatorError) isSemanticError() {}
+
+func (e *ForbiddenOperatorError) SecondaryError() string {
+	return "consider using optional binding instead, e.g. `if let value = optional { ... }`"
+}
+
+func (e *ForbiddenOperatorError) StartPosition() ast.Position {
+	return e.Pos
+}
+
+func (e *ForbiddenOperatorError) EndPosition() ast.Position {
+	length := len(e.Operator)
+	return e.Pos.Shifted(length - 1)
+}
+
+// NonExhaustiveSwitchError
+
+type NonExhaustiveSwitchError struct {
+	Type         Type
+	MissingCases []string
+	ast.Range
+}
+
+func (e *NonExhaustiveSwitchError) Error() string {
+	return fmt.Sprintf(
+		"switch over `%s` is not exhaustive",
+		e.Type.QualifiedString(),
+	)
+}
+
+func (*NonExhaustiveSwitchError) isSemanticError() {}
+
+func (e *NonExhaustiveSwitchError) SecondaryError() string {
+	return fmt.Sprintf(
+		"missing cases: %s",
+		strings.Join(e.MissingCases, ", "),
+	)
+}
+
+// MissingSwitchExpressionDefaultCaseError
+
+type MissingSwitchExpressionDefaultCaseError struct {
+	Type Type
+	ast.Range
+}
+
+func (e *MissingSwitchExpressionDefaultCaseError) Error() string {
+	return fmt.Sprintf(
+		"switch expression over `%s` is not exhaustive",
+		e.Type.QualifiedString(),
+	)
+}
+
+func (*MissingSwitchExpressionDefaultCaseError) isSemanticError() {}
+
+func (*MissingSwitchExpressionDefaultCaseError) SecondaryError() string {
+	return "add a default case"
+}
+
+// RedundantDefaultCaseError is reported as a warning by default,
+// and as an error if the checker is configured to do so
+
+type RedundantDefaultCaseError struct {
+	Type Type
+	ast.Range
+}
+
+func (e *RedundantDefaultCaseError) Error() string {
+	return fmt.Sprintf(
+		"redundant default case: switch over `%s` already covers all cases",
+		e.Type.QualifiedString(),
+	)
+}
+
+func (*RedundantDefaultCaseError) isSemanticError() {}
+
+func (e *RedundantDefaultCaseError) Warning() string {
+	return e.Error()
+}
+
+func (*RedundantDefaultCaseError) isWarning() {}
+
 // NotDeclaredError
 
 type NotDeclaredError struct {
 	ExpectedKind common.DeclarationKind
 	Name         string
 	Pos          ast.Position
+	// IsSelfMember is true if the name is a member of `self`,
+	// i.e. the `self.` prefix is likely missing
+	IsSelfMember bool
 }
 
 func (e *NotDeclaredError) Error() string {
@@ -203,6 +302,22 @@ func (e *NotDeclaredError) EndPosition() ast.Position {
 	return e.Pos.Shifted(length - 1)
 }
 
+func (e *NotDeclaredError) SuggestedFixes() []TextEdit {
+	if !e.IsSelfMember {
+		return nil
+	}
+
+	return []TextEdit{
+		{
+			Insertion: SelfIdentifier + ".",
+			Range: ast.Range{
+				StartPos: e.Pos,
+				EndPos:   e.Pos,
+			},
+		},
+	}
+}
+
 // AssignmentToConstantError
 
 type AssignmentToConstantError struct {
@@ -324,6 +439,27 @@ func (e *NotEquatableTypeError) Error() string {
 
 func (*NotEquatableTypeError) isSemanticError() {}
 
+// NotComparableTypeError is reported when values of a type must be comparable,
+// e.g. to sort them, but the type does not support the comparison operators
+//
+type NotComparableTypeError struct {
+	Type Type
+	ast.Range
+}
+
+func (e *NotComparableTypeError) Error() string {
+	return fmt.Sprintf(
+		"values of type `%s` are not comparable",
+		e.Type.QualifiedString(),
+	)
+}
+
+func (e *NotComparableTypeError) SecondaryError() string {
+	return "only numbers are comparable"
+}
+
+func (*NotComparableTypeError) isSemanticError() {}
+
 // NotCallableError
 
 type NotCallableError struct {
@@ -505,6 +641,38 @@ func (e *ControlStatementError) Error() string {
 
 func (*ControlStatementError) isSemanticError() {}
 
+// UndefinedLabelError
+
+type UndefinedLabelError struct {
+	Name string
+	ast.Range
+}
+
+func (e *UndefinedLabelError) Error() string {
+	return fmt.Sprintf(
+		"cannot find label in enclosing loops: `%s`",
+		e.Name,
+	)
+}
+
+func (*UndefinedLabelError) isSemanticError() {}
+
+// RedeclaredLabelError
+
+type RedeclaredLabelError struct {
+	Name string
+	ast.Range
+}
+
+func (e *RedeclaredLabelError) Error() string {
+	return fmt.Sprintf(
+		"cannot redeclare label: `%s` is already declared by an enclosing loop",
+		e.Name,
+	)
+}
+
+func (*RedeclaredLabelError) isSemanticError() {}
+
 // InvalidAccessModifierError
 
 type InvalidAccessModifierError struct {
@@ -734,6 +902,7 @@ type FieldUninitializedError struct {
 	Name          string
 	ContainerType Type
 	Pos           ast.Position
+	Fixes         []TextEdit
 }
 
 func (e *FieldUninitializedError) Error() string {
@@ -759,6 +928,43 @@ func (e *FieldUninitializedError) EndPosition() ast.Position {
 	return e.Pos.Shifted(length - 1)
 }
 
+func (e *FieldUninitializedError) SuggestedFixes() []TextEdit {
+	return e.Fixes
+}
+
+// MissingFieldInitializationError is reported when an initializer
+// does not initialize multiple fields. It lists all uninitialized fields at once.
+//
+type MissingFieldInitializationError struct {
+	Names         []string
+	ContainerType Type
+	Fixes         []TextEdit
+	ast.Range
+}
+
+func (e *MissingFieldInitializationError) Error() string {
+	quotedNames := make([]string, len(e.Names))
+	for i, name := range e.Names {
+		quotedNames[i] = fmt.Sprintf("`%s`", name)
+	}
+
+	return fmt.Sprintf(
+		"missing initialization of fields %s in type `%s`",
+		strings.Join(quotedNames, ", "),
+		e.ContainerType.QualifiedString(),
+	)
+}
+
+func (e *MissingFieldInitializationError) SecondaryError() string {
+	return fmt.Sprintf("%d fields not initialized", len(e.Names))
+}
+
+func (*MissingFieldInitializationError) isSemanticError() {}
+
+func (e *MissingFieldInitializationError) SuggestedFixes() []TextEdit {
+	return e.Fixes
+}
+
 // FieldTypeNotStorableError is an error that is reported for
 // fields of composite types that are not storable.
 //
@@ -824,6 +1030,44 @@ func (e *InvalidReturnValueError) Error() string {
 
 func (*InvalidReturnValueError) isSemanticError() {}
 
+// MissingReturnTypeAnnotationError is reported when explicit return types are required,
+// and a value is returned from a function without a return type annotation.
+//
+type MissingReturnTypeAnnotationError struct {
+	ValueType Type
+	// AnnotationPos is the position after which the annotation is missing,
+	// i.e. the end of the function's parameter list
+	AnnotationPos ast.Position
+	ast.Range
+}
+
+func (e *MissingReturnTypeAnnotationError) Error() string {
+	return fmt.Sprintf(
+		"missing return type annotation: function returns value of type `%s`",
+		e.ValueType.QualifiedString(),
+	)
+}
+
+func (e *MissingReturnTypeAnnotationError) SecondaryError() string {
+	return "explicit return types are required"
+}
+
+func (*MissingReturnTypeAnnotationError) isSemanticError() {}
+
+func (e *MissingReturnTypeAnnotationError) SuggestedFixes() []TextEdit {
+	pos := e.AnnotationPos.Shifted(1)
+
+	return []TextEdit{
+		{
+			Insertion: fmt.Sprintf(": %s", e.ValueType.QualifiedString()),
+			Range: ast.Range{
+				StartPos: pos,
+				EndPos:   pos,
+			},
+		},
+	}
+}
+
 // MissingReturnValueError
 
 type MissingReturnValueError struct {
@@ -941,6 +1185,9 @@ func (*InvalidEnumConformancesError) isSemanticError() {}
 
 // TODO: report each missing member and mismatch as note
 
+// MemberMismatch is a member of an interface which is not satisfied by a composite.
+// The composite member is nil if the composite does not have the member.
+//
 type MemberMismatch struct {
 	CompositeMember *Member
 	InterfaceMember *Member
@@ -1840,9 +2087,24 @@ type MissingDestructorError struct {
 	ContainerType  Type
 	FirstFieldName string
 	FirstFieldPos  ast.Position
+	// FieldNames are the names of all resource fields, in declaration order
+	FieldNames []string
 }
 
 func (e *MissingDestructorError) Error() string {
+	if len(e.FieldNames) > 1 {
+		quotedFieldNames := make([]string, len(e.FieldNames))
+		for i, fieldName := range e.FieldNames {
+			quotedFieldNames[i] = fmt.Sprintf("`%s`", fieldName)
+		}
+
+		return fmt.Sprintf(
+			"missing destructor for resource fields %s in type `%s`",
+			strings.Join(quotedFieldNames, ", "),
+			e.ContainerType.QualifiedString(),
+		)
+	}
+
 	return fmt.Sprintf(
 		"missing destructor for resource field `%s` in type `%s`",
 		e.FirstFieldName,
@@ -1850,6 +2112,10 @@ func (e *MissingDestructorError) Error() string {
 	)
 }
 
+func (e *MissingDestructorError) SecondaryError() string {
+	return "the resource fields must be destroyed or moved in a destructor, otherwise they are lost"
+}
+
 func (*MissingDestructorError) isSemanticError() {}
 
 func (e *MissingDestructorError) StartPosition() ast.Position {
@@ -2021,6 +2287,22 @@ func (e *InvalidResourceOptionalMemberError) Error() string {
 
 func (*InvalidResourceDictionaryMemberError) isSemanticError() {}
 
+// InvalidResourceDictionaryMergeOverwriteError
+
+type InvalidResourceDictionaryMergeOverwriteError struct {
+	ast.Range
+}
+
+func (e *InvalidResourceDictionaryMergeOverwriteError) Error() string {
+	return "cannot overwrite values when merging resource dictionaries"
+}
+
+func (*InvalidResourceDictionaryMergeOverwriteError) isSemanticError() {}
+
+func (e *InvalidResourceDictionaryMergeOverwriteError) SecondaryError() string {
+	return "overwriting would result in the loss of a resource; `overwrite` must be `false`"
+}
+
 // NonReferenceTypeReferenceError
 
 type NonReferenceTypeReferenceError struct {
@@ -2597,6 +2879,28 @@ func (e *ConstantSizedArrayLiteralSizeError) SecondaryError() string {
 
 func (*ConstantSizedArrayLiteralSizeError) isSemanticError() {}
 
+// TupleVariableDeclarationCountError
+
+type TupleVariableDeclarationCountError struct {
+	ActualCount   int
+	ExpectedCount int
+	ast.Range
+}
+
+func (e *TupleVariableDeclarationCountError) Error() string {
+	return "incorrect number of variables in tuple variable declaration"
+}
+
+func (e *TupleVariableDeclarationCountError) SecondaryError() string {
+	return fmt.Sprintf(
+		"expected %d, got %d",
+		e.ExpectedCount,
+		e.ActualCount,
+	)
+}
+
+func (*TupleVariableDeclarationCountError) isSemanticError() {}
+
 // InvalidRestrictedTypeError
 
 type InvalidRestrictedTypeError struct {
@@ -2921,6 +3225,31 @@ func (e *TypeAnnotationRequiredError) EndPosition() ast.Position {
 	return e.Pos
 }
 
+// RecursiveCompositeTypeError is reported when a composite type contains itself,
+// i.e. a field has a type which (transitively) contains the composite type without indirection.
+//
+type RecursiveCompositeTypeError struct {
+	Type *CompositeType
+	// Path is the list of fields which form the cycle, e.g. `S.t`, `T.s`
+	Path []string
+	ast.Range
+}
+
+func (e *RecursiveCompositeTypeError) Error() string {
+	return fmt.Sprintf(
+		"recursive composite type `%s`: %s -> %s",
+		e.Type.QualifiedString(),
+		strings.Join(e.Path, " -> "),
+		e.Type.QualifiedString(),
+	)
+}
+
+func (e *RecursiveCompositeTypeError) SecondaryError() string {
+	return "values of the type would have an infinite size; consider using an optional or a reference"
+}
+
+func (*RecursiveCompositeTypeError) isSemanticError() {}
+
 // CyclicImportsError
 
 type CyclicImportsError struct {
@@ -2988,3 +3317,124 @@ func (e *InvalidEntryPointTypeError) Error() string {
 		e.Type.QualifiedString(),
 	)
 }
+
+// LeakedAuthAccountError is reported when public auth accounts are disallowed,
+// and the signature of a public function contains `AuthAccount`.
+//
+type LeakedAuthAccountError struct {
+	Type Type
+	ast.Range
+}
+
+func (e *LeakedAuthAccountError) Error() string {
+	return fmt.Sprintf(
+		"cannot expose `%s` in the signature of a public function: `%s`",
+		AuthAccountType.QualifiedString(),
+		e.Type.QualifiedString(),
+	)
+}
+
+func (e *LeakedAuthAccountError) SecondaryError() string {
+	return "restrict the access of the function, or pass a capability instead"
+}
+
+func (*LeakedAuthAccountError) isSemanticError() {}
+
+// TooManyFieldsError
+
+type TooManyFieldsError struct {
+	ContainerDeclarationKind common.DeclarationKind
+	FieldCount               int
+	MaxFieldCount            int
+	ast.Range
+}
+
+func (e *TooManyFieldsError) Error() string {
+	return fmt.Sprintf(
+		"%s declaration has too many fields: expected at most %d, got %d",
+		e.ContainerDeclarationKind.Name(),
+		e.MaxFieldCount,
+		e.FieldCount,
+	)
+}
+
+func (*TooManyFieldsError) isSemanticError() {}
+
+// WarningError is a warning which is reported as an error,
+// because warnings are treated as errors
+
+type WarningError struct {
+	Warning
+}
+
+func (e *WarningError) Error() string {
+	return e.Warning.Warning()
+}
+
+func (*WarningError) isSemanticError() {}
+
+// HintError is a hint which is reported as an error,
+// because warnings are treated as errors
+
+type HintError struct {
+	Hint
+}
+
+func (e *HintError) Error() string {
+	return e.Hint.Hint()
+}
+
+func (*HintError) isSemanticError() {}
+
+// InvalidBuiltinEnumRawTypeError
+
+type InvalidBuiltinEnumRawTypeError struct {
+	Identifier string
+	Type       Type
+}
+
+func (e *InvalidBuiltinEnumRawTypeError) Error() string {
+	return fmt.Sprintf(
+		"invalid raw type for enum `%s`: expected concrete integer type, got `%s`",
+		e.Identifier,
+		e.Type.QualifiedString(),
+	)
+}
+
+// InvalidBuiltinEnumRawValueError
+
+type InvalidBuiltinEnumRawValueError struct {
+	Identifier       string
+	CaseName         string
+	RawValue         uint64
+	ExpectedRawValue uint64
+}
+
+func (e *InvalidBuiltinEnumRawValueError) Error() string {
+	return fmt.Sprintf(
+		"invalid raw value for case `%s` of enum `%s`: expected %d, got %d",
+		e.CaseName,
+		e.Identifier,
+		e.ExpectedRawValue,
+		e.RawValue,
+	)
+}
+
+// BuiltinEnumRawValueOverflowError
+
+type BuiltinEnumRawValueOverflowError struct {
+	Identifier string
+	CaseName   string
+	RawValue   uint64
+	RawType    Type
+}
+
+func (e *BuiltinEnumRawValueOverflowError) Error() string {
+	return fmt.Sprintf(
+		"invalid raw value for case `%s` of enum `%s`: %d is out of the range of `%s`",
+		e.CaseName,
+		e.Identifier,
+		e.RawValue,
+		e.RawType.QualifiedString(),
+	)
+}
@@ -18,14 +18,26 @@
 
 package sema
 
+import (
+	"github.com/onflow/cadence/runtime/ast"
+)
+
 type FunctionActivation struct {
 	ReturnType           Type
 	Loops                int
+	LoopLabels           []string
 	Switches             int
 	ValueActivationDepth int
 	ReturnInfo           *ReturnInfo
 	ReportedDeadCode     bool
 	InitializationInfo   *InitializationInfo
+	// MissingReturnTypeAnnotationPos is the position after which
+	// the return type annotation of the function is missing,
+	// or nil if the function has a return type annotation
+	MissingReturnTypeAnnotationPos *ast.Position
+	// BrokenLoopLabels are the labels of the loops
+	// which are exited by a labeled `break` statement
+	BrokenLoopLabels map[string]bool
 }
 
 func (a FunctionActivation) InLoop() bool {
@@ -36,6 +48,24 @@ func (a FunctionActivation) InSwitch() bool {
 	return a.Switches > 0
 }
 
+// HasLoopLabel returns true if the given label is declared by an enclosing loop
+//
+func (a FunctionActivation) HasLoopLabel(label string) bool {
+	for _, loopLabel := range a.LoopLabels {
+		if loopLabel == label {
+			return true
+		}
+	}
+	return false
+}
+
+func (a *FunctionActivation) recordBrokenLoopLabel(label string) {
+	if a.BrokenLoopLabels == nil {
+		a.BrokenLoopLabels = map[string]bool{}
+	}
+	a.BrokenLoopLabels[label] = true
+}
+
 type FunctionActivations struct {
 	activations []*FunctionActivation
 }
@@ -88,6 +118,18 @@ func (a *FunctionActivations) WithLoop(f func()) {
 	f()
 }
 
+// WithLabeledLoop is like WithLoop, but also declares the given label
+// for the duration of the loop.
+//
+func (a *FunctionActivations) WithLabeledLoop(label string, f func()) {
+	current := a.Current()
+	current.LoopLabels = append(current.LoopLabels, label)
+	defer func() {
+		current.LoopLabels = current.LoopLabels[:len(current.LoopLabels)-1]
+	}()
+	a.WithLoop(f)
+}
+
 func (a *FunctionActivations) WithSwitch(f func()) {
 	a.Current().Switches++
 	defer func() {
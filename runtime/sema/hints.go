@@ -100,3 +100,35 @@ func (h *AlwaysSucceedingForceCastHint) Hint() string {
 }
 
 func (*AlwaysSucceedingForceCastHint) isHint() {}
+
+// InitializerParameterWithoutFieldHint
+
+type InitializerParameterWithoutFieldHint struct {
+	ParameterName string
+	ast.Range
+}
+
+func (h *InitializerParameterWithoutFieldHint) Hint() string {
+	return fmt.Sprintf(
+		"initializer parameter `%s` does not correspond to any field of the interface",
+		h.ParameterName,
+	)
+}
+
+func (*InitializerParameterWithoutFieldHint) isHint() {}
+
+// DeepTernaryHint
+
+type DeepTernaryHint struct {
+	Depth int
+	ast.Range
+}
+
+func (h *DeepTernaryHint) Hint() string {
+	return fmt.Sprintf(
+		"%d chained conditional expressions are hard to read, consider using a switch expression",
+		h.Depth,
+	)
+}
+
+func (*DeepTernaryHint) isHint() {}
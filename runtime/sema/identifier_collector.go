@@ -0,0 +1,68 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2020 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sema
+
+import (
+	"github.com/onflow/cadence/runtime/ast"
+)
+
+// IdentifierCollector collects the names of all identifiers referenced in expressions
+//
+type IdentifierCollector struct {
+	ExpressionExtractor *ast.ExpressionExtractor
+	Identifiers         map[string]struct{}
+}
+
+func NewIdentifierCollector() *IdentifierCollector {
+	identifierCollector := &IdentifierCollector{
+		Identifiers: map[string]struct{}{},
+	}
+	expressionExtractor := &ast.ExpressionExtractor{
+		IdentifierExtractor: identifierCollector,
+		FunctionExtractor:   identifierCollector,
+	}
+	identifierCollector.ExpressionExtractor = expressionExtractor
+	return identifierCollector
+}
+
+func (c *IdentifierCollector) Collect(expression ast.Expression) {
+	c.ExpressionExtractor.Extract(expression)
+}
+
+func (c *IdentifierCollector) ExtractIdentifier(
+	extractor *ast.ExpressionExtractor,
+	expression *ast.IdentifierExpression,
+) ast.ExpressionExtraction {
+
+	c.Identifiers[expression.Identifier.Identifier] = struct{}{}
+
+	return extractor.ExtractIdentifier(expression)
+}
+
+func (c *IdentifierCollector) ExtractFunction(
+	_ *ast.ExpressionExtractor,
+	expression *ast.FunctionExpression,
+) ast.ExpressionExtraction {
+
+	// NOTE: function expressions are not supported by the expression extractor, so return as-is
+
+	return ast.ExpressionExtraction{
+		RewrittenExpression: expression,
+	}
+}
@@ -38,7 +38,30 @@ var MetaType = &SimpleType{
 	Storable:             true,
 	Equatable:            true,
 	ExternallyReturnable: true,
-	Members: func(t *SimpleType) map[string]MemberResolver {
+}
+
+const MetaTypeIsSubtypeFunctionName = "isSubtype"
+
+var metaTypeIsSubtypeFunctionType = &FunctionType{
+	Parameters: []*Parameter{
+		{
+			Identifier: "of",
+			TypeAnnotation: NewTypeAnnotation(
+				MetaType,
+			),
+		},
+	},
+	ReturnTypeAnnotation: NewTypeAnnotation(
+		BoolType,
+	),
+}
+
+const metaTypeIsSubtypeFunctionDocString = `
+Returns true if this type is a subtype of the given type at runtime
+`
+
+func init() {
+	MetaType.Members = func(t *SimpleType) map[string]MemberResolver {
 		return map[string]MemberResolver{
 			"identifier": {
 				Kind: common.DeclarationKindField,
@@ -51,6 +74,17 @@ var MetaType = &SimpleType{
 					)
 				},
 			},
+			MetaTypeIsSubtypeFunctionName: {
+				Kind: common.DeclarationKindFunction,
+				Resolve: func(identifier string, _ ast.Range, _ func(error)) *Member {
+					return NewPublicFunctionMember(
+						t,
+						identifier,
+						metaTypeIsSubtypeFunctionType,
+						metaTypeIsSubtypeFunctionDocString,
+					)
+				},
+			},
 		}
-	},
+	}
 }
@@ -66,6 +66,7 @@ type Origin struct {
 	DeclarationKind common.DeclarationKind
 	StartPos        *ast.Position
 	EndPos          *ast.Position
+	DocString       string
 }
 
 type Occurrences struct {
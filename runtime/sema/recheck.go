@@ -0,0 +1,258 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2020 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sema
+
+import (
+	"github.com/onflow/cadence/runtime/ast"
+)
+
+// RecheckDeclaration replaces the top-level declaration of the checked program
+// which has the same identifier as the given declaration, and re-checks the program.
+// If the program has no such declaration, the given declaration is added to the program.
+//
+// If only the body of a global function changed, i.e. its type, argument labels,
+// and access modifier are unchanged, no other declaration can depend on the change.
+// In this case only the function is re-checked, and the elaboration
+// of all other declarations is reused.
+//
+// In all other cases, e.g. when a type declaration changed, which potentially
+// invalidates all declarations referring to it, the whole program is re-checked.
+//
+func (checker *Checker) RecheckDeclaration(declaration ast.Declaration) error {
+
+	declarations := checker.Program.Declarations()
+
+	index := -1
+
+	identifier := declaration.DeclarationIdentifier()
+	if identifier != nil {
+		for i, existingDeclaration := range declarations {
+			existingIdentifier := existingDeclaration.DeclarationIdentifier()
+			if existingIdentifier != nil &&
+				existingIdentifier.Identifier == identifier.Identifier {
+
+				index = i
+				break
+			}
+		}
+	}
+
+	newDeclarations := make([]ast.Declaration, len(declarations), len(declarations)+1)
+	copy(newDeclarations, declarations)
+
+	if index < 0 {
+		newDeclarations = append(newDeclarations, declaration)
+	} else {
+		newDeclarations[index] = declaration
+	}
+
+	newProgram := ast.NewProgram(newDeclarations)
+
+	if index >= 0 && checker.canRecheckFunctionBody(declarations, index, declaration) {
+		oldDeclaration := declarations[index].(*ast.FunctionDeclaration)
+		newDeclaration := declaration.(*ast.FunctionDeclaration)
+
+		checker.Program = newProgram
+		checker.recheckFunctionBody(oldDeclaration, newDeclaration)
+	} else {
+		checker.Program = newProgram
+		checker.resetState()
+	}
+
+	return checker.Check()
+}
+
+// canRecheckFunctionBody returns true if the declaration at the given index
+// can be replaced with the given declaration by only re-checking the function body.
+//
+func (checker *Checker) canRecheckFunctionBody(
+	declarations []ast.Declaration,
+	index int,
+	declaration ast.Declaration,
+) bool {
+
+	if !checker.isChecked ||
+		// Origins and occurrences are recorded for the whole program
+		checker.originsAndOccurrencesEnabled {
+
+		return false
+	}
+
+	oldDeclaration, ok := declarations[index].(*ast.FunctionDeclaration)
+	if !ok {
+		return false
+	}
+
+	newDeclaration, ok := declaration.(*ast.FunctionDeclaration)
+	if !ok {
+		return false
+	}
+
+	if oldDeclaration.Access != newDeclaration.Access {
+		return false
+	}
+
+	// Global variables are declared in order, so the function body
+	// can only refer to the global variables declared before it.
+	// Global variables declared after it are already declared,
+	// so only re-check the function body if there are none
+
+	for _, otherDeclaration := range declarations[index+1:] {
+		if _, ok := otherDeclaration.(*ast.VariableDeclaration); ok {
+			return false
+		}
+	}
+
+	// All errors and hints of the function must be removable,
+	// i.e. they must have a position
+
+	for _, err := range checker.errors {
+		if _, ok := err.(ast.HasPosition); !ok {
+			return false
+		}
+	}
+
+	// The function's type and argument labels must be unchanged
+
+	oldFunctionType := checker.Elaboration.FunctionDeclarationFunctionTypes[oldDeclaration]
+	if oldFunctionType == nil {
+		return false
+	}
+
+	oldArgumentLabels := oldDeclaration.ParameterList.EffectiveArgumentLabels()
+	newArgumentLabels := newDeclaration.ParameterList.EffectiveArgumentLabels()
+	if len(oldArgumentLabels) != len(newArgumentLabels) {
+		return false
+	}
+	for i, oldArgumentLabel := range oldArgumentLabels {
+		if newArgumentLabels[i] != oldArgumentLabel {
+			return false
+		}
+	}
+
+	// NOTE: the errors of the type conversion are not reported,
+	// the whole program is re-checked if the type is invalid
+
+	errors := checker.errors
+	newFunctionType := checker.functionType(newDeclaration.ParameterList, newDeclaration.ReturnTypeAnnotation)
+	checker.errors = errors
+
+	return !newFunctionType.IsInvalidType() &&
+		newFunctionType.Equal(oldFunctionType)
+}
+
+// recheckFunctionBody removes the errors, warnings, hints, and type references of the old function declaration,
+// and checks the new function declaration, reusing the previously declared function type.
+//
+func (checker *Checker) recheckFunctionBody(oldDeclaration, newDeclaration *ast.FunctionDeclaration) {
+
+	oldRange := ast.NewRangeFromPositioned(oldDeclaration)
+
+	var errors []error
+	for _, err := range checker.errors {
+		if !rangeContains(oldRange, err.(ast.HasPosition)) {
+			errors = append(errors, err)
+		}
+	}
+	checker.errors = errors
+
+	var hints []Hint
+	for _, hint := range checker.hints {
+		if !rangeContains(oldRange, hint) {
+			hints = append(hints, hint)
+		}
+	}
+	checker.hints = hints
+
+	var warnings []Warning
+	for _, warning := range checker.warnings {
+		if !rangeContains(oldRange, warning) {
+			warnings = append(warnings, warning)
+		}
+	}
+	checker.warnings = warnings
+
+	checker.Elaboration.removeTypeReferences(oldRange)
+
+	functionType := checker.Elaboration.FunctionDeclarationFunctionTypes[oldDeclaration]
+	delete(checker.Elaboration.FunctionDeclarationFunctionTypes, oldDeclaration)
+	checker.Elaboration.FunctionDeclarationFunctionTypes[newDeclaration] = functionType
+
+	// Convert the function's type again to record the type references
+
+	checker.functionType(newDeclaration.ParameterList, newDeclaration.ReturnTypeAnnotation)
+
+	name := newDeclaration.Identifier.Identifier
+
+	if variable := checker.valueActivations.Find(name); variable != nil {
+		pos := newDeclaration.Identifier.Pos
+		variable.Pos = &pos
+	}
+
+	checker.Elaboration.setIsChecking(true)
+
+	check := func() {
+		newDeclaration.Accept(checker)
+	}
+	if checker.checkHandler != nil {
+		checker.checkHandler(checker.Location, check)
+	} else {
+		check()
+	}
+
+	checker.Elaboration.setIsChecking(false)
+}
+
+// resetState resets the checker to the state before checking,
+// so the program can be checked again.
+//
+func (checker *Checker) resetState() {
+	checker.errors = nil
+	checker.warnings = nil
+	checker.hints = nil
+
+	checker.valueActivations = NewVariableActivations(BaseValueActivation)
+	checker.typeActivations = NewVariableActivations(BaseTypeActivation)
+	checker.functionActivations = &FunctionActivations{}
+	checker.functionActivations.EnterFunction(&FunctionType{
+		ReturnTypeAnnotation: NewTypeAnnotation(VoidType)},
+		0,
+	)
+	checker.resources = NewResources()
+	checker.containerTypes = map[Type]bool{}
+	checker.Elaboration = NewElaboration()
+	checker.isChecked = false
+
+	if checker.originsAndOccurrencesEnabled {
+		checker.variableOrigins = map[*Variable]*Origin{}
+		checker.Occurrences = NewOccurrences()
+		checker.MemberAccesses = NewMemberAccesses()
+	}
+
+	// NOTE: the options cannot fail
+
+	_ = WithPredeclaredValues(checker.PredeclaredValues)(checker)
+	_ = WithPredeclaredTypes(checker.PredeclaredTypes)(checker)
+}
+
+func rangeContains(r ast.Range, positioned ast.HasPosition) bool {
+	startOffset := positioned.StartPosition().Offset
+	return startOffset >= r.StartPos.Offset &&
+		startOffset <= r.EndPos.Offset
+}
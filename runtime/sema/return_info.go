@@ -22,6 +22,21 @@ type ReturnInfo struct {
 	MaybeReturned      bool
 	DefinitelyReturned bool
 	DefinitelyHalted   bool
+	// DefinitelyJumped is true if a `break` or `continue` statement was definitely executed,
+	// or if all branches definitely exited in some way
+	DefinitelyJumped bool
+	// MaybeBroke is true if a `break` statement was potentially executed
+	MaybeBroke bool
+	// MaybeJumped is true if a `break` or `continue` statement was potentially executed
+	MaybeJumped bool
+}
+
+// DefinitelyExited returns true if the following statements are unreachable
+//
+func (ri *ReturnInfo) DefinitelyExited() bool {
+	return ri.DefinitelyReturned ||
+		ri.DefinitelyHalted ||
+		ri.DefinitelyJumped
 }
 
 func (ri *ReturnInfo) MergeBranches(thenReturnInfo *ReturnInfo, elseReturnInfo *ReturnInfo) {
@@ -36,6 +51,18 @@ func (ri *ReturnInfo) MergeBranches(thenReturnInfo *ReturnInfo, elseReturnInfo *
 	ri.DefinitelyHalted = ri.DefinitelyHalted ||
 		(thenReturnInfo.DefinitelyHalted &&
 			elseReturnInfo.DefinitelyHalted)
+
+	ri.DefinitelyJumped = ri.DefinitelyJumped ||
+		(thenReturnInfo.DefinitelyExited() &&
+			elseReturnInfo.DefinitelyExited())
+
+	ri.MaybeBroke = ri.MaybeBroke ||
+		thenReturnInfo.MaybeBroke ||
+		elseReturnInfo.MaybeBroke
+
+	ri.MaybeJumped = ri.MaybeJumped ||
+		thenReturnInfo.MaybeJumped ||
+		elseReturnInfo.MaybeJumped
 }
 
 func (ri *ReturnInfo) Clone() *ReturnInfo {
@@ -21,6 +21,7 @@ package sema
 import (
 	"github.com/onflow/cadence/runtime/ast"
 	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/errors"
 )
 
 // StringType represents the string type
@@ -80,6 +81,85 @@ func init() {
 					)
 				},
 			},
+			"toLower": {
+				Kind: common.DeclarationKindFunction,
+				Resolve: func(identifier string, _ ast.Range, _ func(error)) *Member {
+					return NewPublicFunctionMember(
+						t,
+						identifier,
+						stringTypeToLowerFunctionType,
+						stringTypeToLowerFunctionDocString,
+					)
+				},
+			},
+			"toUpper": {
+				Kind: common.DeclarationKindFunction,
+				Resolve: func(identifier string, _ ast.Range, _ func(error)) *Member {
+					return NewPublicFunctionMember(
+						t,
+						identifier,
+						stringTypeToUpperFunctionType,
+						stringTypeToUpperFunctionDocString,
+					)
+				},
+			},
+			"trim": {
+				Kind: common.DeclarationKindFunction,
+				Resolve: func(identifier string, _ ast.Range, _ func(error)) *Member {
+					return NewPublicFunctionMember(
+						t,
+						identifier,
+						stringTypeTrimFunctionType,
+						stringTypeTrimFunctionDocString,
+					)
+				},
+			},
+			"contains": {
+				Kind: common.DeclarationKindFunction,
+				Resolve: func(identifier string, _ ast.Range, _ func(error)) *Member {
+					return NewPublicFunctionMember(
+						t,
+						identifier,
+						stringTypeContainsFunctionType,
+						stringTypeContainsFunctionDocString,
+					)
+				},
+			},
+			"startsWith": {
+				Kind: common.DeclarationKindFunction,
+				Resolve: func(identifier string, _ ast.Range, _ func(error)) *Member {
+					return NewPublicFunctionMember(
+						t,
+						identifier,
+						stringTypeStartsWithFunctionType,
+						stringTypeStartsWithFunctionDocString,
+					)
+				},
+			},
+			"endsWith": {
+				Kind: common.DeclarationKindFunction,
+				Resolve: func(identifier string, _ ast.Range, _ func(error)) *Member {
+					return NewPublicFunctionMember(
+						t,
+						identifier,
+						stringTypeEndsWithFunctionType,
+						stringTypeEndsWithFunctionDocString,
+					)
+				},
+			},
+			"utf8": {
+				Kind: common.DeclarationKindField,
+				Resolve: func(identifier string, _ ast.Range, _ func(error)) *Member {
+					return NewPublicConstantFieldMember(
+						t,
+						identifier,
+						&VariableSizedType{
+							Type: &UInt8Type{},
+						},
+						stringTypeUTF8FieldDocString,
+					)
+				},
+			},
 			"length": {
 				Kind: common.DeclarationKindField,
 				Resolve: func(identifier string, _ ast.Range, _ func(error)) *Member {
@@ -151,6 +231,293 @@ The given string must only contain hexadecimal characters and must have an even
 If the string is malformed, the program aborts
 `
 
+var stringTypeToLowerFunctionType = &FunctionType{
+	ReturnTypeAnnotation: NewTypeAnnotation(
+		StringType,
+	),
+}
+
+const stringTypeToLowerFunctionDocString = `
+Returns a new string with all characters converted to lowercase, but does not modify the original string.
+
+The full Unicode case mapping is used, independent of any locale, e.g. ` + "`\"İ\"`" + ` is converted to ` + "`\"i̇\"`" + `
+`
+
+var stringTypeToUpperFunctionType = &FunctionType{
+	ReturnTypeAnnotation: NewTypeAnnotation(
+		StringType,
+	),
+}
+
+const stringTypeToUpperFunctionDocString = `
+Returns a new string with all characters converted to uppercase, but does not modify the original string.
+
+The full Unicode case mapping is used, independent of any locale, e.g. ` + "`\"ß\"`" + ` is converted to ` + "`\"SS\"`" + `
+`
+
+// stringTypeTrimFunctionType is the type of the function `trim`,
+// which has two overloads: `trim()` and `trim(_ cutset: String)`.
+//
+// Overloading is not supported, so the cutset parameter is optional,
+// and invocations with more arguments are rejected.
+//
+var stringTypeTrimFunctionType = &CheckedFunctionType{
+	FunctionType: &FunctionType{
+		Parameters: []*Parameter{
+			{
+				Label:          ArgumentLabelNotRequired,
+				Identifier:     "cutset",
+				TypeAnnotation: NewTypeAnnotation(StringType),
+			},
+		},
+		ReturnTypeAnnotation: NewTypeAnnotation(
+			StringType,
+		),
+		RequiredArgumentCount: RequiredArgumentCount(0),
+	},
+	ArgumentExpressionsCheck: maximumArgumentCountCheck(1),
+}
+
+// maximumArgumentCountCheck returns an argument expressions check
+// which reports an error if more than the given number of arguments are passed.
+//
+// It is used for functions with optional parameters, i.e. functions
+// which have a required argument count, which allows additional arguments.
+//
+func maximumArgumentCountCheck(parameterCount int) ArgumentExpressionsCheck {
+	return func(checker *Checker, argumentExpressions []ast.Expression, invocationRange ast.Range) {
+		argumentCount := len(argumentExpressions)
+		if argumentCount <= parameterCount {
+			return
+		}
+
+		checker.report(
+			&ArgumentCountError{
+				ParameterCount: parameterCount,
+				ArgumentCount:  argumentCount,
+				Range:          invocationRange,
+			},
+		)
+	}
+}
+
+const stringTypeTrimFunctionDocString = `
+Returns a new string with all leading and trailing whitespace removed, but does not modify the original string.
+
+If a cutset is given, all leading and trailing characters contained in the cutset are removed instead of whitespace
+`
+
+// stringTypePredicateFunctionType returns the type of a function
+// which has a single string parameter and returns a boolean
+//
+func stringTypePredicateFunctionType(parameterIdentifier string) *FunctionType {
+	return &FunctionType{
+		Parameters: []*Parameter{
+			{
+				Label:          ArgumentLabelNotRequired,
+				Identifier:     parameterIdentifier,
+				TypeAnnotation: NewTypeAnnotation(StringType),
+			},
+		},
+		ReturnTypeAnnotation: NewTypeAnnotation(
+			BoolType,
+		),
+	}
+}
+
+var stringTypeContainsFunctionType = stringTypePredicateFunctionType("other")
+
+const stringTypeContainsFunctionDocString = `
+Returns true if the string contains the given string, i.e. if it is a substring of the string.
+
+Every string contains the empty string
+`
+
+var stringTypeStartsWithFunctionType = stringTypePredicateFunctionType("prefix")
+
+const stringTypeStartsWithFunctionDocString = `
+Returns true if the string begins with the given prefix.
+
+Every string starts with the empty string
+`
+
+var stringTypeEndsWithFunctionType = stringTypePredicateFunctionType("suffix")
+
+const stringTypeEndsWithFunctionDocString = `
+Returns true if the string ends with the given suffix.
+
+Every string ends with the empty string
+`
+
 const stringTypeLengthFieldDocString = `
-The number of characters in the string
+The number of characters in the string.
+
+Characters are grapheme clusters, so the length is not the number of Unicode code points or bytes of the string
+`
+
+const stringTypeUTF8FieldDocString = `
+The byte array of the UTF-8 encoding of the string
+`
+
+// String function
+
+const StringFunctionEncodeBase64FunctionName = "encodeBase64"
+const StringFunctionDecodeBase64FunctionName = "decodeBase64"
+const StringFunctionFromUTF8FunctionName = "fromUTF8"
+
+const stringFunctionDocString = `
+Returns an empty string
 `
+
+const stringFunctionEncodeBase64FunctionDocString = `
+Returns the Base64 representation of the given bytes.
+
+If ` + "`urlSafe`" + ` is true, the URL-safe alphabet is used instead of the standard alphabet
+`
+
+const stringFunctionDecodeBase64FunctionDocString = `
+Returns the bytes represented by the given padded Base64 string, or nil if the string is malformed.
+
+If ` + "`urlSafe`" + ` is true, the URL-safe alphabet is used instead of the standard alphabet
+`
+
+const stringFunctionFromUTF8FunctionDocString = `
+Returns the string with the given UTF-8 encoding, or nil if the bytes are not valid UTF-8.
+
+Overlong encodings and encoded surrogate halves are invalid
+`
+
+var stringFunctionFromUTF8FunctionType = &FunctionType{
+	Parameters: []*Parameter{
+		{
+			Label:      ArgumentLabelNotRequired,
+			Identifier: "bytes",
+			TypeAnnotation: NewTypeAnnotation(
+				&VariableSizedType{
+					Type: &UInt8Type{},
+				},
+			),
+		},
+	},
+	ReturnTypeAnnotation: NewTypeAnnotation(
+		&OptionalType{
+			Type: StringType,
+		},
+	),
+}
+
+var stringFunctionEncodeBase64FunctionType = &CheckedFunctionType{
+	FunctionType: &FunctionType{
+		Parameters: []*Parameter{
+			{
+				Label:      ArgumentLabelNotRequired,
+				Identifier: "data",
+				TypeAnnotation: NewTypeAnnotation(
+					&VariableSizedType{
+						Type: &UInt8Type{},
+					},
+				),
+			},
+			{
+				Identifier:     "urlSafe",
+				TypeAnnotation: NewTypeAnnotation(BoolType),
+			},
+		},
+		ReturnTypeAnnotation: NewTypeAnnotation(
+			StringType,
+		),
+		RequiredArgumentCount: RequiredArgumentCount(1),
+	},
+	ArgumentExpressionsCheck: maximumArgumentCountCheck(2),
+}
+
+var stringFunctionDecodeBase64FunctionType = &CheckedFunctionType{
+	FunctionType: &FunctionType{
+		Parameters: []*Parameter{
+			{
+				Label:          ArgumentLabelNotRequired,
+				Identifier:     "string",
+				TypeAnnotation: NewTypeAnnotation(StringType),
+			},
+			{
+				Identifier:     "urlSafe",
+				TypeAnnotation: NewTypeAnnotation(BoolType),
+			},
+		},
+		ReturnTypeAnnotation: NewTypeAnnotation(
+			&OptionalType{
+				Type: &VariableSizedType{
+					Type: &UInt8Type{},
+				},
+			},
+		),
+		RequiredArgumentCount: RequiredArgumentCount(1),
+	},
+	ArgumentExpressionsCheck: maximumArgumentCountCheck(2),
+}
+
+func init() {
+
+	// Declare a function for the string type,
+	// which provides the functions that are not members of string values,
+	// e.g. the functions which encode and decode the Base64 representation of bytes
+
+	typeName := StringType.String()
+
+	// Check that the function is not accidentally redeclared
+
+	if BaseValueActivation.Find(typeName) != nil {
+		panic(errors.NewUnreachableError())
+	}
+
+	functionType := &CheckedFunctionType{
+		FunctionType: &FunctionType{
+			ReturnTypeAnnotation: NewTypeAnnotation(StringType),
+		},
+		ArgumentExpressionsCheck: func(_ *Checker, _ []ast.Expression, _ ast.Range) {
+			// NO-OP: the function has no parameters
+		},
+	}
+
+	members := NewStringMemberOrderedMap()
+
+	members.Set(
+		StringFunctionEncodeBase64FunctionName,
+		NewPublicFunctionMember(
+			functionType,
+			StringFunctionEncodeBase64FunctionName,
+			stringFunctionEncodeBase64FunctionType,
+			stringFunctionEncodeBase64FunctionDocString,
+		),
+	)
+
+	members.Set(
+		StringFunctionDecodeBase64FunctionName,
+		NewPublicFunctionMember(
+			functionType,
+			StringFunctionDecodeBase64FunctionName,
+			stringFunctionDecodeBase64FunctionType,
+			stringFunctionDecodeBase64FunctionDocString,
+		),
+	)
+
+	members.Set(
+		StringFunctionFromUTF8FunctionName,
+		NewPublicFunctionMember(
+			functionType,
+			StringFunctionFromUTF8FunctionName,
+			stringFunctionFromUTF8FunctionType,
+			stringFunctionFromUTF8FunctionDocString,
+		),
+	)
+
+	functionType.Members = members
+
+	BaseValueActivation.Set(
+		typeName,
+		baseFunctionVariable(
+			typeName,
+			functionType,
+		),
+	)
+}
@@ -0,0 +1,224 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2020 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sema
+
+import (
+	"strings"
+
+	"github.com/onflow/cadence/runtime/ast"
+)
+
+// TupleType is the type of a fixed-size, heterogeneous group of values,
+// e.g. `(Int, String)`.
+//
+// Tuples are used to return multiple values from a function,
+// and are destructured using a tuple variable declaration, e.g. `let (a, b) = f()`
+//
+type TupleType struct {
+	ElementTypeAnnotations []*TypeAnnotation
+}
+
+func NewTupleType(elementTypes []Type) *TupleType {
+	elementTypeAnnotations := make([]*TypeAnnotation, len(elementTypes))
+	for i, elementType := range elementTypes {
+		elementTypeAnnotations[i] = NewTypeAnnotation(elementType)
+	}
+	return &TupleType{
+		ElementTypeAnnotations: elementTypeAnnotations,
+	}
+}
+
+func (*TupleType) IsType() {}
+
+func formatTupleType(elementTypes []string) string {
+	return "(" + strings.Join(elementTypes, ", ") + ")"
+}
+
+func (t *TupleType) String() string {
+	elementTypes := make([]string, len(t.ElementTypeAnnotations))
+	for i, elementTypeAnnotation := range t.ElementTypeAnnotations {
+		elementTypes[i] = elementTypeAnnotation.String()
+	}
+	return formatTupleType(elementTypes)
+}
+
+func (t *TupleType) QualifiedString() string {
+	elementTypes := make([]string, len(t.ElementTypeAnnotations))
+	for i, elementTypeAnnotation := range t.ElementTypeAnnotations {
+		elementTypes[i] = elementTypeAnnotation.QualifiedString()
+	}
+	return formatTupleType(elementTypes)
+}
+
+func (t *TupleType) ID() TypeID {
+	elementTypes := make([]string, len(t.ElementTypeAnnotations))
+	for i, elementTypeAnnotation := range t.ElementTypeAnnotations {
+		elementTypes[i] = string(elementTypeAnnotation.Type.ID())
+	}
+	return TypeID(formatTupleType(elementTypes))
+}
+
+func (t *TupleType) Equal(other Type) bool {
+	otherTuple, ok := other.(*TupleType)
+	if !ok {
+		return false
+	}
+
+	if len(t.ElementTypeAnnotations) != len(otherTuple.ElementTypeAnnotations) {
+		return false
+	}
+
+	for i, elementTypeAnnotation := range t.ElementTypeAnnotations {
+		otherElementTypeAnnotation := otherTuple.ElementTypeAnnotations[i]
+		if !elementTypeAnnotation.Equal(otherElementTypeAnnotation) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// IsResourceType returns true if any of the elements is a resource
+//
+func (t *TupleType) IsResourceType() bool {
+	for _, elementTypeAnnotation := range t.ElementTypeAnnotations {
+		if elementTypeAnnotation.Type.IsResourceType() {
+			return true
+		}
+	}
+	return false
+}
+
+func (t *TupleType) IsInvalidType() bool {
+	for _, elementTypeAnnotation := range t.ElementTypeAnnotations {
+		if elementTypeAnnotation.Type.IsInvalidType() {
+			return true
+		}
+	}
+	return false
+}
+
+func (*TupleType) IsStorable(_ map[*Member]bool) bool {
+	// Tuples only exist to return multiple values from a function,
+	// and cannot be stored
+	return false
+}
+
+func (*TupleType) IsExternallyReturnable(_ map[*Member]bool) bool {
+	return false
+}
+
+func (*TupleType) IsEquatable() bool {
+	return false
+}
+
+func (t *TupleType) TypeAnnotationState() TypeAnnotationState {
+	for _, elementTypeAnnotation := range t.ElementTypeAnnotations {
+		elementTypeAnnotationState := elementTypeAnnotation.TypeAnnotationState()
+		if elementTypeAnnotationState != TypeAnnotationStateValid {
+			return elementTypeAnnotationState
+		}
+	}
+
+	return TypeAnnotationStateValid
+}
+
+func (t *TupleType) RewriteWithRestrictedTypes() (Type, bool) {
+	anyRewritten := false
+
+	rewrittenElementTypeAnnotations := make([]*TypeAnnotation, len(t.ElementTypeAnnotations))
+
+	for i, elementTypeAnnotation := range t.ElementTypeAnnotations {
+		rewrittenType, rewritten := elementTypeAnnotation.Type.RewriteWithRestrictedTypes()
+		if rewritten {
+			anyRewritten = true
+			rewrittenElementTypeAnnotations[i] = &TypeAnnotation{
+				IsResource: elementTypeAnnotation.IsResource,
+				Type:       rewrittenType,
+			}
+		} else {
+			rewrittenElementTypeAnnotations[i] = elementTypeAnnotation
+		}
+	}
+
+	if !anyRewritten {
+		return t, false
+	}
+
+	return &TupleType{
+		ElementTypeAnnotations: rewrittenElementTypeAnnotations,
+	}, true
+}
+
+func (t *TupleType) Unify(
+	other Type,
+	typeParameters *TypeParameterTypeOrderedMap,
+	report func(err error),
+	outerRange ast.Range,
+) (
+	result bool,
+) {
+	otherTuple, ok := other.(*TupleType)
+	if !ok {
+		return false
+	}
+
+	if len(t.ElementTypeAnnotations) != len(otherTuple.ElementTypeAnnotations) {
+		return false
+	}
+
+	for i, elementTypeAnnotation := range t.ElementTypeAnnotations {
+		otherElementTypeAnnotation := otherTuple.ElementTypeAnnotations[i]
+		elementUnified := elementTypeAnnotation.Type.Unify(
+			otherElementTypeAnnotation.Type,
+			typeParameters,
+			report,
+			outerRange,
+		)
+		result = result || elementUnified
+	}
+
+	return
+}
+
+func (t *TupleType) Resolve(typeArguments *TypeParameterTypeOrderedMap) Type {
+	newElementTypeAnnotations := make([]*TypeAnnotation, len(t.ElementTypeAnnotations))
+
+	for i, elementTypeAnnotation := range t.ElementTypeAnnotations {
+		newElementType := elementTypeAnnotation.Type.Resolve(typeArguments)
+		if newElementType == nil {
+			return nil
+		}
+
+		newElementTypeAnnotations[i] = &TypeAnnotation{
+			IsResource: elementTypeAnnotation.IsResource,
+			Type:       newElementType,
+		}
+	}
+
+	return &TupleType{
+		ElementTypeAnnotations: newElementTypeAnnotations,
+	}
+}
+
+func (*TupleType) GetMembers() map[string]MemberResolver {
+	// Tuples have no members, not even the built-in members:
+	// their elements are only accessible through destructuring
+	return nil
+}
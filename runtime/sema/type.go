@@ -349,6 +349,212 @@ const toBigEndianBytesFunctionDocString = `
 Returns an array containing the big-endian byte representation of the number
 `
 
+// saturatingAdd, saturatingSub, saturatingMul
+
+const SaturatingAddFunctionName = "saturatingAdd"
+const SaturatingSubtractFunctionName = "saturatingSub"
+const SaturatingMultiplyFunctionName = "saturatingMul"
+
+func saturatingArithmeticFunctionType(ty Type) *FunctionType {
+	return &FunctionType{
+		Parameters: []*Parameter{
+			{
+				Label:          ArgumentLabelNotRequired,
+				Identifier:     "other",
+				TypeAnnotation: NewTypeAnnotation(ty),
+			},
+		},
+		ReturnTypeAnnotation: NewTypeAnnotation(ty),
+	}
+}
+
+const saturatingAddFunctionDocString = `
+Returns the sum of this number and the other number, clamped to the range of the type instead of overflowing
+`
+
+const saturatingSubtractFunctionDocString = `
+Returns the difference of this number and the other number, clamped to the range of the type instead of overflowing
+`
+
+const saturatingMultiplyFunctionDocString = `
+Returns the product of this number and the other number, clamped to the range of the type instead of overflowing
+`
+
+// toInt8Checked, toInt16Checked, toInt32Checked, toInt64Checked, toInt128Checked
+
+const ToInt8CheckedFunctionName = "toInt8Checked"
+const ToInt16CheckedFunctionName = "toInt16Checked"
+const ToInt32CheckedFunctionName = "toInt32Checked"
+const ToInt64CheckedFunctionName = "toInt64Checked"
+const ToInt128CheckedFunctionName = "toInt128Checked"
+
+// CheckedConversionTargetTypes returns the narrower types the given type
+// can be converted to with a checked conversion function, by function name.
+//
+// Only the large signed integer types have checked conversion functions
+//
+func CheckedConversionTargetTypes(ty Type) map[string]IntegerRangedType {
+	switch ty.(type) {
+	case *Int128Type:
+		return map[string]IntegerRangedType{
+			ToInt8CheckedFunctionName:  &Int8Type{},
+			ToInt16CheckedFunctionName: &Int16Type{},
+			ToInt32CheckedFunctionName: &Int32Type{},
+			ToInt64CheckedFunctionName: &Int64Type{},
+		}
+
+	case *Int256Type:
+		return map[string]IntegerRangedType{
+			ToInt8CheckedFunctionName:   &Int8Type{},
+			ToInt16CheckedFunctionName:  &Int16Type{},
+			ToInt32CheckedFunctionName:  &Int32Type{},
+			ToInt64CheckedFunctionName:  &Int64Type{},
+			ToInt128CheckedFunctionName: &Int128Type{},
+		}
+
+	default:
+		return nil
+	}
+}
+
+func checkedConversionFunctionType(targetType Type) *FunctionType {
+	return &FunctionType{
+		ReturnTypeAnnotation: NewTypeAnnotation(
+			&OptionalType{
+				Type: targetType,
+			},
+		),
+	}
+}
+
+func checkedConversionFunctionDocString(targetType Type) string {
+	return fmt.Sprintf(
+		"Returns the number converted to `%[1]s`, or nil if the number is not in the range of `%[1]s`",
+		targetType,
+	)
+}
+
+// IsFixedWidthIntegerType returns true if the given type is a fixed-width integer type,
+// i.e. it is bounded and overflows are errors.
+//
+// Arbitrary-precision types are unbounded,
+// and Word types wrap around by definition
+//
+func IsFixedWidthIntegerType(ty Type) bool {
+	switch ty.(type) {
+	case *Int8Type, *Int16Type, *Int32Type, *Int64Type, *Int128Type, *Int256Type,
+		*UInt8Type, *UInt16Type, *UInt32Type, *UInt64Type, *UInt128Type, *UInt256Type:
+		return true
+	default:
+		return false
+	}
+}
+
+// SupportsSaturatingArithmetic returns true if the given type has saturating arithmetic functions.
+// Only fixed-width integer types can overflow, so only they need saturation
+//
+func SupportsSaturatingArithmetic(ty Type) bool {
+	return IsFixedWidthIntegerType(ty)
+}
+
+// toLittleEndianBytes
+
+const ToLittleEndianBytesFunctionName = "toLittleEndianBytes"
+
+var toLittleEndianBytesFunctionType = &FunctionType{
+	ReturnTypeAnnotation: NewTypeAnnotation(
+		&VariableSizedType{
+			Type: &UInt8Type{},
+		},
+	),
+}
+
+const toLittleEndianBytesFunctionDocString = `
+Returns an array containing the little-endian byte representation of the number
+`
+
+// fromBigEndianBytes, fromLittleEndianBytes
+
+const FromBigEndianBytesFunctionName = "fromBigEndianBytes"
+const FromLittleEndianBytesFunctionName = "fromLittleEndianBytes"
+
+func fromBytesFunctionType(ty Type) *FunctionType {
+	return &FunctionType{
+		Parameters: []*Parameter{
+			{
+				Label:      ArgumentLabelNotRequired,
+				Identifier: "bytes",
+				TypeAnnotation: NewTypeAnnotation(
+					&VariableSizedType{
+						Type: &UInt8Type{},
+					},
+				),
+			},
+		},
+		ReturnTypeAnnotation: NewTypeAnnotation(
+			&OptionalType{
+				Type: ty,
+			},
+		),
+	}
+}
+
+const fromBigEndianBytesFunctionDocString = `
+Returns the number with the given big-endian byte representation, or nil if the bytes do not represent a number of this type
+`
+
+const fromLittleEndianBytesFunctionDocString = `
+Returns the number with the given little-endian byte representation, or nil if the bytes do not represent a number of this type
+`
+
+// numberConversionFunctionMembers returns the members of the conversion function of the given number type
+//
+func numberConversionFunctionMembers(conversionFunctionType Type, numberType Type) *StringMemberOrderedMap {
+	members := NewStringMemberOrderedMap()
+
+	// All integer types, except the Word types, have functions to decode their byte representations.
+	// The arbitrary-precision integer types only support big-endian representations
+
+	switch numberType.(type) {
+	case *IntType, *UIntType:
+
+		members.Set(
+			FromBigEndianBytesFunctionName,
+			NewPublicFunctionMember(
+				conversionFunctionType,
+				FromBigEndianBytesFunctionName,
+				fromBytesFunctionType(numberType),
+				fromBigEndianBytesFunctionDocString,
+			),
+		)
+	}
+
+	if IsFixedWidthIntegerType(numberType) {
+
+		members.Set(
+			FromBigEndianBytesFunctionName,
+			NewPublicFunctionMember(
+				conversionFunctionType,
+				FromBigEndianBytesFunctionName,
+				fromBytesFunctionType(numberType),
+				fromBigEndianBytesFunctionDocString,
+			),
+		)
+
+		members.Set(
+			FromLittleEndianBytesFunctionName,
+			NewPublicFunctionMember(
+				conversionFunctionType,
+				FromLittleEndianBytesFunctionName,
+				fromBytesFunctionType(numberType),
+				fromLittleEndianBytesFunctionDocString,
+			),
+		)
+	}
+
+	return members
+}
+
 func withBuiltinMembers(ty Type, members map[string]MemberResolver) map[string]MemberResolver {
 	if members == nil {
 		members = map[string]MemberResolver{}
@@ -416,6 +622,68 @@ func withBuiltinMembers(ty Type, members map[string]MemberResolver) map[string]M
 		}
 	}
 
+	// All fixed-width integer types have a `toLittleEndianBytes` function
+
+	if IsFixedWidthIntegerType(ty) {
+
+		members[ToLittleEndianBytesFunctionName] = MemberResolver{
+			Kind: common.DeclarationKindFunction,
+			Resolve: func(identifier string, _ ast.Range, _ func(error)) *Member {
+				return NewPublicFunctionMember(
+					ty,
+					identifier,
+					toLittleEndianBytesFunctionType,
+					toLittleEndianBytesFunctionDocString,
+				)
+			},
+		}
+	}
+
+	// All fixed-width integer types have saturating arithmetic functions
+
+	if SupportsSaturatingArithmetic(ty) {
+
+		saturatingFunctions := map[string]string{
+			SaturatingAddFunctionName:      saturatingAddFunctionDocString,
+			SaturatingSubtractFunctionName: saturatingSubtractFunctionDocString,
+			SaturatingMultiplyFunctionName: saturatingMultiplyFunctionDocString,
+		}
+
+		for name, docString := range saturatingFunctions { //nolint:maprangecheck
+			docString := docString
+
+			members[name] = MemberResolver{
+				Kind: common.DeclarationKindFunction,
+				Resolve: func(identifier string, _ ast.Range, _ func(error)) *Member {
+					return NewPublicFunctionMember(
+						ty,
+						identifier,
+						saturatingArithmeticFunctionType(ty),
+						docString,
+					)
+				},
+			}
+		}
+	}
+
+	// Large signed integer types have checked conversion functions to narrower types
+
+	for name, targetType := range CheckedConversionTargetTypes(ty) { //nolint:maprangecheck
+		targetType := targetType
+
+		members[name] = MemberResolver{
+			Kind: common.DeclarationKindFunction,
+			Resolve: func(identifier string, _ ast.Range, _ func(error)) *Member {
+				return NewPublicFunctionMember(
+					ty,
+					identifier,
+					checkedConversionFunctionType(targetType),
+					checkedConversionFunctionDocString(targetType),
+				)
+			},
+		}
+	}
+
 	return members
 }
 
@@ -2705,10 +2973,32 @@ const arrayTypeLengthFieldDocString = `
 Returns the number of elements in the array
 `
 
+const arrayTypeReverseFunctionDocString = `
+Returns a new array which contains the elements of the original array in reverse order, but does not modify the original array
+`
+
+const arrayTypeSortedFunctionDocString = `
+Returns a new array which contains the elements of the original array in ascending order, but does not modify the original array.
+
+The sort is stable, i.e. equal elements keep their relative order.
+The elements must be comparable
+`
+
+const arrayTypeSliceFunctionDocString = `
+Returns a new array which contains the elements of the original array from the index ` + "`from`" + ` (inclusive) to the index ` + "`upTo`" + ` (exclusive), but does not modify the original array.
+
+The indices must be within the bounds of the array, and ` + "`from`" + ` must not be greater than ` + "`upTo`" + `.
+If the indices are invalid, the program aborts
+`
+
 const arrayTypeAppendFunctionDocString = `
 Adds the given element to the end of the array
 `
 
+const arrayTypeAppendAllFunctionDocString = `
+Adds all the elements of the given array to the end of the array
+`
+
 const arrayTypeConcatFunctionDocString = `
 Returns a new array which contains the given array concatenated to the end of the original array, but does not modify the original array
 `
@@ -2743,6 +3033,24 @@ Removes the last element from the array and returns it.
 The array must not be empty. If the array is empty, the program aborts
 `
 
+// IsComparableType returns true if values of the given type can be compared with each other
+// using the comparison operators `<`, `<=`, `>`, and `>=`, i.e. if the type is a leaf number type.
+//
+// Values of "hierarchy" number types, like `Integer`, are not comparable,
+// as the values might have different number types.
+//
+func IsComparableType(ty Type) bool {
+	switch ty.(type) {
+	case *NumberType, *SignedNumberType,
+		*IntegerType, *SignedIntegerType,
+		*FixedPointType, *SignedFixedPointType:
+
+		return false
+	}
+
+	return IsSubType(ty, &NumberType{})
+}
+
 func getArrayMembers(arrayType ArrayType) map[string]MemberResolver {
 
 	members := map[string]MemberResolver{
@@ -2806,6 +3114,119 @@ func getArrayMembers(arrayType ArrayType) map[string]MemberResolver {
 				)
 			},
 		},
+		"reverse": {
+			Kind: common.DeclarationKindFunction,
+			Resolve: func(identifier string, targetRange ast.Range, report func(error)) *Member {
+
+				elementType := arrayType.ElementType(false)
+
+				// The result is a copy of the array, and resources cannot be copied
+
+				if elementType.IsResourceType() {
+					report(
+						&InvalidResourceArrayMemberError{
+							Name:            identifier,
+							DeclarationKind: common.DeclarationKindFunction,
+							Range:           targetRange,
+						},
+					)
+				}
+
+				return NewPublicFunctionMember(
+					arrayType,
+					identifier,
+					&FunctionType{
+						ReturnTypeAnnotation: NewTypeAnnotation(
+							&VariableSizedType{
+								Type: elementType,
+							},
+						),
+					},
+					arrayTypeReverseFunctionDocString,
+				)
+			},
+		},
+		"sorted": {
+			Kind: common.DeclarationKindFunction,
+			Resolve: func(identifier string, targetRange ast.Range, report func(error)) *Member {
+
+				elementType := arrayType.ElementType(false)
+
+				// The result is a copy of the array, and resources cannot be copied
+
+				if elementType.IsResourceType() {
+					report(
+						&InvalidResourceArrayMemberError{
+							Name:            identifier,
+							DeclarationKind: common.DeclarationKindFunction,
+							Range:           targetRange,
+						},
+					)
+				} else if !elementType.IsInvalidType() && !IsComparableType(elementType) {
+					report(
+						&NotComparableTypeError{
+							Type:  elementType,
+							Range: targetRange,
+						},
+					)
+				}
+
+				return NewPublicFunctionMember(
+					arrayType,
+					identifier,
+					&FunctionType{
+						ReturnTypeAnnotation: NewTypeAnnotation(
+							&VariableSizedType{
+								Type: elementType,
+							},
+						),
+					},
+					arrayTypeSortedFunctionDocString,
+				)
+			},
+		},
+		"slice": {
+			Kind: common.DeclarationKindFunction,
+			Resolve: func(identifier string, targetRange ast.Range, report func(error)) *Member {
+
+				elementType := arrayType.ElementType(false)
+
+				// The result is a copy of the array, and resources cannot be copied
+
+				if elementType.IsResourceType() {
+					report(
+						&InvalidResourceArrayMemberError{
+							Name:            identifier,
+							DeclarationKind: common.DeclarationKindFunction,
+							Range:           targetRange,
+						},
+					)
+				}
+
+				return NewPublicFunctionMember(
+					arrayType,
+					identifier,
+					&FunctionType{
+						Parameters: []*Parameter{
+							{
+								Identifier:     "from",
+								TypeAnnotation: NewTypeAnnotation(&IntType{}),
+							},
+							{
+								Identifier:     "upTo",
+								TypeAnnotation: NewTypeAnnotation(&IntType{}),
+							},
+						},
+						ReturnTypeAnnotation: NewTypeAnnotation(
+							&VariableSizedType{
+								Type: elementType,
+							},
+						),
+					},
+					arrayTypeSliceFunctionDocString,
+				)
+			},
+		},
 	}
 
 	// TODO: maybe still return members but report a helpful error?
@@ -2836,6 +3257,29 @@ func getArrayMembers(arrayType ArrayType) map[string]MemberResolver {
 			},
 		}
 
+		members["appendAll"] = MemberResolver{
+			Kind: common.DeclarationKindFunction,
+			Resolve: func(identifier string, targetRange ast.Range, report func(error)) *Member {
+				return NewPublicFunctionMember(
+					arrayType,
+					identifier,
+					&FunctionType{
+						Parameters: []*Parameter{
+							{
+								Label:          ArgumentLabelNotRequired,
+								Identifier:     "other",
+								TypeAnnotation: NewTypeAnnotation(arrayType),
+							},
+						},
+						ReturnTypeAnnotation: NewTypeAnnotation(
+							VoidType,
+						),
+					},
+					arrayTypeAppendAllFunctionDocString,
+				)
+			},
+		}
+
 		members["concat"] = MemberResolver{
 			Kind: common.DeclarationKindFunction,
 			Resolve: func(identifier string, targetRange ast.Range, report func(error)) *Member {
@@ -3843,6 +4287,27 @@ type ArgumentExpressionsCheck func(
 type CheckedFunctionType struct {
 	*FunctionType
 	ArgumentExpressionsCheck ArgumentExpressionsCheck
+	Members                  *StringMemberOrderedMap
+}
+
+func (t *CheckedFunctionType) GetMembers() map[string]MemberResolver {
+	if t.Members == nil {
+		return t.FunctionType.GetMembers()
+	}
+
+	members := make(map[string]MemberResolver, t.Members.Len())
+	t.Members.Foreach(func(name string, loopMember *Member) {
+		// NOTE: don't capture loop variable
+		member := loopMember
+		members[name] = MemberResolver{
+			Kind: member.DeclarationKind,
+			Resolve: func(_ string, _ ast.Range, _ func(error)) *Member {
+				return member
+			},
+		}
+	})
+
+	return withBuiltinMembers(t, members)
 }
 
 func (t *CheckedFunctionType) CheckArgumentExpressions(
@@ -3884,6 +4349,7 @@ func init() {
 		PublicKeyType,
 		SignatureAlgorithmType,
 		HashAlgorithmType,
+		PRNGType,
 	}
 
 	types := append(
@@ -4012,23 +4478,27 @@ func init() {
 				panic(errors.NewUnreachableError())
 			}
 
+			functionType := &CheckedFunctionType{
+				FunctionType: &FunctionType{
+					Parameters: []*Parameter{
+						{
+							Label:          ArgumentLabelNotRequired,
+							Identifier:     "value",
+							TypeAnnotation: NewTypeAnnotation(&NumberType{}),
+						},
+					},
+					ReturnTypeAnnotation: NewTypeAnnotation(numberType),
+				},
+				ArgumentExpressionsCheck: numberFunctionArgumentExpressionsChecker(numberType),
+			}
+
+			functionType.Members = numberConversionFunctionMembers(functionType, numberType)
+
 			BaseValueActivation.Set(
 				typeName,
 				baseFunctionVariable(
 					typeName,
-					&CheckedFunctionType{
-						FunctionType: &FunctionType{
-							Parameters: []*Parameter{
-								{
-									Label:          ArgumentLabelNotRequired,
-									Identifier:     "value",
-									TypeAnnotation: NewTypeAnnotation(&NumberType{}),
-								},
-							},
-							ReturnTypeAnnotation: NewTypeAnnotation(numberType),
-						},
-						ArgumentExpressionsCheck: numberFunctionArgumentExpressionsChecker(numberType),
-					},
+					functionType,
 				),
 			)
 		}
@@ -4059,36 +4529,94 @@ func init() {
 		panic(errors.NewUnreachableError())
 	}
 
+	functionType := &CheckedFunctionType{
+		FunctionType: &FunctionType{
+			Parameters: []*Parameter{
+				{
+					Label:          ArgumentLabelNotRequired,
+					Identifier:     "value",
+					TypeAnnotation: NewTypeAnnotation(&IntegerType{}),
+				},
+			},
+			ReturnTypeAnnotation: NewTypeAnnotation(addressType),
+		},
+		ArgumentExpressionsCheck: func(checker *Checker, argumentExpressions []ast.Expression, _ ast.Range) {
+			if len(argumentExpressions) < 1 {
+				return
+			}
+
+			intExpression, ok := argumentExpressions[0].(*ast.IntegerExpression)
+			if !ok {
+				return
+			}
+
+			CheckAddressLiteral(intExpression, checker.report)
+		},
+	}
+
+	functionType.Members = addressConversionFunctionMembers(functionType, addressType)
+
 	BaseValueActivation.Set(
 		typeName,
 		baseFunctionVariable(
 			typeName,
-			&CheckedFunctionType{
-				FunctionType: &FunctionType{
-					Parameters: []*Parameter{
-						{
-							Label:          ArgumentLabelNotRequired,
-							Identifier:     "value",
-							TypeAnnotation: NewTypeAnnotation(&IntegerType{}),
-						},
-					},
-					ReturnTypeAnnotation: NewTypeAnnotation(addressType),
-				},
-				ArgumentExpressionsCheck: func(checker *Checker, argumentExpressions []ast.Expression, _ ast.Range) {
-					if len(argumentExpressions) < 1 {
-						return
-					}
+			functionType,
+		),
+	)
+}
 
-					intExpression, ok := argumentExpressions[0].(*ast.IntegerExpression)
-					if !ok {
-						return
-					}
+// Address.fromBytes, Address.fromString
 
-					CheckAddressLiteral(intExpression, checker.report)
+const AddressFromBytesFunctionName = "fromBytes"
+const AddressFromStringFunctionName = "fromString"
+
+const addressFromBytesFunctionDocString = `
+Returns the address with the given byte representation, or nil if the number of bytes is not exactly the size of an address
+`
+
+const addressFromStringFunctionDocString = `
+Returns the address with the given 0x-prefixed, hexadecimal string representation, or nil if the string is not a valid address
+`
+
+// addressConversionFunctionMembers returns the members of the conversion function of the address type
+//
+func addressConversionFunctionMembers(conversionFunctionType Type, addressType Type) *StringMemberOrderedMap {
+	members := NewStringMemberOrderedMap()
+
+	members.Set(
+		AddressFromBytesFunctionName,
+		NewPublicFunctionMember(
+			conversionFunctionType,
+			AddressFromBytesFunctionName,
+			fromBytesFunctionType(addressType),
+			addressFromBytesFunctionDocString,
+		),
+	)
+
+	members.Set(
+		AddressFromStringFunctionName,
+		NewPublicFunctionMember(
+			conversionFunctionType,
+			AddressFromStringFunctionName,
+			&FunctionType{
+				Parameters: []*Parameter{
+					{
+						Label:          ArgumentLabelNotRequired,
+						Identifier:     "string",
+						TypeAnnotation: NewTypeAnnotation(StringType),
+					},
 				},
+				ReturnTypeAnnotation: NewTypeAnnotation(
+					&OptionalType{
+						Type: addressType,
+					},
+				),
 			},
+			addressFromStringFunctionDocString,
 		),
 	)
+
+	return members
 }
 
 func numberFunctionArgumentExpressionsChecker(targetType Type) ArgumentExpressionsCheck {
@@ -4272,6 +4800,35 @@ type CompositeType struct {
 	nestedTypes           *StringTypeOrderedMap
 	ContainerType         Type
 	EnumRawType           Type
+	// EnumCases are the names of the enum cases, in declaration order.
+	// Only set for enum types
+	EnumCases []string
+	// builtinEnumCases are the cases of a built-in enum type
+	builtinEnumCases []BuiltinEnumCase
+}
+
+// BuiltinEnumCases returns the cases of the built-in enum type,
+// e.g. the signature algorithms of `SignatureAlgorithm`.
+//
+// Returns nil if the type is not an enum, or not a built-in enum.
+//
+func (t *CompositeType) BuiltinEnumCases() []BuiltinEnumCase {
+	if t.EnumRawType == nil {
+		return nil
+	}
+	return t.builtinEnumCases
+}
+
+// CaseDocString returns the doc string of the case with the given name
+// of the built-in enum type, if any.
+//
+func (t *CompositeType) CaseDocString(name string) (string, bool) {
+	for _, enumCase := range t.BuiltinEnumCases() {
+		if enumCase.Name() == name {
+			return enumCase.DocString(), true
+		}
+	}
+	return "", false
 }
 
 func (t *CompositeType) ExplicitInterfaceConformanceSet() *InterfaceSet {
@@ -4457,6 +5014,44 @@ func (t *CompositeType) TypeRequirements() []*CompositeType {
 	return typeRequirements
 }
 
+// ConformsTo returns true if the composite type structurally satisfies
+// the members of the given interface type, i.e. if it has a member
+// with a matching declaration kind, type, variable kind, and access
+// for each member of the interface.
+//
+// If the composite type does not conform, the mismatching members are returned.
+// The composite member of a mismatch is nil if the member is missing.
+//
+// Unlike the conformance check performed by the checker,
+// declared conformances, the composite kind, initializer, and nested type requirements
+// are not considered. Member access is determined using the strict access check mode.
+//
+func (t *CompositeType) ConformsTo(interfaceType *InterfaceType) (bool, []MemberMismatch) {
+	var memberMismatches []MemberMismatch
+
+	interfaceType.Members.Foreach(func(name string, interfaceMember *Member) {
+
+		// Conforming types do not provide a concrete member
+		// for the member in the interface if it is predeclared
+
+		if interfaceMember.Predeclared {
+			return
+		}
+
+		compositeMember, ok := t.Members.Get(name)
+		if !ok || !memberSatisfied(compositeMember, interfaceMember, AccessCheckModeStrict) {
+			memberMismatches = append(memberMismatches,
+				MemberMismatch{
+					CompositeMember: compositeMember,
+					InterfaceMember: interfaceMember,
+				},
+			)
+		}
+	})
+
+	return len(memberMismatches) == 0, memberMismatches
+}
+
 func (*CompositeType) Unify(_ Type, _ *TypeParameterTypeOrderedMap, _ func(err error), _ ast.Range) bool {
 	// TODO:
 	return false
@@ -4508,6 +5103,31 @@ func (t *CompositeType) initializeMemberResolvers() {
 	})
 }
 
+// AllMembers returns all members of the composite type,
+// including the members of the interfaces it conforms to.
+//
+// Members declared by the composite type take precedence
+// over members with the same name declared by a conformance.
+//
+func (t *CompositeType) AllMembers() map[string]*Member {
+	members := make(map[string]*Member, t.Members.Len())
+
+	t.Members.Foreach(func(name string, member *Member) {
+		members[name] = member
+	})
+
+	t.ExplicitInterfaceConformanceSet().
+		ForEach(func(conformance *InterfaceType) {
+			conformance.Members.Foreach(func(name string, member *Member) {
+				if _, ok := members[name]; !ok {
+					members[name] = member
+				}
+			})
+		})
+
+	return members
+}
+
 // Member
 
 type Member struct {
@@ -4658,6 +5278,17 @@ type InterfaceType struct {
 	InitializerParameters []*Parameter
 	ContainerType         Type
 	nestedTypes           *StringTypeOrderedMap
+	// functionConditions are the conditions of the function requirements, keyed by function name.
+	// Only function requirements which declare conditions have an entry
+	functionConditions map[string]FunctionConditions
+}
+
+// FunctionConditions are the pre-conditions and post-conditions
+// declared by a function requirement of an interface
+//
+type FunctionConditions struct {
+	PreConditions  ast.Conditions
+	PostConditions ast.Conditions
 }
 
 func (*InterfaceType) IsType() {}
@@ -4674,6 +5305,14 @@ func (t *InterfaceType) GetContainerType() Type {
 	return t.ContainerType
 }
 
+// FunctionConditions returns the conditions declared by the function requirement with the given name.
+// Returns false if the interface has no such function requirement, or it declares no conditions.
+//
+func (t *InterfaceType) FunctionConditions(functionName string) (FunctionConditions, bool) {
+	conditions, ok := t.functionConditions[functionName]
+	return conditions, ok
+}
+
 func (t *InterfaceType) GetCompositeKind() common.CompositeKind {
 	return t.CompositeKind
 }
@@ -4925,6 +5564,13 @@ const dictionaryTypeValuesFieldDocString = `
 An array containing all values of the dictionary
 `
 
+const dictionaryTypeForEachKeyFunctionDocString = `
+Iterates over the keys of the dictionary in insertion order, and calls the given function for each key.
+
+The iteration stops when the function returns false.
+The dictionary must not be modified during the iteration
+`
+
 const dictionaryTypeInsertFunctionDocString = `
 Inserts the given value into the dictionary under the given key.
 
@@ -4937,6 +5583,42 @@ Removes the value for the given key from the dictionary.
 Returns the value as an optional if the dictionary contained the key, or nil if the dictionary did not contain the key
 `
 
+const dictionaryTypeMergeFunctionDocString = `
+Inserts all entries of the given dictionary into the dictionary.
+
+If the dictionary already contains a key of the given dictionary, the existing value is replaced if ` + "`overwrite`" + ` is true, and kept otherwise.
+
+For dictionaries with resource values, ` + "`overwrite`" + ` must be false, and the dictionary must not contain any of the keys of the given dictionary, as a resource would be lost.
+If it does, the program aborts
+`
+
+// checkMergeArgumentExpressions checks the arguments of a call of the `merge` function.
+//
+// Overwriting an existing resource value would result in its loss,
+// so for dictionaries with resource values the `overwrite` argument must be the literal `false`.
+//
+func (t *DictionaryType) checkMergeArgumentExpressions(
+	checker *Checker,
+	argumentExpressions []ast.Expression,
+	_ ast.Range,
+) {
+	if !t.ValueType.IsResourceType() || len(argumentExpressions) < 2 {
+		return
+	}
+
+	overwriteExpression := argumentExpressions[1]
+
+	if boolExpression, ok := overwriteExpression.(*ast.BoolExpression); ok && !boolExpression.Value {
+		return
+	}
+
+	checker.report(
+		&InvalidResourceDictionaryMergeOverwriteError{
+			Range: ast.NewRangeFromPositioned(overwriteExpression),
+		},
+	)
+}
+
 func (t *DictionaryType) GetMembers() map[string]MemberResolver {
 	t.initializeMemberResolvers()
 	return t.memberResolvers
@@ -5026,6 +5708,40 @@ func (t *DictionaryType) initializeMemberResolvers() {
 					)
 				},
 			},
+			"forEachKey": {
+				Kind: common.DeclarationKindFunction,
+				Resolve: func(identifier string, _ ast.Range, _ func(error)) *Member {
+					return NewPublicFunctionMember(t,
+						identifier,
+						&FunctionType{
+							Parameters: []*Parameter{
+								{
+									Label:      ArgumentLabelNotRequired,
+									Identifier: "f",
+									TypeAnnotation: NewTypeAnnotation(
+										&FunctionType{
+											Parameters: []*Parameter{
+												{
+													Label:          ArgumentLabelNotRequired,
+													Identifier:     "key",
+													TypeAnnotation: NewTypeAnnotation(t.KeyType),
+												},
+											},
+											ReturnTypeAnnotation: NewTypeAnnotation(
+												BoolType,
+											),
+										},
+									),
+								},
+							},
+							ReturnTypeAnnotation: NewTypeAnnotation(
+								VoidType,
+							),
+						},
+						dictionaryTypeForEachKeyFunctionDocString,
+					)
+				},
+			},
 			"insert": {
 				Kind: common.DeclarationKindFunction,
 				Resolve: func(identifier string, _ ast.Range, _ func(error)) *Member {
@@ -5053,6 +5769,34 @@ func (t *DictionaryType) initializeMemberResolvers() {
 					)
 				},
 			},
+			"merge": {
+				Kind: common.DeclarationKindFunction,
+				Resolve: func(identifier string, _ ast.Range, _ func(error)) *Member {
+					return NewPublicFunctionMember(t,
+						identifier,
+						&CheckedFunctionType{
+							FunctionType: &FunctionType{
+								Parameters: []*Parameter{
+									{
+										Label:          ArgumentLabelNotRequired,
+										Identifier:     "other",
+										TypeAnnotation: NewTypeAnnotation(t),
+									},
+									{
+										Identifier:     "overwrite",
+										TypeAnnotation: NewTypeAnnotation(BoolType),
+									},
+								},
+								ReturnTypeAnnotation: NewTypeAnnotation(
+									VoidType,
+								),
+							},
+							ArgumentExpressionsCheck: t.checkMergeArgumentExpressions,
+						},
+						dictionaryTypeMergeFunctionDocString,
+					)
+				},
+			},
 			"remove": {
 				Kind: common.DeclarationKindFunction,
 				Resolve: func(identifier string, _ ast.Range, _ func(error)) *Member {
@@ -5491,6 +6235,25 @@ func IsSubType(subType Type, superType Type) bool {
 			typedSuperType.ElementType(false),
 		)
 
+	case *TupleType:
+		typedSubType, ok := subType.(*TupleType)
+		if !ok {
+			return false
+		}
+
+		if len(typedSubType.ElementTypeAnnotations) != len(typedSuperType.ElementTypeAnnotations) {
+			return false
+		}
+
+		for i, superElementTypeAnnotation := range typedSuperType.ElementTypeAnnotations {
+			subElementTypeAnnotation := typedSubType.ElementTypeAnnotations[i]
+			if !IsSubType(subElementTypeAnnotation.Type, superElementTypeAnnotation.Type) {
+				return false
+			}
+		}
+
+		return true
+
 	case *ConstantSizedType:
 		typedSubType, ok := subType.(*ConstantSizedType)
 		if !ok {
@@ -6564,6 +7327,7 @@ func init() {
 		SignatureAlgorithmType,
 		AuthAccountKeysType,
 		PublicAccountKeysType,
+		PRNGType,
 	}
 
 	for _, semaType := range types {
@@ -6666,8 +7430,51 @@ var PublicKeyType = func() *CompositeType {
 	return accountKeyType
 }()
 
+const PRNGTypeName = "PRNG"
+const PRNGNextFunctionName = "next"
+
+// PRNGType represents a deterministic pseudo-random number generator.
+var PRNGType = func() *CompositeType {
+
+	prngType := &CompositeType{
+		Identifier: PRNGTypeName,
+		Kind:       common.CompositeKindStructure,
+	}
+
+	const prngNextFunctionDocString = `
+Returns the next pseudo-random number of the generator's deterministic stream.
+
+The stream only depends on the seed of the generator, so it is not suitable for security-relevant purposes
+`
+
+	var members = []*Member{
+		NewPublicFunctionMember(
+			prngType,
+			PRNGNextFunctionName,
+			&FunctionType{
+				ReturnTypeAnnotation: NewTypeAnnotation(&UInt64Type{}),
+			},
+			prngNextFunctionDocString,
+		),
+	}
+
+	prngType.Members = GetMembersAsMap(members)
+
+	return prngType
+}()
+
 type CryptoAlgorithm interface {
+	BuiltinEnumCase
 	RawValue() uint8
+}
+
+// BuiltinEnumCase is a case of a built-in enum type, see NewBuiltinEnumType.
+//
+// The raw value is unsigned, as raw values are contiguous from zero,
+// and unlike CryptoAlgorithm.RawValue it is not limited to the range of `UInt8`.
+//
+type BuiltinEnumCase interface {
+	EnumCaseRawValue() uint64
 	Name() string
 	DocString() string
 }
@@ -19,6 +19,7 @@
 package sema
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -529,3 +530,147 @@ func TestBeforeType_Strings(t *testing.T) {
 		beforeType.QualifiedString(),
 	)
 }
+
+func TestCompositeType_BuiltinEnumCases(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("built-in enum", func(t *testing.T) {
+
+		t.Parallel()
+
+		assert.Equal(t,
+			[]BuiltinEnumCase{
+				SignatureAlgorithmECDSA_P256,
+				SignatureAlgorithmECDSA_Secp256k1,
+			},
+			SignatureAlgorithmType.BuiltinEnumCases(),
+		)
+
+		assert.Equal(t,
+			cryptoAlgorithmEnumCases(HashAlgorithms),
+			HashAlgorithmType.BuiltinEnumCases(),
+		)
+	})
+
+	t.Run("non-enum", func(t *testing.T) {
+
+		t.Parallel()
+
+		assert.Nil(t, PublicKeyType.BuiltinEnumCases())
+	})
+}
+
+func TestCompositeType_CaseDocString(t *testing.T) {
+
+	t.Parallel()
+
+	docString, ok := SignatureAlgorithmType.CaseDocString("ECDSA_P256")
+	require.True(t, ok)
+	assert.Equal(t, SignatureAlgorithmDocStringECDSA_P256, docString)
+
+	docString, ok = HashAlgorithmType.CaseDocString("SHA3_384")
+	require.True(t, ok)
+	assert.Equal(t, HashAlgorithmDocStringSHA3_384, docString)
+
+	_, ok = HashAlgorithmType.CaseDocString("MD5")
+	require.False(t, ok)
+
+	_, ok = PublicKeyType.CaseDocString("publicKey")
+	require.False(t, ok)
+}
+
+type testBuiltinEnumCase struct {
+	name     string
+	rawValue uint64
+}
+
+func (c testBuiltinEnumCase) EnumCaseRawValue() uint64 {
+	return c.rawValue
+}
+
+func (c testBuiltinEnumCase) Name() string {
+	return c.name
+}
+
+func (c testBuiltinEnumCase) DocString() string {
+	return "doc " + c.name
+}
+
+func TestNewBuiltinEnumType(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("valid", func(t *testing.T) {
+
+		t.Parallel()
+
+		enumCases := []BuiltinEnumCase{
+			testBuiltinEnumCase{name: "a", rawValue: 0},
+			testBuiltinEnumCase{name: "b", rawValue: 1},
+		}
+
+		ty, err := NewBuiltinEnumType("E", &UInt8Type{}, enumCases)
+		require.NoError(t, err)
+
+		assert.Equal(t, "E", ty.Identifier)
+		assert.Equal(t, common.CompositeKindEnum, ty.Kind)
+		assert.Equal(t, []string{"a", "b"}, ty.EnumCases)
+		assert.Equal(t, enumCases, ty.BuiltinEnumCases())
+
+		docString, ok := ty.CaseDocString("b")
+		require.True(t, ok)
+		assert.Equal(t, "doc b", docString)
+	})
+
+	t.Run("invalid raw type", func(t *testing.T) {
+
+		t.Parallel()
+
+		for _, rawType := range []Type{
+			StringType,
+			&IntegerType{},
+			&SignedIntegerType{},
+		} {
+			_, err := NewBuiltinEnumType("E", rawType, nil)
+			require.IsType(t, &InvalidBuiltinEnumRawTypeError{}, err)
+		}
+	})
+
+	t.Run("non-contiguous raw values", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := NewBuiltinEnumType(
+			"E",
+			&UInt8Type{},
+			[]BuiltinEnumCase{
+				testBuiltinEnumCase{name: "a", rawValue: 0},
+				testBuiltinEnumCase{name: "b", rawValue: 2},
+			},
+		)
+		require.IsType(t, &InvalidBuiltinEnumRawValueError{}, err)
+	})
+
+	t.Run("raw values out of range", func(t *testing.T) {
+
+		t.Parallel()
+
+		enumCases := make([]BuiltinEnumCase, 257)
+		for i := range enumCases {
+			enumCases[i] = testBuiltinEnumCase{
+				name:     fmt.Sprintf("c%d", i),
+				rawValue: uint64(i),
+			}
+		}
+
+		_, err := NewBuiltinEnumType("E", &Int8Type{}, enumCases)
+		require.IsType(t, &BuiltinEnumRawValueOverflowError{}, err)
+
+		_, err = NewBuiltinEnumType("E", &UInt8Type{}, enumCases)
+		require.IsType(t, &BuiltinEnumRawValueOverflowError{}, err)
+
+		_, err = NewBuiltinEnumType("E", &UInt16Type{}, enumCases)
+		require.NoError(t, err)
+	})
+}
@@ -0,0 +1,164 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2020 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sema
+
+import (
+	"fmt"
+
+	"github.com/onflow/cadence/runtime/ast"
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/errors"
+)
+
+// Warning is a non-fatal problem reported by the checker.
+// Unlike errors, warnings do not fail the checking of a program.
+//
+type Warning interface {
+	Warning() string
+	ast.HasPosition
+	isWarning()
+}
+
+// PossibleDivisionByZeroError is reported when the divisor of a division or remainder
+// is not known to be non-zero, in which case the operation might abort the program.
+//
+type PossibleDivisionByZeroError struct {
+	Operation ast.Operation
+	ast.Range
+}
+
+func (e *PossibleDivisionByZeroError) Warning() string {
+	return fmt.Sprintf(
+		"possible division by zero: divisor of `%s` might be zero",
+		e.Operation.Symbol(),
+	)
+}
+
+func (*PossibleDivisionByZeroError) isWarning() {}
+
+// UnusedFunctionError is reported when a function is declared,
+// but never referenced in the program.
+//
+type UnusedFunctionError struct {
+	Name string
+	ast.Range
+}
+
+func (e *UnusedFunctionError) Warning() string {
+	return fmt.Sprintf(
+		"unused function: `%s` is never referenced",
+		e.Name,
+	)
+}
+
+func (*UnusedFunctionError) isWarning() {}
+
+// ConstantConditionWarning is reported when the condition of an `if` or `while` statement
+// is always true or always false, e.g. `if true` or `if x != x`.
+//
+type ConstantConditionWarning struct {
+	Value bool
+	ast.Range
+}
+
+func (e *ConstantConditionWarning) Warning() string {
+	return fmt.Sprintf(
+		"constant condition: condition is always %t",
+		e.Value,
+	)
+}
+
+func (*ConstantConditionWarning) isWarning() {}
+
+// MissingDocCommentWarning is reported when a public declaration has no doc comment,
+// and doc comments are required for public declarations.
+//
+type MissingDocCommentWarning struct {
+	DeclarationKind common.DeclarationKind
+	Name            string
+	ast.Range
+}
+
+func (e *MissingDocCommentWarning) Warning() string {
+	return fmt.Sprintf(
+		"missing doc comment for public %s `%s`",
+		e.DeclarationKind.Name(),
+		e.Name,
+	)
+}
+
+func (*MissingDocCommentWarning) isWarning() {}
+
+// VariableShadowingWarning is reported when a declaration shadows a variable of an outer scope,
+// and the shadowing of variables is reported.
+//
+type VariableShadowingWarning struct {
+	Kind        common.DeclarationKind
+	Name        string
+	Pos         ast.Position
+	PreviousPos *ast.Position
+}
+
+func (e *VariableShadowingWarning) Warning() string {
+	return fmt.Sprintf(
+		"%s `%s` shadows a declaration of an outer scope",
+		e.Kind.Name(),
+		e.Name,
+	)
+}
+
+func (*VariableShadowingWarning) isWarning() {}
+
+func (e *VariableShadowingWarning) StartPosition() ast.Position {
+	return e.Pos
+}
+
+func (e *VariableShadowingWarning) EndPosition() ast.Position {
+	length := len(e.Name)
+	return e.Pos.Shifted(length - 1)
+}
+
+func (e *VariableShadowingWarning) ErrorNotes() []errors.ErrorNote {
+	previousStartPos := *e.PreviousPos
+	length := len(e.Name)
+	previousEndPos := previousStartPos.Shifted(length - 1)
+
+	return []errors.ErrorNote{
+		&RedeclarationNote{
+			Range: ast.Range{
+				StartPos: previousStartPos,
+				EndPos:   previousEndPos,
+			},
+		},
+	}
+}
+
+// UnreachableStatementWarning is reported for the statements following
+// a statement which definitely exits, e.g. a `return` statement,
+// if unreachable statements are reported as warnings instead of errors.
+//
+type UnreachableStatementWarning struct {
+	ast.Range
+}
+
+func (e *UnreachableStatementWarning) Warning() string {
+	return "unreachable statement"
+}
+
+func (*UnreachableStatementWarning) isWarning() {}
@@ -19,6 +19,7 @@
 package stdlib
 
 import (
+	"encoding/hex"
 	"fmt"
 
 	"github.com/onflow/cadence/runtime/common"
@@ -101,12 +102,45 @@ var PanicFunction = NewStandardLibraryFunction(
 	},
 )
 
+// EncodeHexFunction
+
+var EncodeHexFunction = NewStandardLibraryFunction(
+	"encodeHex",
+	&sema.FunctionType{
+		Parameters: []*sema.Parameter{
+			{
+				Label:      sema.ArgumentLabelNotRequired,
+				Identifier: "data",
+				TypeAnnotation: sema.NewTypeAnnotation(
+					&sema.VariableSizedType{
+						Type: &sema.UInt8Type{},
+					},
+				),
+			},
+		},
+		ReturnTypeAnnotation: sema.NewTypeAnnotation(
+			sema.StringType,
+		),
+	},
+	func(invocation interpreter.Invocation) interpreter.Value {
+		data, err := interpreter.ByteArrayValueToByteSlice(invocation.Arguments[0])
+		if err != nil {
+			panic(err)
+		}
+
+		return interpreter.NewStringValue(hex.EncodeToString(data))
+	},
+)
+
 // BuiltinFunctions
 
 var BuiltinFunctions = StandardLibraryFunctions{
 	AssertFunction,
 	PanicFunction,
 	CreatePublicKeyFunction,
+	HashWithKeyFunction,
+	EncodeHexFunction,
+	CreatePRNGFunction,
 }
 
 // LogFunction
@@ -165,6 +199,25 @@ var CreatePublicKeyFunction = NewStandardLibraryFunction(
 	},
 )
 
+// CreatePRNGFunction is the constructor of the deterministic pseudo-random number generator.
+//
+var CreatePRNGFunction = NewStandardLibraryFunction(
+	sema.PRNGTypeName,
+	&sema.FunctionType{
+		Parameters: []*sema.Parameter{
+			{
+				Identifier:     "seed",
+				TypeAnnotation: sema.NewTypeAnnotation(&sema.UInt64Type{}),
+			},
+		},
+		ReturnTypeAnnotation: sema.NewTypeAnnotation(sema.PRNGType),
+	},
+	func(invocation interpreter.Invocation) interpreter.Value {
+		seed := invocation.Arguments[0].(interpreter.UInt64Value)
+		return interpreter.NewPRNGValue(uint64(seed))
+	},
+)
+
 // BuiltinValues
 
 var BuiltinValues = StandardLibraryValues{
@@ -172,27 +225,37 @@ var BuiltinValues = StandardLibraryValues{
 	HashAlgorithmValue,
 }
 
-var SignatureAlgorithmValue = StandardLibraryValue{
-	Name:  sema.SignatureAlgorithmTypeName,
-	Type:  cryptoAlgorithmEnumType(sema.SignatureAlgorithmType, sema.SignatureAlgorithms),
-	Value: cryptoAlgorithmEnumValue(sema.SignatureAlgorithmType, sema.SignatureAlgorithms),
-	Kind:  common.DeclarationKindEnum,
-}
+var SignatureAlgorithmValue = NewBuiltinEnumValue(sema.SignatureAlgorithmType)
 
-var HashAlgorithmValue = StandardLibraryValue{
-	Name:  sema.HashAlgorithmTypeName,
-	Type:  cryptoAlgorithmEnumType(sema.HashAlgorithmType, sema.HashAlgorithms),
-	Value: cryptoAlgorithmEnumValue(sema.HashAlgorithmType, sema.HashAlgorithms),
-	Kind:  common.DeclarationKindEnum,
+var HashAlgorithmValue = NewBuiltinEnumValue(sema.HashAlgorithmType)
+
+// NewBuiltinEnumValue returns the declaration of the constructor
+// of the given built-in enum type, see sema.NewBuiltinEnumType.
+//
+// The constructor has the name of the enum type,
+// and has the cases of the enum as members.
+//
+func NewBuiltinEnumValue(enumType *sema.CompositeType) StandardLibraryValue {
+	return StandardLibraryValue{
+		Name:  enumType.Identifier,
+		Type:  BuiltinEnumConstructorType(enumType),
+		Value: BuiltinEnumConstructorValue(enumType),
+		Kind:  common.DeclarationKindEnum,
+	}
 }
 
-func cryptoAlgorithmEnumType(enumType *sema.CompositeType, enumCases []sema.CryptoAlgorithm) *sema.SpecialFunctionType {
+// BuiltinEnumConstructorType returns the type of the constructor
+// of the given built-in enum type
+//
+func BuiltinEnumConstructorType(enumType *sema.CompositeType) *sema.SpecialFunctionType {
+	enumCases := enumType.BuiltinEnumCases()
+
 	members := make([]*sema.Member, len(enumCases))
-	for i, algo := range enumCases {
+	for i, enumCase := range enumCases {
 		members[i] = sema.NewPublicEnumCaseMember(
 			enumType,
-			algo.Name(),
-			algo.DocString(),
+			enumCase.Name(),
+			enumCase.DocString(),
 		)
 	}
 
@@ -216,13 +279,17 @@ func cryptoAlgorithmEnumType(enumType *sema.CompositeType, enumCases []sema.Cryp
 	return constructorType
 }
 
-func cryptoAlgorithmEnumValue(enumType *sema.CompositeType, enumCases []sema.CryptoAlgorithm) (value interpreter.Value) {
-	caseCount := len(enumCases)
-	caseValues := make([]*interpreter.CompositeValue, caseCount)
+// BuiltinEnumConstructorValue returns the constructor function
+// of the given built-in enum type
+//
+func BuiltinEnumConstructorValue(enumType *sema.CompositeType) interpreter.Value {
+	enumCases := enumType.BuiltinEnumCases()
+
+	caseValues := make([]*interpreter.CompositeValue, len(enumCases))
 	constructorNestedVariables := interpreter.NewStringVariableOrderedMap()
 
 	for i, enumCase := range enumCases {
-		caseValue := interpreter.NewCryptoAlgorithmEnumCaseValue(enumType, enumCase.RawValue())
+		caseValue := interpreter.NewBuiltinEnumCaseValue(enumType, enumCase.EnumCaseRawValue())
 		caseValues[i] = caseValue
 		constructorNestedVariables.Set(
 			enumCase.Name(),
@@ -19,6 +19,7 @@
 package stdlib
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -26,6 +27,7 @@ import (
 
 	"github.com/onflow/cadence/runtime/ast"
 	"github.com/onflow/cadence/runtime/interpreter"
+	"github.com/onflow/cadence/runtime/parser2"
 	"github.com/onflow/cadence/runtime/sema"
 	"github.com/onflow/cadence/runtime/tests/utils"
 )
@@ -117,3 +119,327 @@ func TestPanic(t *testing.T) {
 		err,
 	)
 }
+
+func TestHashWithKey(t *testing.T) {
+
+	t.Parallel()
+
+	checker, err := sema.NewChecker(
+		&ast.Program{},
+		utils.TestLocation,
+		sema.WithPredeclaredValues(BuiltinFunctions.ToSemaValueDeclarations()),
+	)
+	require.Nil(t, err)
+
+	inter, err := interpreter.NewInterpreter(
+		interpreter.ProgramFromChecker(checker),
+		checker.Location,
+		interpreter.WithPredeclaredValues(BuiltinFunctions.ToInterpreterValueDeclarations()),
+	)
+	require.Nil(t, err)
+
+	data := []byte{1, 2, 3}
+	key := []byte{4, 5}
+	customization := []byte{6}
+
+	result, err := inter.Invoke(
+		"hashWithKey",
+		interpreter.ByteSliceToByteArrayValue(data),
+		interpreter.ByteSliceToByteArrayValue(key),
+		interpreter.ByteSliceToByteArrayValue(customization),
+		interpreter.NewIntValueFromInt64(16),
+	)
+	require.NoError(t, err)
+
+	assert.Equal(t,
+		interpreter.ByteSliceToByteArrayValue(kmac128(key, data, customization, 16)),
+		result,
+	)
+
+	_, err = inter.Invoke(
+		"hashWithKey",
+		interpreter.ByteSliceToByteArrayValue(data),
+		interpreter.ByteSliceToByteArrayValue(key),
+		interpreter.ByteSliceToByteArrayValue(customization),
+		interpreter.NewIntValueFromInt64(-1),
+	)
+	require.Error(t, err)
+	require.ErrorAs(t, err, &InvalidHashLengthError{})
+}
+
+func TestEncodeHex(t *testing.T) {
+
+	t.Parallel()
+
+	program, err := parser2.ParseProgram(`
+      pub fun roundTrip(_ string: String): String {
+          return encodeHex(string.decodeHex())
+      }
+    `)
+	require.NoError(t, err)
+
+	checker, err := sema.NewChecker(
+		program,
+		utils.TestLocation,
+		sema.WithPredeclaredValues(BuiltinFunctions.ToSemaValueDeclarations()),
+	)
+	require.Nil(t, err)
+
+	err = checker.Check()
+	require.NoError(t, err)
+
+	inter, err := interpreter.NewInterpreter(
+		interpreter.ProgramFromChecker(checker),
+		checker.Location,
+		interpreter.WithPredeclaredValues(BuiltinFunctions.ToInterpreterValueDeclarations()),
+	)
+	require.Nil(t, err)
+
+	err = inter.Interpret()
+	require.NoError(t, err)
+
+	type test struct {
+		data    []byte
+		encoded string
+	}
+
+	tests := []test{
+		{[]byte{}, ""},
+		{[]byte{0x0}, "00"},
+		{[]byte{0x1, 0x23, 0x45, 0x67, 0x89, 0xab, 0xcd, 0xef}, "0123456789abcdef"},
+		{[]byte("Cadence!"), "436164656e636521"},
+		{[]byte{0xff, 0xfe, 0x0}, "fffe00"},
+	}
+
+	for _, test := range tests {
+
+		result, err := inter.Invoke(
+			"encodeHex",
+			interpreter.ByteSliceToByteArrayValue(test.data),
+		)
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			interpreter.NewStringValue(test.encoded),
+			result,
+		)
+
+		result, err = inter.Invoke(
+			"roundTrip",
+			interpreter.NewStringValue(test.encoded),
+		)
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			interpreter.NewStringValue(test.encoded),
+			result,
+		)
+	}
+
+	// Upper-case hexadecimal strings are decoded,
+	// but encoding always produces lower-case hexadecimal strings
+
+	result, err := inter.Invoke(
+		"roundTrip",
+		interpreter.NewStringValue("ABCDEF"),
+	)
+	require.NoError(t, err)
+
+	assert.Equal(t,
+		interpreter.NewStringValue("abcdef"),
+		result,
+	)
+}
+
+func TestPRNG(t *testing.T) {
+
+	t.Parallel()
+
+	program, err := parser2.ParseProgram(`
+      pub fun generate(seed: UInt64, count: Int): [UInt64] {
+          let prng: PRNG = PRNG(seed: seed)
+          let numbers: [UInt64] = []
+          var i = 0
+          while i < count {
+              numbers.append(prng.next())
+              i = i + 1
+          }
+          return numbers
+      }
+
+      pub fun copy(seed: UInt64): [UInt64] {
+          let prng = PRNG(seed: seed)
+          prng.next()
+          let copy = prng
+          return [prng.next(), copy.next()]
+      }
+    `)
+	require.NoError(t, err)
+
+	checker, err := sema.NewChecker(
+		program,
+		utils.TestLocation,
+		sema.WithPredeclaredValues(BuiltinFunctions.ToSemaValueDeclarations()),
+	)
+	require.Nil(t, err)
+
+	err = checker.Check()
+	require.NoError(t, err)
+
+	inter, err := interpreter.NewInterpreter(
+		interpreter.ProgramFromChecker(checker),
+		checker.Location,
+		interpreter.WithPredeclaredValues(BuiltinFunctions.ToInterpreterValueDeclarations()),
+	)
+	require.Nil(t, err)
+
+	err = inter.Interpret()
+	require.NoError(t, err)
+
+	newUInt64Array := func(values ...uint64) *interpreter.ArrayValue {
+		elements := make([]interpreter.Value, len(values))
+		for i, value := range values {
+			elements[i] = interpreter.UInt64Value(value)
+		}
+		return interpreter.NewArrayValueUnownedNonCopying(elements...)
+	}
+
+	t.Run("golden vectors", func(t *testing.T) {
+
+		// Reference values of the SplitMix64 algorithm
+
+		for seed, expected := range map[uint64][]uint64{
+			0: {
+				16294208416658607535,
+				7960286522194355700,
+				487617019471545679,
+			},
+			1234567: {
+				6457827717110365317,
+				3203168211198807973,
+				9817491932198370423,
+				4593380528125082431,
+				16408922859458223821,
+			},
+		} {
+			result, err := inter.Invoke(
+				"generate",
+				interpreter.UInt64Value(seed),
+				interpreter.NewIntValueFromInt64(int64(len(expected))),
+			)
+			require.NoError(t, err)
+
+			expectedArray := newUInt64Array(expected...)
+			expectedArray.SetModified(false)
+			result.SetModified(false)
+
+			assert.Equal(t, expectedArray, result)
+		}
+	})
+
+	t.Run("copy", func(t *testing.T) {
+
+		// Copies of a generator continue the same stream independently
+
+		result, err := inter.Invoke("copy", interpreter.UInt64Value(1234567))
+		require.NoError(t, err)
+
+		expectedArray := newUInt64Array(3203168211198807973, 3203168211198807973)
+		expectedArray.SetModified(false)
+		result.SetModified(false)
+
+		assert.Equal(t, expectedArray, result)
+	})
+}
+
+type testBuiltinEnumCase struct {
+	name     string
+	rawValue uint64
+}
+
+func (c testBuiltinEnumCase) EnumCaseRawValue() uint64 {
+	return c.rawValue
+}
+
+func (c testBuiltinEnumCase) Name() string {
+	return c.name
+}
+
+func (c testBuiltinEnumCase) DocString() string {
+	return ""
+}
+
+func TestBuiltinEnumRawValueLookup(t *testing.T) {
+
+	t.Parallel()
+
+	// More cases than fit into a UInt8 raw value
+
+	const caseCount = 300
+
+	enumCases := make([]sema.BuiltinEnumCase, caseCount)
+	for i := range enumCases {
+		enumCases[i] = testBuiltinEnumCase{
+			name:     fmt.Sprintf("c%d", i),
+			rawValue: uint64(i),
+		}
+	}
+
+	enumType, err := sema.NewBuiltinEnumType("E", &sema.UInt16Type{}, enumCases)
+	require.NoError(t, err)
+
+	values := StandardLibraryValues{
+		NewBuiltinEnumValue(enumType),
+	}
+
+	program, err := parser2.ParseProgram(`
+      pub fun lookup(_ rawValue: UInt16): UInt16? {
+          return E(rawValue: rawValue)?.rawValue
+      }
+
+      pub fun member(): UInt16 {
+          return E.c299.rawValue
+      }
+    `)
+	require.NoError(t, err)
+
+	checker, err := sema.NewChecker(
+		program,
+		utils.TestLocation,
+		sema.WithPredeclaredValues(values.ToSemaValueDeclarations()),
+	)
+	require.Nil(t, err)
+
+	err = checker.Check()
+	require.NoError(t, err)
+
+	inter, err := interpreter.NewInterpreter(
+		interpreter.ProgramFromChecker(checker),
+		checker.Location,
+		interpreter.WithPredeclaredValues(values.ToInterpreterValueDeclarations()),
+	)
+	require.Nil(t, err)
+
+	err = inter.Interpret()
+	require.NoError(t, err)
+
+	for _, enumCase := range enumCases {
+		rawValue := interpreter.UInt16Value(enumCase.EnumCaseRawValue())
+
+		result, err := inter.Invoke("lookup", rawValue)
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			interpreter.NewSomeValueOwningNonCopying(rawValue),
+			result,
+		)
+	}
+
+	result, err := inter.Invoke("lookup", interpreter.UInt16Value(caseCount))
+	require.NoError(t, err)
+	assert.Equal(t, interpreter.NilValue{}, result)
+
+	result, err = inter.Invoke("member")
+	require.NoError(t, err)
+	assert.Equal(t, interpreter.UInt16Value(299), result)
+}
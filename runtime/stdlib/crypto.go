@@ -127,6 +127,8 @@ func newCryptoContractVerifySignatureFunction(signatureVerifier CryptoSignatureV
 
 			hashAlgorithm := getHashAlgorithmFromValue(invocation.Arguments[5])
 
+			invocation.Interpreter.ReportSignatureVerification(signatureAlgorithm)
+
 			isValid, err := signatureVerifier.VerifySignature(signature,
 				tag,
 				signedData,
@@ -173,6 +175,8 @@ func newCryptoContractHashFunction(hasher CryptoHasher) interpreter.FunctionValu
 
 			hashAlgorithm := getHashAlgorithmFromValue(invocation.Arguments[1])
 
+			invocation.Interpreter.MeterComputation(interpreter.ComputationKindHashing)
+
 			digest, err := hasher.Hash(data, hashAlgorithm)
 			if err != nil {
 				panic(err)
@@ -21,11 +21,136 @@ package stdlib
 import (
 	"testing"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/onflow/cadence/runtime/ast"
+	"github.com/onflow/cadence/runtime/interpreter"
 	"github.com/onflow/cadence/runtime/sema"
+	"github.com/onflow/cadence/runtime/tests/utils"
 )
 
 func TestCryptoContract(t *testing.T) {
 	require.IsType(t, &sema.Checker{}, CryptoChecker)
 }
+
+func TestKMAC128(t *testing.T) {
+
+	t.Parallel()
+
+	// Test vectors from NIST SP 800-185, KMAC samples #1 and #2
+
+	key := make([]byte, 32)
+	for i := range key {
+		key[i] = byte(0x40 + i)
+	}
+
+	data := []byte{0x00, 0x01, 0x02, 0x03}
+
+	t.Run("without customization", func(t *testing.T) {
+
+		t.Parallel()
+
+		require.Equal(t,
+			[]byte{
+				0xE5, 0x78, 0x0B, 0x0D, 0x3E, 0xA6, 0xF7, 0xD3,
+				0xA4, 0x29, 0xC5, 0x70, 0x6A, 0xA4, 0x3A, 0x00,
+				0xFA, 0xDB, 0xD7, 0xD4, 0x96, 0x28, 0x83, 0x9E,
+				0x31, 0x87, 0x24, 0x3F, 0x45, 0x6E, 0xE1, 0x4E,
+			},
+			kmac128(key, data, nil, 32),
+		)
+	})
+
+	t.Run("with customization", func(t *testing.T) {
+
+		t.Parallel()
+
+		require.Equal(t,
+			[]byte{
+				0x3B, 0x1F, 0xBA, 0x96, 0x3C, 0xD8, 0xB0, 0xB5,
+				0x9E, 0x8C, 0x1A, 0x6D, 0x71, 0x88, 0x8B, 0x71,
+				0x43, 0x65, 0x1A, 0xF8, 0xBA, 0x0A, 0x70, 0x70,
+				0xC0, 0x97, 0x9E, 0x28, 0x11, 0x32, 0x4A, 0xA5,
+			},
+			kmac128(key, data, []byte("My Tagged Application"), 32),
+		)
+	})
+}
+
+type testSignatureVerifier struct{}
+
+func (testSignatureVerifier) VerifySignature(
+	_ []byte,
+	_ string,
+	_ []byte,
+	_ []byte,
+	_ SignatureAlgorithm,
+	_ HashAlgorithm,
+) (bool, error) {
+	return true, nil
+}
+
+func TestCryptoVerificationStats(t *testing.T) {
+
+	t.Parallel()
+
+	checker, err := sema.NewChecker(
+		&ast.Program{},
+		utils.TestLocation,
+		sema.WithPredeclaredValues(BuiltinFunctions.ToSemaValueDeclarations()),
+	)
+	require.Nil(t, err)
+
+	inter, err := interpreter.NewInterpreter(
+		interpreter.ProgramFromChecker(checker),
+		checker.Location,
+		interpreter.WithPredeclaredValues(BuiltinFunctions.ToInterpreterValueDeclarations()),
+	)
+	require.Nil(t, err)
+
+	verify := newCryptoContractVerifySignatureFunction(testSignatureVerifier{})
+
+	invokeVerify := func(signatureAlgorithm SignatureAlgorithm) {
+		result := verify.Invoke(interpreter.Invocation{
+			Arguments: []interpreter.Value{
+				interpreter.ByteSliceToByteArrayValue([]byte{1}),
+				interpreter.NewStringValue("tag"),
+				interpreter.ByteSliceToByteArrayValue([]byte{2}),
+				interpreter.ByteSliceToByteArrayValue([]byte{3}),
+				interpreter.NewCryptoAlgorithmEnumCaseValue(
+					sema.SignatureAlgorithmType,
+					signatureAlgorithm.RawValue(),
+				),
+				interpreter.NewCryptoAlgorithmEnumCaseValue(
+					sema.HashAlgorithmType,
+					sema.HashAlgorithmSHA3_256.RawValue(),
+				),
+			},
+			GetLocationRange: interpreter.ReturnEmptyLocationRange,
+			Interpreter:      inter,
+		})
+		require.Equal(t, interpreter.BoolValue(true), result)
+	}
+
+	assert.Empty(t, inter.VerificationStats())
+
+	invokeVerify(sema.SignatureAlgorithmECDSA_P256)
+	invokeVerify(sema.SignatureAlgorithmECDSA_P256)
+	invokeVerify(sema.SignatureAlgorithmECDSA_Secp256k1)
+
+	assert.Equal(t,
+		map[SignatureAlgorithm]uint64{
+			sema.SignatureAlgorithmECDSA_P256:      2,
+			sema.SignatureAlgorithmECDSA_Secp256k1: 1,
+		},
+		inter.VerificationStats(),
+	)
+
+	// A new top-level execution resets the stats
+
+	_, err = inter.Invoke("assert", interpreter.BoolValue(true))
+	require.NoError(t, err)
+
+	assert.Empty(t, inter.VerificationStats())
+}
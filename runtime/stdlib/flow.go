@@ -21,7 +21,6 @@ package stdlib
 import (
 	"encoding/json"
 	"fmt"
-	"math/rand"
 	"strings"
 
 	"github.com/onflow/cadence/runtime/common"
@@ -100,21 +99,65 @@ var getBlockFunctionType = &sema.FunctionType{
 	),
 }
 
+const unsafeRandomFunctionDocString = `
+Returns a pseudo-random number.
+
+NOTE: The use of this function is unsafe if not used correctly.
+The returned number is not cryptographically secure and may be predicted or influenced,
+so it must not be used for security-relevant purposes, e.g. lotteries or key generation.
+It is only suitable for non-security uses, e.g. jitter or sampling.
+`
+
 var unsafeRandomFunctionType = &sema.FunctionType{
 	ReturnTypeAnnotation: sema.NewTypeAnnotation(
 		&sema.UInt64Type{},
 	),
 }
 
+var recoverECDSAPublicKeyFunctionType = &sema.FunctionType{
+	Parameters: []*sema.Parameter{
+		{
+			Identifier: "signature",
+			TypeAnnotation: sema.NewTypeAnnotation(
+				&sema.VariableSizedType{Type: &sema.UInt8Type{}},
+			),
+		},
+		{
+			Identifier: "message",
+			TypeAnnotation: sema.NewTypeAnnotation(
+				&sema.VariableSizedType{Type: &sema.UInt8Type{}},
+			),
+		},
+		{
+			Identifier: "hashAlgorithm",
+			TypeAnnotation: sema.NewTypeAnnotation(
+				sema.HashAlgorithmType,
+			),
+		},
+		{
+			Identifier: "signatureAlgorithm",
+			TypeAnnotation: sema.NewTypeAnnotation(
+				sema.SignatureAlgorithmType,
+			),
+		},
+	},
+	ReturnTypeAnnotation: sema.NewTypeAnnotation(
+		&sema.OptionalType{
+			Type: sema.PublicKeyType,
+		},
+	),
+}
+
 // FlowBuiltinImpls defines the set of functions needed to implement the Flow
 // built-in functions.
 type FlowBuiltinImpls struct {
-	CreateAccount   interpreter.HostFunction
-	GetAccount      interpreter.HostFunction
-	Log             interpreter.HostFunction
-	GetCurrentBlock interpreter.HostFunction
-	GetBlock        interpreter.HostFunction
-	UnsafeRandom    interpreter.HostFunction
+	CreateAccount         interpreter.HostFunction
+	GetAccount            interpreter.HostFunction
+	Log                   interpreter.HostFunction
+	GetCurrentBlock       interpreter.HostFunction
+	GetBlock              interpreter.HostFunction
+	UnsafeRandom          interpreter.HostFunction
+	RecoverECDSAPublicKey interpreter.HostFunction
 }
 
 // FlowBuiltInFunctions returns a list of standard library functions, bound to
@@ -150,6 +193,11 @@ func FlowBuiltInFunctions(impls FlowBuiltinImpls) StandardLibraryFunctions {
 			"unsafeRandom",
 			unsafeRandomFunctionType,
 			impls.UnsafeRandom,
+		).WithDocString(unsafeRandomFunctionDocString),
+		NewStandardLibraryFunction(
+			"recoverECDSAPublicKey",
+			recoverECDSAPublicKeyFunctionType,
+			impls.RecoverECDSAPublicKey,
 		),
 	}
 }
@@ -170,7 +218,10 @@ func DefaultFlowBuiltinImpls() FlowBuiltinImpls {
 			panic(fmt.Errorf("cannot get blocks"))
 		},
 		UnsafeRandom: func(invocation interpreter.Invocation) interpreter.Value {
-			return interpreter.UInt64Value(rand.Uint64())
+			return invocation.Interpreter.UnsafeRandom(invocation.GetLocationRange)
+		},
+		RecoverECDSAPublicKey: func(invocation interpreter.Invocation) interpreter.Value {
+			panic(fmt.Errorf("cannot recover public keys"))
 		},
 	}
 }
@@ -26,7 +26,10 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/onflow/cadence/runtime/ast"
+	"github.com/onflow/cadence/runtime/interpreter"
 	"github.com/onflow/cadence/runtime/sema"
+	"github.com/onflow/cadence/runtime/tests/utils"
 )
 
 func TestFlowEventTypeIDs(t *testing.T) {
@@ -117,3 +120,59 @@ func TestDecodeFlowLocationTypeID(t *testing.T) {
 		assert.Equal(t, "T.U", qualifiedIdentifier)
 	})
 }
+
+func TestUnsafeRandom(t *testing.T) {
+
+	t.Parallel()
+
+	functions := FlowBuiltInFunctions(DefaultFlowBuiltinImpls())
+
+	checker, err := sema.NewChecker(
+		&ast.Program{},
+		utils.TestLocation,
+		sema.WithPredeclaredValues(functions.ToSemaValueDeclarations()),
+	)
+	require.NoError(t, err)
+
+	newInterpreter := func(options ...interpreter.Option) *interpreter.Interpreter {
+		inter, err := interpreter.NewInterpreter(
+			interpreter.ProgramFromChecker(checker),
+			checker.Location,
+			append(
+				[]interpreter.Option{
+					interpreter.WithPredeclaredValues(functions.ToInterpreterValueDeclarations()),
+				},
+				options...,
+			)...,
+		)
+		require.NoError(t, err)
+
+		return inter
+	}
+
+	t.Run("random source", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := newInterpreter(
+			interpreter.WithRandomSource(func() uint64 {
+				return 42
+			}),
+		)
+
+		value, err := inter.Invoke("unsafeRandom")
+		require.NoError(t, err)
+
+		assert.Equal(t, interpreter.UInt64Value(42), value)
+	})
+
+	t.Run("no random source", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := newInterpreter()
+
+		_, err := inter.Invoke("unsafeRandom")
+		require.ErrorAs(t, err, &interpreter.RandomSourceUnavailableError{})
+	})
+}
@@ -35,6 +35,8 @@ type StandardLibraryFunction struct {
 	Function       interpreter.HostFunctionValue
 	ArgumentLabels []string
 	Available      func(common.Location) bool
+	// DocString is the documentation of the function, e.g. shown by IDEs
+	DocString string
 }
 
 func (f StandardLibraryFunction) ValueDeclarationName() string {
@@ -95,6 +97,13 @@ func NewStandardLibraryFunction(
 	}
 }
 
+// WithDocString returns a copy of the function with the given documentation.
+//
+func (f StandardLibraryFunction) WithDocString(docString string) StandardLibraryFunction {
+	f.DocString = docString
+	return f
+}
+
 // StandardLibraryFunctions
 
 type StandardLibraryFunctions []StandardLibraryFunction
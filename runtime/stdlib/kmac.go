@@ -0,0 +1,181 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2020 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package stdlib
+
+import (
+	"fmt"
+
+	"golang.org/x/crypto/sha3"
+
+	"github.com/onflow/cadence/runtime/interpreter"
+	"github.com/onflow/cadence/runtime/sema"
+)
+
+// HashWithKeyFunction computes the KMAC128 keyed hash (NIST SP 800-185)
+// of the given data, using the given key and customization string.
+//
+var HashWithKeyFunction = NewStandardLibraryFunction(
+	"hashWithKey",
+	&sema.FunctionType{
+		Parameters: []*sema.Parameter{
+			{
+				Identifier:     "data",
+				TypeAnnotation: sema.NewTypeAnnotation(&sema.VariableSizedType{Type: &sema.UInt8Type{}}),
+			},
+			{
+				Identifier:     "key",
+				TypeAnnotation: sema.NewTypeAnnotation(&sema.VariableSizedType{Type: &sema.UInt8Type{}}),
+			},
+			{
+				Identifier:     "customization",
+				TypeAnnotation: sema.NewTypeAnnotation(&sema.VariableSizedType{Type: &sema.UInt8Type{}}),
+			},
+			{
+				Identifier:     "length",
+				TypeAnnotation: sema.NewTypeAnnotation(&sema.IntType{}),
+			},
+		},
+		ReturnTypeAnnotation: sema.NewTypeAnnotation(
+			&sema.VariableSizedType{Type: &sema.UInt8Type{}},
+		),
+	},
+	func(invocation interpreter.Invocation) interpreter.Value {
+		data, err := interpreter.ByteArrayValueToByteSlice(invocation.Arguments[0])
+		if err != nil {
+			panic(fmt.Errorf("hashWithKey: invalid data argument: %w", err))
+		}
+
+		key, err := interpreter.ByteArrayValueToByteSlice(invocation.Arguments[1])
+		if err != nil {
+			panic(fmt.Errorf("hashWithKey: invalid key argument: %w", err))
+		}
+
+		customization, err := interpreter.ByteArrayValueToByteSlice(invocation.Arguments[2])
+		if err != nil {
+			panic(fmt.Errorf("hashWithKey: invalid customization argument: %w", err))
+		}
+
+		length := invocation.Arguments[3].(interpreter.IntValue)
+		if !length.BigInt.IsInt64() ||
+			length.BigInt.Sign() < 0 ||
+			length.BigInt.Int64() > maxKMACLength {
+
+			panic(InvalidHashLengthError{
+				Length:        length,
+				MaxLength:     maxKMACLength,
+				LocationRange: invocation.GetLocationRange(),
+			})
+		}
+
+		invocation.Interpreter.MeterComputation(interpreter.ComputationKindHashing)
+
+		digest := kmac128(key, data, customization, int(length.BigInt.Int64()))
+
+		return interpreter.ByteSliceToByteArrayValue(digest)
+	},
+)
+
+// InvalidHashLengthError
+
+type InvalidHashLengthError struct {
+	Length    interpreter.IntValue
+	MaxLength int
+	interpreter.LocationRange
+}
+
+func (e InvalidHashLengthError) Error() string {
+	return fmt.Sprintf(
+		"invalid hash length: must be between 0 and %d, got %s",
+		e.MaxLength,
+		e.Length,
+	)
+}
+
+// maxKMACLength is the maximum length of a KMAC128 digest, in bytes
+//
+const maxKMACLength = 1 << 16
+
+// kmac128 computes the KMAC128 keyed hash of the data (NIST SP 800-185, section 4),
+// with an output length of the given number of bytes.
+//
+func kmac128(key, data, customization []byte, length int) []byte {
+	// KMAC128 uses a rate of 168 bytes
+	const rate = 168
+
+	hash := sha3.NewCShake128([]byte("KMAC"), customization)
+
+	// newX = bytepad(encode_string(K), 168) || X || right_encode(L)
+
+	_, _ = hash.Write(bytepad(encodeString(key), rate))
+	_, _ = hash.Write(data)
+	_, _ = hash.Write(rightEncode(uint64(length) * 8))
+
+	digest := make([]byte, length)
+	_, _ = hash.Read(digest)
+	return digest
+}
+
+// leftEncode encodes the given value as a byte string,
+// prefixed with the number of bytes (NIST SP 800-185, section 2.3.1)
+//
+func leftEncode(value uint64) []byte {
+	encoded := encodeUint64(value)
+	return append([]byte{byte(len(encoded))}, encoded...)
+}
+
+// rightEncode encodes the given value as a byte string,
+// suffixed with the number of bytes (NIST SP 800-185, section 2.3.1)
+//
+func rightEncode(value uint64) []byte {
+	encoded := encodeUint64(value)
+	return append(encoded, byte(len(encoded)))
+}
+
+// encodeUint64 returns the minimal big-endian encoding of the given value,
+// which is at least one byte long
+//
+func encodeUint64(value uint64) []byte {
+	var encoded []byte
+	for value > 0 {
+		encoded = append([]byte{byte(value)}, encoded...)
+		value >>= 8
+	}
+	if len(encoded) == 0 {
+		encoded = []byte{0}
+	}
+	return encoded
+}
+
+// encodeString encodes the given byte string,
+// prefixed with its length in bits (NIST SP 800-185, section 2.3.2)
+//
+func encodeString(value []byte) []byte {
+	return append(leftEncode(uint64(len(value))*8), value...)
+}
+
+// bytepad prefixes the given byte string with the encoded width,
+// and pads it with zeros to a multiple of the width (NIST SP 800-185, section 2.3.3)
+//
+func bytepad(value []byte, width int) []byte {
+	padded := append(leftEncode(uint64(width)), value...)
+	if remainder := len(padded) % width; remainder != 0 {
+		padded = append(padded, make([]byte, width-remainder)...)
+	}
+	return padded
+}
@@ -1312,3 +1312,110 @@ func TestAuthAccountContractsType(t *testing.T) {
 
 	require.NoError(t, err)
 }
+
+func TestCheckPublicAuthAccountDisallowed(t *testing.T) {
+
+	t.Parallel()
+
+	check := func(code string) error {
+		_, err := ParseAndCheckWithOptions(t,
+			code,
+			ParseAndCheckOptions{
+				Options: []sema.Option{
+					sema.WithPublicAuthAccountDisallowed(true),
+				},
+			},
+		)
+		return err
+	}
+
+	t.Run("allowed by default", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          pub fun test(account: AuthAccount) {}
+        `)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("parameter", func(t *testing.T) {
+
+		t.Parallel()
+
+		err := check(`
+          pub fun test(account: AuthAccount) {}
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		require.IsType(t, &sema.LeakedAuthAccountError{}, errs[0])
+
+		leakedErr := errs[0].(*sema.LeakedAuthAccountError)
+		assert.Equal(t, sema.AuthAccountType, leakedErr.Type)
+		assert.Equal(t, 2, leakedErr.StartPos.Line)
+		assert.Equal(t, 32, leakedErr.StartPos.Column)
+	})
+
+	t.Run("return type", func(t *testing.T) {
+
+		t.Parallel()
+
+		err := check(`
+          struct S {
+              pub fun test(): AuthAccount? {
+                  return nil
+              }
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		require.IsType(t, &sema.LeakedAuthAccountError{}, errs[0])
+	})
+
+	for _, ty := range []string{
+		"AuthAccount?",
+		"[AuthAccount]",
+		"[AuthAccount; 2]",
+		"{String: AuthAccount}",
+		"&AuthAccount",
+		"[{String: AuthAccount?}]",
+	} {
+		ty := ty
+
+		t.Run(ty, func(t *testing.T) {
+
+			t.Parallel()
+
+			err := check(fmt.Sprintf(
+				`
+                  resource interface RI {
+                      pub fun test(account: %s)
+                  }
+                `,
+				ty,
+			))
+
+			errs := ExpectCheckerErrors(t, err, 1)
+
+			require.IsType(t, &sema.LeakedAuthAccountError{}, errs[0])
+		})
+	}
+
+	t.Run("non-public", func(t *testing.T) {
+
+		t.Parallel()
+
+		err := check(`
+          access(contract) fun a(account: AuthAccount) {}
+          priv fun b(): AuthAccount? { return nil }
+          fun c(account: AuthAccount) {}
+
+          pub fun d(account: PublicAccount): Int { return 1 }
+        `)
+
+		require.NoError(t, err)
+	})
+}
@@ -437,6 +437,54 @@ func TestCheckArrayConcatBound(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestCheckArrayAppendAll(t *testing.T) {
+
+	t.Parallel()
+
+	_, err := ParseAndCheck(t, `
+      fun test(): [Int] {
+          let a = [1, 2]
+          a.appendAll([3, 4])
+          return a
+      }
+    `)
+
+	require.NoError(t, err)
+}
+
+func TestCheckInvalidArrayAppendAll(t *testing.T) {
+
+	t.Parallel()
+
+	_, err := ParseAndCheck(t, `
+      fun test(): [Int] {
+          let a = [1, 2]
+          a.appendAll(["a", "b"])
+          return a
+      }
+    `)
+
+	errs := ExpectCheckerErrors(t, err, 1)
+
+	assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
+}
+
+func TestCheckInvalidArrayAppendAllOfConstantSized(t *testing.T) {
+
+	t.Parallel()
+
+	_, err := ParseAndCheck(t, `
+      fun test() {
+          let a: [Int; 2] = [1, 2]
+          a.appendAll([3, 4])
+      }
+    `)
+
+	errs := ExpectCheckerErrors(t, err, 1)
+
+	assert.IsType(t, &sema.NotDeclaredMemberError{}, errs[0])
+}
+
 func TestCheckArrayInsert(t *testing.T) {
 
 	t.Parallel()
@@ -662,6 +710,165 @@ func TestCheckInvalidArrayContainsNotEquatable(t *testing.T) {
 	assert.IsType(t, &sema.NotEquatableTypeError{}, errs[0])
 }
 
+func TestCheckArrayReverse(t *testing.T) {
+
+	t.Parallel()
+
+	checker, err := ParseAndCheck(t, `
+      let x = [1, 2, 3]
+      let y = x.reverse()
+      let z = ["a", "b"].reverse()
+    `)
+
+	require.NoError(t, err)
+
+	assert.Equal(t,
+		&sema.VariableSizedType{Type: &sema.IntType{}},
+		RequireGlobalValue(t, checker.Elaboration, "y"),
+	)
+	assert.Equal(t,
+		&sema.VariableSizedType{Type: sema.StringType},
+		RequireGlobalValue(t, checker.Elaboration, "z"),
+	)
+}
+
+func TestCheckArraySorted(t *testing.T) {
+
+	t.Parallel()
+
+	checker, err := ParseAndCheck(t, `
+      let x: [Int8; 3] = [Int8(3), Int8(1), Int8(2)]
+      let y = x.sorted()
+      let z = [-1.5, -0.5].sorted()
+    `)
+
+	require.NoError(t, err)
+
+	assert.Equal(t,
+		&sema.VariableSizedType{Type: &sema.Int8Type{}},
+		RequireGlobalValue(t, checker.Elaboration, "y"),
+	)
+	assert.Equal(t,
+		&sema.VariableSizedType{Type: &sema.Fix64Type{}},
+		RequireGlobalValue(t, checker.Elaboration, "z"),
+	)
+}
+
+func TestCheckInvalidArraySortedNotComparable(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("String", func(t *testing.T) {
+
+		_, err := ParseAndCheck(t, `
+          let x = ["b", "a"].sorted()
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.NotComparableTypeError{}, errs[0])
+	})
+
+	t.Run("Integer", func(t *testing.T) {
+
+		_, err := ParseAndCheck(t, `
+          let x: [Integer] = [1, 2]
+          let y = x.sorted()
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.NotComparableTypeError{}, errs[0])
+	})
+}
+
+func TestCheckInvalidResourceArrayReverseSorted(t *testing.T) {
+
+	t.Parallel()
+
+	for _, name := range []string{"reverse", "sorted"} {
+
+		t.Run(name, func(t *testing.T) {
+
+			_, err := ParseAndCheck(t, fmt.Sprintf(
+				`
+                  resource R {}
+
+                  fun test() {
+                      let rs <- [<-create R()]
+                      let f = rs.%s
+                      destroy rs
+                  }
+                `,
+				name,
+			))
+
+			errs := ExpectCheckerErrors(t, err, 2)
+
+			assert.IsType(t, &sema.InvalidResourceArrayMemberError{}, errs[0])
+			assert.IsType(t, &sema.ResourceMethodBindingError{}, errs[1])
+		})
+	}
+}
+
+func TestCheckArraySlice(t *testing.T) {
+
+	t.Parallel()
+
+	checker, err := ParseAndCheck(t, `
+      let x = [1, 2, 3]
+      let y = x.slice(from: 1, upTo: 2)
+      let z: [String; 2] = ["a", "b"]
+      let w = z.slice(from: 0, upTo: 1)
+    `)
+
+	require.NoError(t, err)
+
+	assert.Equal(t,
+		&sema.VariableSizedType{Type: &sema.IntType{}},
+		RequireGlobalValue(t, checker.Elaboration, "y"),
+	)
+	assert.Equal(t,
+		&sema.VariableSizedType{Type: sema.StringType},
+		RequireGlobalValue(t, checker.Elaboration, "w"),
+	)
+}
+
+func TestCheckInvalidArraySlice(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("missing labels", func(t *testing.T) {
+
+		_, err := ParseAndCheck(t, `
+          let x = [1, 2, 3].slice(1, 2)
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 2)
+
+		assert.IsType(t, &sema.MissingArgumentLabelError{}, errs[0])
+		assert.IsType(t, &sema.MissingArgumentLabelError{}, errs[1])
+	})
+
+	t.Run("resource array", func(t *testing.T) {
+
+		_, err := ParseAndCheck(t, `
+          resource R {}
+
+          fun test() {
+              let rs <- [<-create R()]
+              let f = rs.slice
+              destroy rs
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 2)
+
+		assert.IsType(t, &sema.InvalidResourceArrayMemberError{}, errs[0])
+		assert.IsType(t, &sema.ResourceMethodBindingError{}, errs[1])
+	})
+}
+
 func TestCheckEmptyArray(t *testing.T) {
 
 	t.Parallel()
@@ -716,6 +923,98 @@ func TestCheckInvalidDictionaryContainsKey(t *testing.T) {
 	assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
 }
 
+func TestCheckDictionaryMerge(t *testing.T) {
+
+	t.Parallel()
+
+	_, err := ParseAndCheck(t, `
+      fun test(): {Int: String} {
+          let x = {1: "One", 2: "Two"}
+          x.merge({2: "Zwei", 3: "Drei"}, overwrite: false)
+          return x
+      }
+    `)
+
+	require.NoError(t, err)
+}
+
+func TestCheckInvalidDictionaryMerge(t *testing.T) {
+
+	t.Parallel()
+
+	_, err := ParseAndCheck(t, `
+      fun test() {
+          let x = {1: "One", 2: "Two"}
+          x.merge({"3": "Three"}, overwrite: true)
+      }
+    `)
+
+	errs := ExpectCheckerErrors(t, err, 1)
+
+	assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
+}
+
+func TestCheckDictionaryForEachKey(t *testing.T) {
+
+	t.Parallel()
+
+	_, err := ParseAndCheck(t, `
+      resource R {}
+
+      fun isNotTwo(_ key: Int): Bool {
+          return key != 2
+      }
+
+      fun test() {
+          let x = {"abc": 1, "def": 2}
+          x.forEachKey(fun (key: String): Bool {
+              return key == "abc"
+          })
+
+          let rs <- {1: <-create R()}
+          rs.forEachKey(isNotTwo)
+          destroy rs
+      }
+    `)
+
+	require.NoError(t, err)
+}
+
+func TestCheckInvalidDictionaryForEachKey(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("wrong key type", func(t *testing.T) {
+
+		_, err := ParseAndCheck(t, `
+          fun test() {
+              let x = {"abc": 1, "def": 2}
+              x.forEachKey(fun (key: Int): Bool {
+                  return true
+              })
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
+	})
+
+	t.Run("missing result", func(t *testing.T) {
+
+		_, err := ParseAndCheck(t, `
+          fun test() {
+              let x = {"abc": 1, "def": 2}
+              x.forEachKey(fun (key: String) {})
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
+	})
+}
+
 func TestCheckEmptyDictionary(t *testing.T) {
 
 	t.Parallel()
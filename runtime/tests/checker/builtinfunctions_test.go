@@ -19,6 +19,7 @@
 package checker
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -113,3 +114,177 @@ func TestCheckToBigEndianBytes(t *testing.T) {
 		})
 	}
 }
+
+func TestCheckSaturatingArithmeticFunctions(t *testing.T) {
+
+	t.Parallel()
+
+	for _, ty := range sema.AllNumberTypes {
+
+		ty := ty
+
+		for _, function := range []string{
+			sema.SaturatingAddFunctionName,
+			sema.SaturatingSubtractFunctionName,
+			sema.SaturatingMultiplyFunctionName,
+		} {
+
+			function := function
+
+			t.Run(fmt.Sprintf("%s.%s", ty, function), func(t *testing.T) {
+
+				t.Parallel()
+
+				checker, err := parseAndCheckWithTestValue(t,
+					fmt.Sprintf(
+						`
+                          let res = test.%s(test)
+                        `,
+						function,
+					),
+					ty,
+				)
+
+				if !sema.SupportsSaturatingArithmetic(ty) {
+					errs := ExpectCheckerErrors(t, err, 1)
+
+					assert.IsType(t, &sema.NotDeclaredMemberError{}, errs[0])
+
+					return
+				}
+
+				require.NoError(t, err)
+
+				resType := RequireGlobalValue(t, checker.Elaboration, "res")
+
+				assert.Equal(t, ty, resType)
+			})
+		}
+	}
+}
+
+func TestCheckToLittleEndianBytes(t *testing.T) {
+
+	t.Parallel()
+
+	for _, ty := range sema.AllNumberTypes {
+
+		ty := ty
+
+		t.Run(ty.String(), func(t *testing.T) {
+
+			t.Parallel()
+
+			checker, err := parseAndCheckWithTestValue(t,
+				`
+                  let res = test.toLittleEndianBytes()
+                `,
+				ty,
+			)
+
+			if !sema.IsFixedWidthIntegerType(ty) {
+				errs := ExpectCheckerErrors(t, err, 1)
+
+				assert.IsType(t, &sema.NotDeclaredMemberError{}, errs[0])
+
+				return
+			}
+
+			require.NoError(t, err)
+
+			resType := RequireGlobalValue(t, checker.Elaboration, "res")
+
+			assert.Equal(t,
+				&sema.VariableSizedType{
+					Type: &sema.UInt8Type{},
+				},
+				resType,
+			)
+		})
+	}
+}
+
+func TestCheckFromBytes(t *testing.T) {
+
+	t.Parallel()
+
+	for _, ty := range sema.AllNumberTypes {
+
+		switch ty.(type) {
+		case *sema.NumberType, *sema.SignedNumberType,
+			*sema.IntegerType, *sema.SignedIntegerType,
+			*sema.FixedPointType, *sema.SignedFixedPointType:
+			continue
+		}
+
+		ty := ty
+
+		for _, function := range []string{
+			sema.FromBigEndianBytesFunctionName,
+			sema.FromLittleEndianBytesFunctionName,
+		} {
+
+			function := function
+
+			t.Run(fmt.Sprintf("%s.%s", ty, function), func(t *testing.T) {
+
+				t.Parallel()
+
+				checker, err := ParseAndCheck(t,
+					fmt.Sprintf(
+						`
+                          let bytes: [UInt8] = []
+                          let res = %s.%s(bytes)
+                        `,
+						ty,
+						function,
+					),
+				)
+
+				expectDeclared := sema.IsFixedWidthIntegerType(ty)
+
+				switch ty.(type) {
+				case *sema.IntType, *sema.UIntType:
+					expectDeclared = function == sema.FromBigEndianBytesFunctionName
+				}
+
+				if !expectDeclared {
+					errs := ExpectCheckerErrors(t, err, 1)
+
+					assert.IsType(t, &sema.NotDeclaredMemberError{}, errs[0])
+
+					return
+				}
+
+				require.NoError(t, err)
+
+				resType := RequireGlobalValue(t, checker.Elaboration, "res")
+
+				assert.Equal(t,
+					&sema.OptionalType{Type: ty},
+					resType,
+				)
+			})
+		}
+	}
+}
+
+func TestCheckAddressFromBytesAndString(t *testing.T) {
+
+	t.Parallel()
+
+	checker, err := ParseAndCheck(t, `
+      let bytes: [UInt8] = []
+      let fromBytes = Address.fromBytes(bytes)
+      let fromString = Address.fromString("0x0000000000000001")
+    `)
+
+	require.NoError(t, err)
+
+	for _, name := range []string{"fromBytes", "fromString"} {
+		assert.Equal(t,
+			&sema.OptionalType{Type: &sema.AddressType{}},
+			RequireGlobalValue(t, checker.Elaboration, name),
+		)
+	}
+}
@@ -67,9 +67,13 @@ func TestCheckInvalidCompositeRedeclaringType(t *testing.T) {
 				),
 			)
 
-			errs := ExpectCheckerErrors(t, err, 1)
+			// The composite redeclares both the type `String`
+			// and the value `String`, i.e. the string function
+
+			errs := ExpectCheckerErrors(t, err, 2)
 
 			assert.IsType(t, &sema.RedeclarationError{}, errs[0])
+			assert.IsType(t, &sema.RedeclarationError{}, errs[1])
 		})
 	}
 }
@@ -724,6 +728,41 @@ func TestCheckInvalidResourceMissingDestructor(t *testing.T) {
 	assert.IsType(t, &sema.MissingDestructorError{}, errs[0])
 }
 
+func TestCheckInvalidResourceMissingDestructorMultipleFields(t *testing.T) {
+
+	t.Parallel()
+
+	_, err := ParseAndCheck(t, `
+       resource R {}
+
+       resource Test {
+           let a: @R
+           let b: Int
+           let c: @[R]
+           init() {
+               self.a <- create R()
+               self.b = 1
+               self.c <- []
+           }
+       }
+    `)
+
+	errs := ExpectCheckerErrors(t, err, 1)
+
+	require.IsType(t, &sema.MissingDestructorError{}, errs[0])
+
+	missingDestructorErr := errs[0].(*sema.MissingDestructorError)
+
+	assert.Equal(t,
+		[]string{"a", "c"},
+		missingDestructorErr.FieldNames,
+	)
+	assert.Equal(t,
+		"missing destructor for resource fields `a`, `c` in type `Test`",
+		missingDestructorErr.Error(),
+	)
+}
+
 func TestCheckResourceWithDestructor(t *testing.T) {
 
 	t.Parallel()
@@ -2261,3 +2300,254 @@ func TestCheckCompositeFieldOrder(t *testing.T) {
 		test(t, kind)
 	}
 }
+
+func TestCheckRecursiveCompositeType(t *testing.T) {
+
+	t.Parallel()
+
+	parseAndCheck := func(t *testing.T, code string) (*sema.Checker, error) {
+		return ParseAndCheckWithOptions(t,
+			code,
+			ParseAndCheckOptions{
+				Options: []sema.Option{
+					sema.WithRecursiveCompositeTypesReported(true),
+				},
+			},
+		)
+	}
+
+	t.Run("direct", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := parseAndCheck(t, `
+          struct S {
+              let s: S
+
+              init(s: S) {
+                  self.s = s
+              }
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		require.IsType(t, &sema.RecursiveCompositeTypeError{}, errs[0])
+
+		recursiveErr := errs[0].(*sema.RecursiveCompositeTypeError)
+		assert.Equal(t, []string{"S.s"}, recursiveErr.Path)
+		assert.Equal(t, "recursive composite type `S`: S.s -> S", recursiveErr.Error())
+		assert.Equal(t, 3, recursiveErr.StartPos.Line)
+	})
+
+	t.Run("mutual", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := parseAndCheck(t, `
+          struct A {
+              let x: Int
+              let b: B
+
+              init(b: B) {
+                  self.x = 1
+                  self.b = b
+              }
+          }
+
+          struct B {
+              let a: [A; 1]
+
+              init(a: [A; 1]) {
+                  self.a = a
+              }
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 2)
+
+		require.IsType(t, &sema.RecursiveCompositeTypeError{}, errs[0])
+		assert.Equal(t,
+			[]string{"A.b", "B.a"},
+			errs[0].(*sema.RecursiveCompositeTypeError).Path,
+		)
+
+		require.IsType(t, &sema.RecursiveCompositeTypeError{}, errs[1])
+		assert.Equal(t,
+			[]string{"B.a", "A.b"},
+			errs[1].(*sema.RecursiveCompositeTypeError).Path,
+		)
+	})
+
+	for name, fieldType := range map[string]string{
+		"optional":            "S?",
+		"variable-sized":      "[S]",
+		"empty constant-size": "[S; 0]",
+		"dictionary":          "{String: S}",
+	} {
+		fieldType := fieldType
+
+		t.Run(name, func(t *testing.T) {
+
+			t.Parallel()
+
+			_, err := parseAndCheck(t, fmt.Sprintf(
+				`
+                  struct S {
+                      let s: %[1]s
+
+                      init(s: %[1]s) {
+                          self.s = s
+                      }
+                  }
+                `,
+				fieldType,
+			))
+
+			require.NoError(t, err)
+		})
+	}
+
+	t.Run("non-recursive", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := parseAndCheck(t, `
+          struct A {
+              let b1: B
+              let b2: B
+
+              init(b: B) {
+                  self.b1 = b
+                  self.b2 = b
+              }
+          }
+
+          struct B {}
+        `)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("resource", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := parseAndCheck(t, `
+          resource R {
+              let r: @R
+
+              init(r: @R) {
+                  self.r <- r
+              }
+
+              destroy() {
+                  destroy self.r
+              }
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		require.IsType(t, &sema.RecursiveCompositeTypeError{}, errs[0])
+		assert.Equal(t,
+			[]string{"R.r"},
+			errs[0].(*sema.RecursiveCompositeTypeError).Path,
+		)
+	})
+
+	t.Run("not reported", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct S {
+              let s: S
+
+              init(s: S) {
+                  self.s = s
+              }
+          }
+        `)
+
+		require.NoError(t, err)
+	})
+}
+
+func TestCheckMaxCompositeFields(t *testing.T) {
+
+	t.Parallel()
+
+	test := func(code string, maxFields int) error {
+		_, err := ParseAndCheckWithOptions(t,
+			code,
+			ParseAndCheckOptions{
+				Options: []sema.Option{
+					sema.WithMaxCompositeFields(maxFields),
+				},
+			},
+		)
+		return err
+	}
+
+	const structCode = `
+      struct S {
+          let a: Int
+          let b: Int
+          let c: Int
+
+          init() {
+              self.a = 1
+              self.b = 2
+              self.c = 3
+          }
+      }
+    `
+
+	t.Run("unlimited", func(t *testing.T) {
+
+		t.Parallel()
+
+		require.NoError(t, test(structCode, 0))
+	})
+
+	t.Run("within limit", func(t *testing.T) {
+
+		t.Parallel()
+
+		require.NoError(t, test(structCode, 3))
+	})
+
+	t.Run("composite exceeding limit", func(t *testing.T) {
+
+		t.Parallel()
+
+		errs := ExpectCheckerErrors(t, test(structCode, 2), 1)
+
+		require.IsType(t, &sema.TooManyFieldsError{}, errs[0])
+
+		tooManyFieldsErr := errs[0].(*sema.TooManyFieldsError)
+		assert.Equal(t, 3, tooManyFieldsErr.FieldCount)
+		assert.Equal(t, 2, tooManyFieldsErr.MaxFieldCount)
+		assert.Equal(t, 5, tooManyFieldsErr.StartPos.Line)
+	})
+
+	t.Run("interface exceeding limit", func(t *testing.T) {
+
+		t.Parallel()
+
+		err := test(
+			`
+              struct interface I {
+                  let a: Int
+                  let b: Int
+              }
+            `,
+			1,
+		)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.TooManyFieldsError{}, errs[0])
+	})
+}
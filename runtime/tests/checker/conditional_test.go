@@ -101,3 +101,88 @@ func TestCheckInvalidAnyConditional(t *testing.T) {
 
 	assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
 }
+
+func TestCheckDeepTernaryHint(t *testing.T) {
+
+	t.Parallel()
+
+	const code = `
+      let x = 1
+      let y = x == 1 ? "a" : (x == 2 ? "b" : (x == 3 ? "c" : "d"))
+    `
+
+	t.Run("disabled", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, code)
+		require.NoError(t, err)
+
+		assert.Empty(t, checker.Hints())
+	})
+
+	t.Run("deeper than depth", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheckWithOptions(t,
+			code,
+			ParseAndCheckOptions{
+				Options: []sema.Option{
+					sema.WithDeepTernaryHintDepth(1),
+				},
+			},
+		)
+		require.NoError(t, err)
+
+		hints := checker.Hints()
+
+		require.Len(t, hints, 1)
+		require.IsType(t, &sema.DeepTernaryHint{}, hints[0])
+
+		hint := hints[0].(*sema.DeepTernaryHint)
+
+		assert.Equal(t, 3, hint.Depth)
+		assert.Equal(t, 3, hint.StartPos.Line)
+		assert.Equal(t, 14, hint.StartPos.Column)
+		assert.Equal(t, 3, hint.EndPos.Line)
+		assert.Equal(t, 63, hint.EndPos.Column)
+	})
+
+	t.Run("not deeper than depth", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheckWithOptions(t,
+			code,
+			ParseAndCheckOptions{
+				Options: []sema.Option{
+					sema.WithDeepTernaryHintDepth(3),
+				},
+			},
+		)
+		require.NoError(t, err)
+
+		assert.Empty(t, checker.Hints())
+	})
+
+	t.Run("nested in then-branch", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheckWithOptions(t,
+			`
+              let x = 1
+              let y = x == 1 ? (x == 2 ? "a" : "b") : "c"
+            `,
+			ParseAndCheckOptions{
+				Options: []sema.Option{
+					sema.WithDeepTernaryHintDepth(1),
+				},
+			},
+		)
+		require.NoError(t, err)
+
+		assert.Empty(t, checker.Hints())
+	})
+}
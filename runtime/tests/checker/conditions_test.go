@@ -457,3 +457,86 @@ func TestCheckFunctionPostConditionWithMessageUsingParameter(t *testing.T) {
 
 	require.NoError(t, err)
 }
+
+func TestCheckInterfacePostConditionWithBefore(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("function", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          resource interface Vault {
+              balance: Int
+
+              fun withdraw(amount: Int) {
+                  post {
+                      self.balance == before(self.balance) - amount
+                  }
+              }
+          }
+        `)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("destructor", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          resource interface Vault {
+              balance: Int
+
+              destroy() {
+                  post {
+                      before(self.balance) == 0
+                  }
+              }
+          }
+        `)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("initializer, parameter", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          resource interface Vault {
+              balance: Int
+
+              init(balance: Int) {
+                  post {
+                      self.balance == before(balance)
+                  }
+              }
+          }
+        `)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("initializer, field", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          resource interface Vault {
+              balance: Int
+
+              init(balance: Int) {
+                  post {
+                      self.balance == before(self.balance)
+                  }
+              }
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		require.IsType(t, &sema.UninitializedFieldAccessError{}, errs[0])
+	})
+}
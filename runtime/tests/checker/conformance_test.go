@@ -414,3 +414,83 @@ func TestCheckConformanceWithFunctionSubtype(t *testing.T) {
 		require.IsType(t, &sema.ConformanceError{}, errs[0])
 	})
 }
+
+func TestCheckCompositeTypeConformsTo(t *testing.T) {
+
+	t.Parallel()
+
+	checker, err := ParseAndCheck(t, `
+      pub resource interface Provider {
+          pub var balance: UInt64
+          pub fun withdraw(amount: UInt64): @Vault
+          pub fun deposit(from: @Vault)
+      }
+
+      pub resource Vault {
+          pub var balance: UInt64
+
+          init() {
+              self.balance = 0
+          }
+
+          pub fun withdraw(amount: UInt64): @Vault {
+              return <-create Vault()
+          }
+
+          pub fun deposit(from: @Vault) {
+              destroy from
+          }
+      }
+
+      pub resource Other {
+          pub let balance: UInt64
+
+          init() {
+              self.balance = 0
+          }
+
+          pub fun withdraw(value: UInt64): @Vault {
+              return <-create Vault()
+          }
+      }
+    `)
+
+	require.NoError(t, err)
+
+	interfaceType := RequireGlobalType(t, checker.Elaboration, "Provider").(*sema.InterfaceType)
+
+	t.Run("conforming", func(t *testing.T) {
+
+		compositeType := RequireGlobalType(t, checker.Elaboration, "Vault").(*sema.CompositeType)
+
+		conforms, mismatches := compositeType.ConformsTo(interfaceType)
+
+		require.True(t, conforms)
+		require.Empty(t, mismatches)
+	})
+
+	t.Run("not conforming", func(t *testing.T) {
+
+		compositeType := RequireGlobalType(t, checker.Elaboration, "Other").(*sema.CompositeType)
+
+		conforms, mismatches := compositeType.ConformsTo(interfaceType)
+
+		require.False(t, conforms)
+		require.Len(t, mismatches, 3)
+
+		// variable kind mismatch
+
+		require.Equal(t, "balance", mismatches[0].InterfaceMember.Identifier.Identifier)
+		require.NotNil(t, mismatches[0].CompositeMember)
+
+		// argument label mismatch
+
+		require.Equal(t, "withdraw", mismatches[1].InterfaceMember.Identifier.Identifier)
+		require.NotNil(t, mismatches[1].CompositeMember)
+
+		// missing member
+
+		require.Equal(t, "deposit", mismatches[2].InterfaceMember.Identifier.Identifier)
+		require.Nil(t, mismatches[2].CompositeMember)
+	})
+}
@@ -627,3 +627,124 @@ func TestCheckVariableDeclarationTypeAnnotationRequired(t *testing.T) {
 		assert.IsType(t, &sema.TypeAnnotationRequiredError{}, errs[0])
 	})
 }
+
+func TestCheckVariableShadowing(t *testing.T) {
+
+	t.Parallel()
+
+	parseAndCheck := func(t *testing.T, code string) (*sema.Checker, error) {
+		return ParseAndCheckWithOptions(t,
+			code,
+			ParseAndCheckOptions{
+				Options: []sema.Option{
+					sema.WithVariableShadowingReported(true),
+				},
+			},
+		)
+	}
+
+	t.Run("disabled by default", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `
+            fun test() {
+                let x = 1
+                if true {
+                    let x = 2
+                }
+            }
+        `)
+
+		require.NoError(t, err)
+		assert.Empty(t, checker.Warnings())
+	})
+
+	t.Run("nested local", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := parseAndCheck(t, `
+            fun test() {
+                let x = 1
+                if true {
+                    let x = 2
+                }
+            }
+        `)
+
+		// Shadowing is a warning, not an error
+
+		require.NoError(t, err)
+		assert.Empty(t, checker.Errors())
+
+		warnings := checker.Warnings()
+		require.Len(t, warnings, 1)
+		require.IsType(t, &sema.VariableShadowingWarning{}, warnings[0])
+
+		shadowingWarning := warnings[0].(*sema.VariableShadowingWarning)
+		assert.Equal(t, "x", shadowingWarning.Name)
+		assert.Equal(t, 5, shadowingWarning.Pos.Line)
+		assert.Equal(t, 3, shadowingWarning.PreviousPos.Line)
+
+		diagnostics := checker.Diagnostics()
+		require.Len(t, diagnostics, 1)
+		assert.Equal(t, sema.SeverityWarning, diagnostics[0].Severity)
+		assert.Equal(t, shadowingWarning.Warning(), diagnostics[0].Message)
+	})
+
+	t.Run("parameter shadows contract constant", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := parseAndCheck(t, `
+            let limit = 10
+
+            contract C {
+                fun test(limit: Int) {}
+            }
+        `)
+
+		require.NoError(t, err)
+
+		warnings := checker.Warnings()
+		require.Len(t, warnings, 1)
+		require.IsType(t, &sema.VariableShadowingWarning{}, warnings[0])
+
+		shadowingWarning := warnings[0].(*sema.VariableShadowingWarning)
+		assert.Equal(t, common.DeclarationKindParameter, shadowingWarning.Kind)
+		assert.Equal(t, 2, shadowingWarning.PreviousPos.Line)
+	})
+
+	t.Run("sibling scopes", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := parseAndCheck(t, `
+            fun test() {
+                if true {
+                    let x = 1
+                } else {
+                    let x = 2
+                }
+            }
+        `)
+
+		require.NoError(t, err)
+		assert.Empty(t, checker.Warnings())
+	})
+
+	t.Run("built-in value", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := parseAndCheck(t, `
+            fun test() {
+                let panic = 1
+            }
+        `)
+
+		require.NoError(t, err)
+		assert.Empty(t, checker.Warnings())
+	})
+}
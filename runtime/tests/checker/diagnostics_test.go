@@ -0,0 +1,559 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2020 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package checker
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence/runtime/ast"
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/sema"
+)
+
+func TestCheckDiagnostics(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("errors and hints", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `
+          let x: Int = 1
+          let y = x as! Int
+          let z: Bool = 1
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
+
+		assert.Equal(t, errs, checker.Errors())
+		assert.Empty(t, checker.Warnings())
+
+		hints := checker.Hints()
+		require.Len(t, hints, 1)
+		require.IsType(t, &sema.AlwaysSucceedingForceCastHint{}, hints[0])
+
+		diagnostics := checker.Diagnostics()
+
+		assert.Equal(t,
+			[]sema.Diagnostic{
+				{
+					Severity: sema.SeverityHint,
+					Message:  hints[0].Hint(),
+					Range: ast.Range{
+						StartPos: ast.Position{Offset: 44, Line: 3, Column: 18},
+						EndPos:   ast.Position{Offset: 52, Line: 3, Column: 26},
+					},
+				},
+				{
+					Severity: sema.SeverityError,
+					Message:  errs[0].Error(),
+					Range: ast.Range{
+						StartPos: ast.Position{Offset: 78, Line: 4, Column: 24},
+						EndPos:   ast.Position{Offset: 78, Line: 4, Column: 24},
+					},
+				},
+			},
+			diagnostics,
+		)
+	})
+
+	t.Run("unreachable statement and shadowing warnings", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheckWithOptions(t,
+			`
+              fun test(): Int {
+                  let x = 1
+                  if true {
+                      let x = 2
+                  }
+                  return x
+                  let y = 3
+              }
+            `,
+			ParseAndCheckOptions{
+				Options: []sema.Option{
+					sema.WithVariableShadowingReported(true),
+					sema.WithUnreachableStatementReportedAsWarning(true),
+				},
+			},
+		)
+
+		require.NoError(t, err)
+
+		assert.Empty(t, checker.Errors())
+
+		warnings := checker.Warnings()
+		require.Len(t, warnings, 2)
+		require.IsType(t, &sema.VariableShadowingWarning{}, warnings[0])
+		require.IsType(t, &sema.UnreachableStatementWarning{}, warnings[1])
+
+		diagnostics := checker.Diagnostics()
+		require.Len(t, diagnostics, 2)
+
+		for i, diagnostic := range diagnostics {
+			assert.Equal(t, sema.SeverityWarning, diagnostic.Severity)
+			assert.Equal(t, warnings[i].Warning(), diagnostic.Message)
+		}
+	})
+
+	t.Run("no diagnostics", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `
+          let x: Int = 1
+        `)
+
+		require.NoError(t, err)
+
+		assert.Empty(t, checker.Errors())
+		assert.Empty(t, checker.Diagnostics())
+	})
+}
+
+func TestCheckDiagnosticsSuggestedFixes(t *testing.T) {
+
+	t.Parallel()
+
+	// suggestedFixes returns the suggested fixes of the error diagnostics
+	// reported for the given code, and checks that the code with all fixes applied
+	// reports the given number of errors
+
+	suggestedFixes := func(t *testing.T, code string, errorCount int, fixedErrorCount int) []sema.TextEdit {
+
+		checker, err := ParseAndCheck(t, code)
+
+		ExpectCheckerErrors(t, err, errorCount)
+
+		var fixes []sema.TextEdit
+
+		for _, diagnostic := range checker.Diagnostics() {
+			require.Equal(t, sema.SeverityError, diagnostic.Severity)
+			fixes = append(fixes, diagnostic.SuggestedFixes...)
+		}
+
+		// Apply the fixes in reverse order,
+		// so the offsets of the remaining fixes stay valid
+
+		fixedCode := code
+		for i := len(fixes) - 1; i >= 0; i-- {
+			fixedCode = fixes[i].ApplyTo(fixedCode)
+		}
+
+		_, err = ParseAndCheck(t, fixedCode)
+		if fixedErrorCount == 0 {
+			require.NoError(t, err)
+		} else {
+			ExpectCheckerErrors(t, err, fixedErrorCount)
+		}
+
+		return fixes
+	}
+
+	t.Run("missing self prefix", func(t *testing.T) {
+
+		t.Parallel()
+
+		fixes := suggestedFixes(t,
+			`
+              struct S {
+                  let x: Int
+
+                  init() {
+                      self.x = 1
+                  }
+
+                  fun get(): Int {
+                      return x
+                  }
+              }
+            `,
+			1,
+			0,
+		)
+
+		assert.Equal(t,
+			[]sema.TextEdit{
+				{
+					Insertion: "self.",
+					Range: ast.Range{
+						StartPos: ast.Position{Offset: 201, Line: 10, Column: 29},
+						EndPos:   ast.Position{Offset: 201, Line: 10, Column: 29},
+					},
+				},
+			},
+			fixes,
+		)
+	})
+
+	t.Run("undeclared variable, not a member", func(t *testing.T) {
+
+		t.Parallel()
+
+		fixes := suggestedFixes(t,
+			`
+              struct S {
+                  fun get(): Int {
+                      return x
+                  }
+              }
+            `,
+			1,
+			1,
+		)
+
+		assert.Empty(t, fixes)
+	})
+
+	t.Run("missing field initialization", func(t *testing.T) {
+
+		t.Parallel()
+
+		fixes := suggestedFixes(t,
+			`
+              struct S {
+                  let x: Int
+
+                  init(x: Int) {
+                  }
+              }
+            `,
+			1,
+			0,
+		)
+
+		assert.Equal(t,
+			[]sema.TextEdit{
+				{
+					Insertion: "    self.x = x\n                  ",
+					Range: ast.Range{
+						StartPos: ast.Position{Offset: 107, Line: 6, Column: 18},
+						EndPos:   ast.Position{Offset: 107, Line: 6, Column: 18},
+					},
+				},
+			},
+			fixes,
+		)
+	})
+
+	t.Run("missing resource field initialization", func(t *testing.T) {
+
+		t.Parallel()
+
+		fixes := suggestedFixes(t,
+			`
+              resource R {}
+
+              resource S {
+                  let r: @R
+
+                  init(r: @R) {
+                  }
+
+                  destroy() {
+                      destroy self.r
+                  }
+              }
+            `,
+			// the parameter is also lost
+			2,
+			0,
+		)
+
+		require.Len(t, fixes, 1)
+		assert.Equal(t,
+			"    self.r <- r\n                  ",
+			fixes[0].Insertion,
+		)
+	})
+
+	t.Run("missing initialization of multiple fields", func(t *testing.T) {
+
+		t.Parallel()
+
+		// Only the fields which have a parameter with the same name and type
+		// are initialized by the fix, `z` is not
+
+		fixes := suggestedFixes(t,
+			`
+              struct S {
+                  let x: Int
+                  let y: String
+                  let z: Bool
+
+                  init(x: Int, y: String, z: Int) {
+                  }
+              }
+            `,
+			1,
+			1,
+		)
+
+		require.Len(t, fixes, 1)
+		assert.Equal(t,
+			"    self.x = x\n                      self.y = y\n                  ",
+			fixes[0].Insertion,
+		)
+	})
+}
+
+func TestCheckWarningsAsErrors(t *testing.T) {
+
+	t.Parallel()
+
+	const code = `
+      fun test(x: Int, y: Int): Int {
+          return x / y
+      }
+
+      let x: Int = 1
+      let y = x as! Int
+    `
+
+	check := func(warningsAsErrors bool) (*sema.Checker, error) {
+		return ParseAndCheckWithOptions(t,
+			code,
+			ParseAndCheckOptions{
+				Options: []sema.Option{
+					sema.WithPossibleDivisionByZeroReported(true),
+					sema.WithWarningsAsErrors(warningsAsErrors),
+				},
+			},
+		)
+	}
+
+	t.Run("disabled", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := check(false)
+		require.NoError(t, err)
+
+		assert.Empty(t, checker.Errors())
+		assert.Len(t, checker.Warnings(), 1)
+		assert.Len(t, checker.Hints(), 1)
+	})
+
+	t.Run("enabled", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := check(true)
+
+		errs := ExpectCheckerErrors(t, err, 2)
+
+		require.IsType(t, &sema.WarningError{}, errs[0])
+		assert.IsType(t,
+			&sema.PossibleDivisionByZeroError{},
+			errs[0].(*sema.WarningError).Warning,
+		)
+
+		require.IsType(t, &sema.HintError{}, errs[1])
+		assert.IsType(t,
+			&sema.AlwaysSucceedingForceCastHint{},
+			errs[1].(*sema.HintError).Hint,
+		)
+
+		assert.Equal(t, errs, checker.Errors())
+
+		// The severity of the diagnostics is unchanged
+
+		diagnostics := checker.Diagnostics()
+		require.Len(t, diagnostics, 2)
+		assert.Equal(t, sema.SeverityWarning, diagnostics[0].Severity)
+		assert.Equal(t, sema.SeverityHint, diagnostics[1].Severity)
+	})
+
+	t.Run("shadowing", func(t *testing.T) {
+
+		t.Parallel()
+
+		const shadowingCode = `
+          fun test() {
+              let x = 1
+              if true {
+                  let x = 2
+              }
+          }
+        `
+
+		checkShadowing := func(warningsAsErrors bool) (*sema.Checker, error) {
+			return ParseAndCheckWithOptions(t,
+				shadowingCode,
+				ParseAndCheckOptions{
+					Options: []sema.Option{
+						sema.WithVariableShadowingReported(true),
+						sema.WithWarningsAsErrors(warningsAsErrors),
+					},
+				},
+			)
+		}
+
+		// Without the flag, the shadowing warning does not fail checking
+
+		checker, err := checkShadowing(false)
+		require.NoError(t, err)
+		require.Len(t, checker.Warnings(), 1)
+
+		// With the flag, the shadowing warning fails checking
+
+		checker, err = checkShadowing(true)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		require.IsType(t, &sema.WarningError{}, errs[0])
+		assert.IsType(t,
+			&sema.VariableShadowingWarning{},
+			errs[0].(*sema.WarningError).Warning,
+		)
+
+		diagnostics := checker.Diagnostics()
+		require.Len(t, diagnostics, 1)
+		assert.Equal(t, sema.SeverityWarning, diagnostics[0].Severity)
+	})
+}
+
+func TestCheckPublicDocComments(t *testing.T) {
+
+	t.Parallel()
+
+	const code = `
+      /// A token standard
+      pub contract interface Token {
+
+          /// The total supply
+          pub var totalSupply: UFix64
+
+          pub let name: String
+
+          access(all) fun transfer(amount: UFix64)
+
+          /// A vault
+          pub resource Vault {
+              /// The balance
+              pub var balance: UFix64
+
+              /// Withdraws the given amount
+              pub fun withdraw(amount: UFix64)
+          }
+      }
+
+      pub struct S {
+          access(all) let x: Int
+
+          priv let y: Int
+
+          init() {
+              self.x = 1
+              self.y = 2
+          }
+
+          fun test() {}
+      }
+
+      fun test() {}
+    `
+
+	check := func(t *testing.T, options ...sema.Option) (*sema.Checker, error) {
+		return ParseAndCheckWithOptions(t,
+			code,
+			ParseAndCheckOptions{
+				Options: append(
+					[]sema.Option{
+						sema.WithAccessCheckMode(sema.AccessCheckModeNotSpecifiedUnrestricted),
+					},
+					options...,
+				),
+			},
+		)
+	}
+
+	t.Run("disabled", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := check(t)
+		require.NoError(t, err)
+
+		assert.Empty(t, checker.Warnings())
+	})
+
+	t.Run("enabled", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := check(t, sema.WithPublicDocCommentsRequired(true))
+		require.NoError(t, err)
+
+		type missingDocComment struct {
+			kind common.DeclarationKind
+			name string
+		}
+
+		var missing []missingDocComment
+		for _, warning := range checker.Warnings() {
+			require.IsType(t, &sema.MissingDocCommentWarning{}, warning)
+			missingDocCommentWarning := warning.(*sema.MissingDocCommentWarning)
+			missing = append(missing,
+				missingDocComment{
+					kind: missingDocCommentWarning.DeclarationKind,
+					name: missingDocCommentWarning.Name,
+				},
+			)
+		}
+
+		assert.ElementsMatch(t,
+			[]missingDocComment{
+				{common.DeclarationKindField, "name"},
+				{common.DeclarationKindFunction, "transfer"},
+				{common.DeclarationKindStructure, "S"},
+				{common.DeclarationKindField, "x"},
+			},
+			missing,
+		)
+	})
+
+	t.Run("warnings as errors", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := check(t,
+			sema.WithPublicDocCommentsRequired(true),
+			sema.WithWarningsAsErrors(true),
+		)
+
+		errs := ExpectCheckerErrors(t, err, 4)
+
+		for _, err := range errs {
+			require.IsType(t, &sema.WarningError{}, err)
+			assert.IsType(t,
+				&sema.MissingDocCommentWarning{},
+				err.(*sema.WarningError).Warning,
+			)
+		}
+	})
+}
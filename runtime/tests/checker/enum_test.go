@@ -240,3 +240,32 @@ func TestCheckEnumInContract(t *testing.T) {
 
 	require.NoError(t, err)
 }
+
+func TestCheckEnumInContractCases(t *testing.T) {
+
+	t.Parallel()
+
+	checker, err := ParseAndCheck(t, `
+      contract C {
+          enum E: UInt8 {
+              pub case a
+              pub case b
+          }
+      }
+    `)
+
+	require.NoError(t, err)
+
+	contractType := RequireGlobalType(t, checker.Elaboration, "C").(*sema.CompositeType)
+
+	nestedType, ok := contractType.GetNestedTypes().Get("E")
+	require.True(t, ok)
+
+	// The enum constructor is declared both for the contract's members
+	// and for the nested declaration itself, the cases must not be duplicated
+
+	assert.Equal(t,
+		[]string{"a", "b"},
+		nestedType.(*sema.CompositeType).EnumCases,
+	)
+}
@@ -151,6 +151,65 @@ func TestCheckEventDeclaration(t *testing.T) {
 		}
 	})
 
+	t.Run("invalid", func(t *testing.T) {
+
+		t.Parallel()
+
+		invalidTypes := []string{
+			"((): Void)",
+			"&Int",
+			"AnyStruct",
+			"[AnyStruct]",
+			"{String: ((): Void)}",
+		}
+
+		for _, ty := range invalidTypes {
+
+			t.Run(ty, func(t *testing.T) {
+
+				_, err := ParseAndCheck(t,
+					fmt.Sprintf(
+						`
+                          event Transfer(_ value: %s)
+                        `,
+						ty,
+					),
+				)
+
+				errs := ExpectCheckerErrors(t, err, 1)
+
+				assert.IsType(t, &sema.InvalidEventParameterTypeError{}, errs[0])
+			})
+		}
+	})
+
+	t.Run("invalid: nested struct with invalid field", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          event E(outer: Outer)
+
+          struct Outer {
+              let inner: Inner
+              init() {
+                  self.inner = Inner()
+              }
+          }
+
+          struct Inner {
+              let callback: ((): Void)
+              init() {
+                  self.callback = fun () {}
+              }
+          }
+		`)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.InvalidEventParameterTypeError{}, errs[0])
+	})
+
 	t.Run("recursive", func(t *testing.T) {
 
 		t.Parallel()
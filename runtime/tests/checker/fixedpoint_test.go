@@ -669,3 +669,34 @@ func TestCheckFixedPointLiteralScales(t *testing.T) {
 		})
 	}
 }
+
+func TestCheckFixedPointLiteralExponent(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("valid", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          let a: UFix64 = 1.5e3
+          let b: Fix64 = -1.2345678e-1
+          let c: UFix64 = 1.84467440737e11
+        `)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("out of range for type", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          let x: Fix64 = 1.84467440737e11
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.InvalidFixedPointLiteralRangeError{}, errs[0])
+	})
+}
@@ -22,6 +22,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/onflow/cadence/runtime/sema"
 )
@@ -208,3 +209,146 @@ func TestCheckInvalidForShadowing(t *testing.T) {
 
 	assert.IsType(t, &sema.RedeclarationError{}, errs[0])
 }
+
+func TestCheckForDictionary(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("key and value", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `
+          fun test() {
+              let xs: {String: {Int: Bool}} = {"a": {1: true}}
+              for key, value in xs {
+                  let k: String = key
+                  let v: {Int: Bool} = value
+                  for innerKey, innerValue in value {
+                      let ik: Int = innerKey
+                      let iv: Bool = innerValue
+                  }
+              }
+          }
+        `)
+
+		require.NoError(t, err)
+		assert.NotNil(t, checker)
+	})
+
+	t.Run("empty", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test() {
+              let xs: {String: Int} = {}
+              for key, value in xs {}
+          }
+        `)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("single binding", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test() {
+              for x in {"a": 1} {}
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.TypeMismatchWithDescriptionError{}, errs[0])
+	})
+
+	t.Run("resource values", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          resource R {}
+
+          fun test() {
+              let xs <- {"a": <-create R()}
+              for key, value in xs {}
+              destroy xs
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.UnsupportedResourceForLoopError{}, errs[0])
+	})
+
+	t.Run("duplicate binding", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test() {
+              for x, x in {"a": 1} {}
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.RedeclarationError{}, errs[0])
+	})
+}
+
+func TestCheckForIndex(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("variable-sized", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test() {
+              for index, element in ["a", "b"] {
+                  let i: Int = index
+                  let e: String = element
+              }
+          }
+        `)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("constant-sized", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test() {
+              let xs: [Bool; 2] = [true, false]
+              for index, element in xs {
+                  let i: Int = index
+                  let e: Bool = element
+              }
+          }
+        `)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("non-array", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test() {
+              for index, element in 1 {}
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.TypeMismatchWithDescriptionError{}, errs[0])
+	})
+}
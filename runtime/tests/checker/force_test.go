@@ -92,3 +92,45 @@ func TestCheckForce(t *testing.T) {
 		assert.IsType(t, &sema.ResourceUseAfterInvalidationError{}, errs[0])
 	})
 }
+
+func TestCheckDisallowedForceUnwrap(t *testing.T) {
+
+	t.Parallel()
+
+	const code = `
+      let x: Int? = 1
+      let y = x!
+    `
+
+	t.Run("allowed by default", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, code)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("disallowed", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheckWithOptions(t,
+			code,
+			ParseAndCheckOptions{
+				Options: []sema.Option{
+					sema.WithForceUnwrapDisallowed(true),
+				},
+			},
+		)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		require.IsType(t, &sema.ForbiddenOperatorError{}, errs[0])
+
+		forbiddenErr := errs[0].(*sema.ForbiddenOperatorError)
+		assert.Equal(t, 3, forbiddenErr.Pos.Line)
+		assert.Equal(t, 15, forbiddenErr.Pos.Column)
+		assert.Contains(t, forbiddenErr.SecondaryError(), "optional binding")
+	})
+}
@@ -24,6 +24,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/onflow/cadence/runtime/ast"
 	"github.com/onflow/cadence/runtime/sema"
 )
 
@@ -385,3 +386,161 @@ func TestCheckInvalidFunctionWithResult(t *testing.T) {
 
 	assert.IsType(t, &sema.RedeclarationError{}, errs[0])
 }
+
+func TestCheckUnusedFunctions(t *testing.T) {
+
+	t.Parallel()
+
+	check := func(t *testing.T, code string) []sema.Warning {
+		checker, err := ParseAndCheckWithOptions(t,
+			code,
+			ParseAndCheckOptions{
+				Options: []sema.Option{
+					sema.WithUnusedFunctionsReported(true),
+				},
+			},
+		)
+		require.NoError(t, err)
+
+		return checker.Warnings()
+	}
+
+	unusedFunctionNames := func(warnings []sema.Warning) []string {
+		names := make([]string, 0, len(warnings))
+		for _, warning := range warnings {
+			require.IsType(t, &sema.UnusedFunctionError{}, warning)
+			names = append(names, warning.(*sema.UnusedFunctionError).Name)
+		}
+		return names
+	}
+
+	t.Run("global functions", func(t *testing.T) {
+
+		t.Parallel()
+
+		warnings := check(t, `
+          fun used(): Int {
+              return 1
+          }
+
+          fun passed(): Int {
+              return 2
+          }
+
+          fun unused() {}
+
+          fun test(): Int {
+              let f = passed
+              return used() + f()
+          }
+        `)
+
+		assert.Equal(t,
+			[]string{"unused", "test"},
+			unusedFunctionNames(warnings),
+		)
+
+		assert.Equal(t,
+			ast.Position{Offset: 145, Line: 10, Column: 14},
+			warnings[0].StartPosition(),
+		)
+	})
+
+	t.Run("entry point", func(t *testing.T) {
+
+		t.Parallel()
+
+		warnings := check(t, `
+          pub fun main() {}
+        `)
+
+		assert.Empty(t, warnings)
+	})
+
+	t.Run("contract", func(t *testing.T) {
+
+		t.Parallel()
+
+		warnings := check(t, `
+          pub contract C {
+
+              pub resource R {
+
+                  pub fun used() {}
+
+                  pub fun unused() {}
+
+                  init() {
+                      self.used()
+                  }
+
+                  destroy() {}
+              }
+
+              pub fun createR(): @R {
+                  return <-create R()
+              }
+
+              access(contract) fun helper() {}
+
+              priv fun unusedPrivate() {}
+
+              pub fun test() {
+                  C.helper()
+              }
+          }
+        `)
+
+		assert.Equal(t,
+			[]string{"createR", "unusedPrivate", "test", "unused"},
+			unusedFunctionNames(warnings),
+		)
+	})
+
+	t.Run("conformances", func(t *testing.T) {
+
+		t.Parallel()
+
+		warnings := check(t, `
+          pub contract interface CI {
+
+              pub resource interface RI {
+                  pub fun foo()
+              }
+
+              pub resource R {
+                  pub fun bar()
+              }
+          }
+
+          pub contract C: CI {
+
+              pub resource R: CI.RI {
+
+                  pub fun foo() {}
+
+                  pub fun bar() {}
+
+                  pub fun baz() {}
+              }
+          }
+        `)
+
+		assert.Equal(t,
+			[]string{"baz"},
+			unusedFunctionNames(warnings),
+		)
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `
+          fun unused() {}
+        `)
+		require.NoError(t, err)
+
+		assert.Empty(t, checker.Warnings())
+	})
+}
@@ -19,6 +19,7 @@
 package checker
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -197,3 +198,83 @@ func TestCheckInvalidIfStatementTestWithDeclarationSameType(t *testing.T) {
 
 	assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
 }
+
+func TestCheckConstantIfConditions(t *testing.T) {
+
+	t.Parallel()
+
+	check := func(t *testing.T, condition string, enabled bool) []sema.Warning {
+		checker, err := ParseAndCheckWithOptions(t,
+			fmt.Sprintf(
+				`
+                  fun test(x: Int, y: Int, b: Bool) {
+                      if %s {}
+                  }
+                `,
+				condition,
+			),
+			ParseAndCheckOptions{
+				Options: []sema.Option{
+					sema.WithConstantConditionsReported(enabled),
+				},
+			},
+		)
+		require.NoError(t, err)
+
+		return checker.Warnings()
+	}
+
+	constantConditions := map[string]bool{
+		"true":            true,
+		"false":           false,
+		"!true":           false,
+		"x == x":          true,
+		"x != x":          false,
+		"x < x":           false,
+		"x >= x":          true,
+		"1 < 2":           true,
+		"\"a\" == \"b\"":  false,
+		"true != false":   true,
+		"b && false":      false,
+		"b || true":       true,
+		"true && !false":  true,
+		"x > y && x != x": false,
+	}
+
+	for condition, value := range constantConditions {
+
+		condition := condition
+		value := value
+
+		t.Run(condition, func(t *testing.T) {
+
+			t.Parallel()
+
+			warnings := check(t, condition, true)
+			require.Len(t, warnings, 1)
+			require.IsType(t, &sema.ConstantConditionWarning{}, warnings[0])
+			assert.Equal(t, value, warnings[0].(*sema.ConstantConditionWarning).Value)
+
+			assert.Empty(t, check(t, condition, false))
+		})
+	}
+
+	for _, condition := range []string{
+		"b",
+		"x == y",
+		"x < 1",
+		"!b",
+		"b && true",
+		"b || false",
+	} {
+
+		condition := condition
+
+		t.Run(condition, func(t *testing.T) {
+
+			t.Parallel()
+
+			assert.Empty(t, check(t, condition, true))
+		})
+	}
+}
@@ -24,6 +24,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/onflow/cadence/runtime/ast"
 	"github.com/onflow/cadence/runtime/sema"
 )
 
@@ -42,10 +43,48 @@ func TestCheckInvalidFieldInitializationEmptyInitializer(t *testing.T) {
       }
     `)
 
-	errs := ExpectCheckerErrors(t, err, 2)
+	errs := ExpectCheckerErrors(t, err, 1)
 
-	assert.IsType(t, &sema.FieldUninitializedError{}, errs[0])
-	assert.IsType(t, &sema.FieldUninitializedError{}, errs[1])
+	require.IsType(t, &sema.MissingFieldInitializationError{}, errs[0])
+
+	assert.Equal(t,
+		[]string{"foo", "bar"},
+		errs[0].(*sema.MissingFieldInitializationError).Names,
+	)
+}
+
+func TestCheckInvalidFieldInitializationMultipleMissing(t *testing.T) {
+
+	t.Parallel()
+
+	_, err := ParseAndCheck(t, `
+      struct Test {
+          var foo: Int
+          let bar: Int
+          var baz: String
+          let qux: Bool
+
+          init() {
+              self.bar = 1
+          }
+      }
+    `)
+
+	errs := ExpectCheckerErrors(t, err, 1)
+
+	require.IsType(t, &sema.MissingFieldInitializationError{}, errs[0])
+
+	missingFieldInitializationError := errs[0].(*sema.MissingFieldInitializationError)
+
+	assert.Equal(t,
+		[]string{"foo", "baz", "qux"},
+		missingFieldInitializationError.Names,
+	)
+
+	assert.Equal(t,
+		ast.Position{Offset: 35, Line: 3, Column: 14},
+		missingFieldInitializationError.StartPos,
+	)
 }
 
 func TestCheckFieldInitializationFromArgument(t *testing.T) {
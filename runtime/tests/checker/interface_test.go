@@ -25,6 +25,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/onflow/cadence/runtime/ast"
 	"github.com/onflow/cadence/runtime/common"
 	"github.com/onflow/cadence/runtime/errors"
 	"github.com/onflow/cadence/runtime/parser2"
@@ -133,6 +134,95 @@ func TestCheckInterfaceWithFunctionImplementationAndConditions(t *testing.T) {
 	}
 }
 
+func TestCheckInterfaceFunctionConditions(t *testing.T) {
+
+	t.Parallel()
+
+	checker, err := ParseAndCheck(t, `
+      struct interface Test {
+          fun withdraw(amount: Int): Int {
+              pre {
+                  amount > 0: "amount must be positive"
+              }
+              post {
+                  result == amount
+                  before(amount) == amount: "amount must not change"
+              }
+          }
+
+          fun deposit(amount: Int)
+      }
+    `)
+
+	require.NoError(t, err)
+
+	interfaceType := RequireGlobalType(t, checker.Elaboration, "Test").(*sema.InterfaceType)
+
+	conditions, ok := interfaceType.FunctionConditions("withdraw")
+	require.True(t, ok)
+
+	require.Len(t, conditions.PreConditions, 1)
+	preCondition := conditions.PreConditions[0]
+	assert.Equal(t, ast.ConditionKindPre, preCondition.Kind)
+	assert.IsType(t, &ast.BinaryExpression{}, preCondition.Test)
+	assert.Equal(t,
+		&ast.StringExpression{
+			Value: "amount must be positive",
+			Range: ast.NewRangeFromPositioned(preCondition.Message),
+		},
+		preCondition.Message,
+	)
+
+	require.Len(t, conditions.PostConditions, 2)
+	assert.Equal(t, ast.ConditionKindPost, conditions.PostConditions[0].Kind)
+	assert.Nil(t, conditions.PostConditions[0].Message)
+	assert.NotNil(t, conditions.PostConditions[1].Message)
+
+	_, ok = interfaceType.FunctionConditions("deposit")
+	assert.False(t, ok)
+}
+
+func TestCheckInterfaceSpecialFunctionConditions(t *testing.T) {
+
+	t.Parallel()
+
+	checker, err := ParseAndCheck(t, `
+      resource interface Test {
+          balance: Int
+
+          init(balance: Int) {
+              pre {
+                  balance > 0: "balance must be positive"
+              }
+              post {
+                  self.balance == balance
+              }
+          }
+
+          destroy() {
+              pre {
+                  self.balance == 0: "balance must be zero"
+              }
+          }
+      }
+    `)
+
+	require.NoError(t, err)
+
+	interfaceType := RequireGlobalType(t, checker.Elaboration, "Test").(*sema.InterfaceType)
+
+	initConditions, ok := interfaceType.FunctionConditions("init")
+	require.True(t, ok)
+	assert.Len(t, initConditions.PreConditions, 1)
+	assert.Len(t, initConditions.PostConditions, 1)
+
+	destroyConditions, ok := interfaceType.FunctionConditions("destroy")
+	require.True(t, ok)
+	require.Len(t, destroyConditions.PreConditions, 1)
+	assert.Equal(t, ast.ConditionKindPre, destroyConditions.PreConditions[0].Kind)
+	assert.Empty(t, destroyConditions.PostConditions)
+}
+
 func TestCheckInvalidInterfaceWithFunctionImplementation(t *testing.T) {
 
 	t.Parallel()
@@ -1991,3 +2081,129 @@ func TestCheckInvalidInterfaceUseAsTypeSuggestion(t *testing.T) {
 		errs[0].(*sema.InvalidInterfaceTypeError).ExpectedType,
 	)
 }
+
+func TestCheckInterfaceInitializerParameterWithoutField(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("parameter without field", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `
+          struct interface Test {
+              pub let x: Int
+
+              init(x: Int, y: Int)
+          }
+        `)
+
+		require.NoError(t, err)
+
+		hints := checker.Hints()
+		require.Len(t, hints, 1)
+		require.IsType(t, &sema.InitializerParameterWithoutFieldHint{}, hints[0])
+
+		assert.Equal(t,
+			"y",
+			hints[0].(*sema.InitializerParameterWithoutFieldHint).ParameterName,
+		)
+	})
+
+	t.Run("all parameters have fields", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `
+          struct interface Test {
+              pub let x: Int
+              pub let y: Int
+
+              init(x: Int, y: Int)
+          }
+        `)
+
+		require.NoError(t, err)
+
+		assert.Empty(t, checker.Hints())
+	})
+
+	t.Run("parameter used in pre-condition", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `
+          struct interface Test {
+              pub let x: Int
+
+              init(x: Int, max: Int) {
+                  pre {
+                      x <= max: "x is too large"
+                  }
+              }
+          }
+        `)
+
+		require.NoError(t, err)
+
+		assert.Empty(t, checker.Hints())
+	})
+}
+
+func TestCheckCompositeAllMembers(t *testing.T) {
+
+	t.Parallel()
+
+	checker, err := ParseAndCheck(t, `
+      struct interface I {
+          pub let x: Int
+
+          pub fun foo(): Int
+          pub fun bar(): Int {
+              pre { true }
+          }
+      }
+
+      struct S: I {
+          pub let x: Int
+          pub let y: Int
+
+          init() {
+              self.x = 1
+              self.y = 2
+          }
+
+          pub fun foo(): Int {
+              return 1
+          }
+
+          pub fun bar(): Int {
+              return 2
+          }
+      }
+
+      struct T {}
+    `)
+	require.NoError(t, err)
+
+	sType := RequireGlobalType(t, checker.Elaboration, "S").(*sema.CompositeType)
+	iType := RequireGlobalType(t, checker.Elaboration, "I").(*sema.InterfaceType)
+
+	members := sType.AllMembers()
+
+	for _, name := range []string{"x", "y", "foo", "bar"} {
+		member, ok := members[name]
+		require.True(t, ok)
+
+		// Members declared by the composite take precedence
+		require.Same(t, sType, member.ContainerType)
+	}
+
+	iMember, ok := iType.Members.Get("foo")
+	require.True(t, ok)
+	require.NotSame(t, iMember, members["foo"])
+
+	tType := RequireGlobalType(t, checker.Elaboration, "T").(*sema.CompositeType)
+
+	assert.NotContains(t, tType.AllMembers(), "foo")
+}
@@ -131,6 +131,77 @@ func TestCheckOptionalChainingFunctionCall(t *testing.T) {
 	)
 }
 
+func TestCheckOptionalChainingDictionaryAccess(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("non-optional values", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `
+          struct Test {
+              let x: Int
+
+              init(x: Int) {
+                  self.x = x
+              }
+
+              fun y(): Int {
+                  return self.x
+              }
+          }
+
+          let tests: {String: Test} = {"a": Test(x: 1)}
+          let x = tests["a"]?.x
+          let y = tests["a"]?.y()
+        `)
+
+		require.NoError(t, err)
+
+		for _, name := range []string{"x", "y"} {
+			assert.Equal(t,
+				&sema.OptionalType{Type: &sema.IntType{}},
+				RequireGlobalValue(t, checker.Elaboration, name),
+			)
+		}
+	})
+
+	t.Run("optional values", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `
+          struct Test {
+              let x: Int
+
+              init(x: Int) {
+                  self.x = x
+              }
+
+              fun y(): Int {
+                  return self.x
+              }
+          }
+
+          let a: Test? = Test(x: 1)
+          let b: Test? = nil
+          let tests: {String: Test?} = {"a": a, "b": b}
+          let x = tests["a"]?.x
+          let y = tests["a"]?.y()
+        `)
+
+		require.NoError(t, err)
+
+		for _, name := range []string{"x", "y"} {
+			assert.Equal(t,
+				&sema.OptionalType{Type: &sema.IntType{}},
+				RequireGlobalValue(t, checker.Elaboration, name),
+			)
+		}
+	})
+}
+
 func TestCheckInvalidOptionalChainingNonOptional(t *testing.T) {
 
 	t.Parallel()
@@ -359,3 +359,139 @@ nextMatcher:
 		assert.NotNil(t, checker.Occurrences.Find(matcher.EndPos))
 	}
 }
+
+func TestCheckTypeReferences(t *testing.T) {
+
+	t.Parallel()
+
+	checker, err := ParseAndCheck(t, `
+      pub struct S {}
+
+      pub contract interface CI {
+          pub fun test(s: S): S
+
+          pub resource R {
+              pub let s: S
+          }
+      }
+
+      pub contract C {
+          pub struct S2 {}
+      }
+
+      fun test(s: S, s2: C.S2): [S?] {
+          let res: {String: S} = {}
+          return [s]
+      }
+    `)
+
+	require.NoError(t, err)
+
+	type position struct {
+		line, column int
+	}
+
+	positions := func(ty sema.Type) []position {
+		var result []position
+		for _, pos := range checker.Elaboration.ReferencesTo(ty) {
+			result = append(result, position{pos.Line, pos.Column})
+		}
+		return result
+	}
+
+	structType := RequireGlobalType(t, checker.Elaboration, "S")
+
+	assert.Equal(t,
+		[]position{
+			{5, 26},
+			{5, 30},
+			{8, 25},
+			{16, 18},
+			{16, 33},
+			{17, 28},
+		},
+		positions(structType),
+	)
+
+	contractType := RequireGlobalType(t, checker.Elaboration, "C").(*sema.CompositeType)
+
+	assert.Equal(t,
+		[]position{
+			{16, 25},
+		},
+		positions(contractType),
+	)
+
+	nestedStructType, ok := contractType.GetNestedTypes().Get("S2")
+	require.True(t, ok)
+
+	assert.Equal(t,
+		[]position{
+			{16, 27},
+		},
+		positions(nestedStructType),
+	)
+
+	assert.Empty(t, checker.Elaboration.ReferencesTo(&sema.IntType{}))
+}
+
+func TestCheckMemberOrigins(t *testing.T) {
+
+	t.Parallel()
+
+	checker, err := ParseAndCheckWithOptions(t,
+		`
+          pub struct interface I {
+              /// The value
+              pub let x: Int
+
+              /// Returns the value
+              pub fun test(): Int
+          }
+        `,
+		ParseAndCheckOptions{
+			Options: []sema.Option{
+				sema.WithOriginsAndOccurrencesEnabled(true),
+			},
+		},
+	)
+
+	require.NoError(t, err)
+
+	interfaceType := RequireGlobalType(t, checker.Elaboration, "I")
+
+	origins := checker.Elaboration.MemberOrigins(interfaceType)
+	require.Len(t, origins, 2)
+
+	fieldOrigin := origins["x"]
+	require.NotNil(t, fieldOrigin)
+	assert.Equal(t, common.DeclarationKindField, fieldOrigin.DeclarationKind)
+	assert.Equal(t, 4, fieldOrigin.StartPos.Line)
+	assert.Equal(t, 22, fieldOrigin.StartPos.Column)
+	assert.Equal(t, " The value", fieldOrigin.DocString)
+
+	functionOrigin := origins["test"]
+	require.NotNil(t, functionOrigin)
+	assert.Equal(t, common.DeclarationKindFunction, functionOrigin.DeclarationKind)
+	assert.Equal(t, 7, functionOrigin.StartPos.Line)
+	assert.Equal(t, 22, functionOrigin.StartPos.Column)
+	assert.Equal(t, " Returns the value", functionOrigin.DocString)
+
+	// The returned map is a copy
+
+	delete(origins, "x")
+	assert.Len(t, checker.Elaboration.MemberOrigins(interfaceType), 2)
+
+	// Origins are only recorded if enabled
+
+	checker, err = ParseAndCheck(t, `
+      pub struct interface I {
+          pub let x: Int
+      }
+    `)
+
+	require.NoError(t, err)
+
+	interfaceType = RequireGlobalType(t, checker.Elaboration, "I")
+	assert.Nil(t, checker.Elaboration.MemberOrigins(interfaceType))
+}
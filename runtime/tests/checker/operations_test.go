@@ -367,3 +367,165 @@ func TestCheckInvalidCompositeEquality(t *testing.T) {
 		test(compositeKind)
 	}
 }
+
+func TestCheckPossibleDivisionByZero(t *testing.T) {
+
+	t.Parallel()
+
+	check := func(t *testing.T, code string, enabled bool) []sema.Warning {
+		checker, err := ParseAndCheckWithOptions(t,
+			code,
+			ParseAndCheckOptions{
+				Options: []sema.Option{
+					sema.WithPossibleDivisionByZeroReported(enabled),
+				},
+			},
+		)
+		require.NoError(t, err)
+
+		return checker.Warnings()
+	}
+
+	for _, operation := range []ast.Operation{
+		ast.OperationDiv,
+		ast.OperationMod,
+	} {
+
+		operation := operation
+
+		t.Run(operation.Symbol(), func(t *testing.T) {
+
+			t.Parallel()
+
+			t.Run("variable divisor", func(t *testing.T) {
+
+				t.Parallel()
+
+				code := fmt.Sprintf(
+					`
+                      fun test(x: Int, y: Int): Int {
+                          return x %s y
+                      }
+                    `,
+					operation.Symbol(),
+				)
+
+				warnings := check(t, code, true)
+				require.Len(t, warnings, 1)
+				require.IsType(t, &sema.PossibleDivisionByZeroError{}, warnings[0])
+
+				assert.Equal(t,
+					operation,
+					warnings[0].(*sema.PossibleDivisionByZeroError).Operation,
+				)
+
+				assert.Empty(t, check(t, code, false))
+			})
+
+			t.Run("zero literal", func(t *testing.T) {
+
+				t.Parallel()
+
+				code := fmt.Sprintf(
+					`
+                      let x = 1 %s 0
+                      let y = 1.0 %s 0.0
+                    `,
+					operation.Symbol(),
+					operation.Symbol(),
+				)
+
+				warnings := check(t, code, true)
+				require.Len(t, warnings, 2)
+				require.IsType(t, &sema.PossibleDivisionByZeroError{}, warnings[0])
+				require.IsType(t, &sema.PossibleDivisionByZeroError{}, warnings[1])
+			})
+
+			t.Run("non-zero literal", func(t *testing.T) {
+
+				t.Parallel()
+
+				code := fmt.Sprintf(
+					`
+                      let x = 1 %[1]s 2
+                      let y = 1 %[1]s -2
+                      let z = 1.0 %[1]s 0.5
+                    `,
+					operation.Symbol(),
+				)
+
+				assert.Empty(t, check(t, code, true))
+			})
+		})
+	}
+}
+
+func TestCheckInvalidMixedIntegerBinaryOperations(t *testing.T) {
+
+	t.Parallel()
+
+	operations := []ast.Operation{
+		ast.OperationEqual,
+		ast.OperationNotEqual,
+		ast.OperationLess,
+		ast.OperationLessEqual,
+		ast.OperationGreater,
+		ast.OperationGreaterEqual,
+		ast.OperationPlus,
+		ast.OperationMinus,
+		ast.OperationMul,
+		ast.OperationDiv,
+		ast.OperationMod,
+		ast.OperationBitwiseOr,
+		ast.OperationBitwiseXor,
+		ast.OperationBitwiseAnd,
+	}
+
+	for _, operation := range operations {
+
+		operation := operation
+
+		t.Run(operation.String(), func(t *testing.T) {
+
+			t.Parallel()
+
+			t.Run("mixed types", func(t *testing.T) {
+
+				t.Parallel()
+
+				_, err := ParseAndCheck(t,
+					fmt.Sprintf(
+						`
+                          let a: Int8 = 1
+                          let b: UInt64 = 2
+                          let c = a %s b
+                        `,
+						operation.Symbol(),
+					),
+				)
+
+				errs := ExpectCheckerErrors(t, err, 1)
+
+				assert.IsType(t, &sema.InvalidBinaryOperandsError{}, errs[0])
+			})
+
+			t.Run("explicit conversion", func(t *testing.T) {
+
+				t.Parallel()
+
+				_, err := ParseAndCheck(t,
+					fmt.Sprintf(
+						`
+                          let a: Int8 = 1
+                          let b: UInt64 = 2
+                          let c = UInt64(a) %s b
+                        `,
+						operation.Symbol(),
+					),
+				)
+
+				require.NoError(t, err)
+			})
+		})
+	}
+}
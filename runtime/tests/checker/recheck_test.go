@@ -0,0 +1,207 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2020 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package checker
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence/runtime/ast"
+	"github.com/onflow/cadence/runtime/parser2"
+	"github.com/onflow/cadence/runtime/sema"
+)
+
+func parseDeclaration(t *testing.T, code string) ast.Declaration {
+	program, err := parser2.ParseProgram(code)
+	require.NoError(t, err)
+
+	declarations := program.Declarations()
+	require.Len(t, declarations, 1)
+
+	return declarations[0]
+}
+
+func TestCheckRecheckDeclaration(t *testing.T) {
+
+	t.Parallel()
+
+	const code = `
+      struct S {
+          let x: Int
+
+          init() {
+              self.x = 1
+          }
+      }
+
+      fun f(): Int {
+          return true
+      }
+
+      fun g(): Int {
+          return f()
+      }
+    `
+
+	t.Run("function body", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, code)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
+
+		structType := RequireGlobalType(t, checker.Elaboration, "S")
+		elaboration := checker.Elaboration
+
+		err = checker.RecheckDeclaration(
+			parseDeclaration(t, `
+              fun f(): Int {
+                  return S().x
+              }
+            `),
+		)
+		require.NoError(t, err)
+
+		// The elaboration of the other declarations is reused
+
+		assert.Same(t, elaboration, checker.Elaboration)
+		assert.Same(t, structType, RequireGlobalType(t, checker.Elaboration, "S"))
+
+		err = checker.RecheckDeclaration(
+			parseDeclaration(t, `
+              fun f(): Int {
+                  return "1"
+              }
+            `),
+		)
+
+		errs = ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
+
+		assert.Same(t, elaboration, checker.Elaboration)
+	})
+
+	t.Run("function type", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, code)
+
+		ExpectCheckerErrors(t, err, 1)
+
+		elaboration := checker.Elaboration
+
+		err = checker.RecheckDeclaration(
+			parseDeclaration(t, `
+              fun f(): Bool {
+                  return true
+              }
+            `),
+		)
+
+		// The dependent function `g` is re-checked
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
+
+		assert.NotSame(t, elaboration, checker.Elaboration)
+	})
+
+	t.Run("type", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, code)
+
+		ExpectCheckerErrors(t, err, 1)
+
+		structType := RequireGlobalType(t, checker.Elaboration, "S")
+
+		err = checker.RecheckDeclaration(
+			parseDeclaration(t, `
+              struct S {
+                  let y: Int
+
+                  init() {
+                      self.y = 1
+                  }
+              }
+            `),
+		)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
+
+		assert.NotSame(t, structType, RequireGlobalType(t, checker.Elaboration, "S"))
+
+		// The dependent function `h` is re-checked
+
+		err = checker.RecheckDeclaration(
+			parseDeclaration(t, `
+              fun h(): Int {
+                  return S().x
+              }
+            `),
+		)
+
+		errs = ExpectCheckerErrors(t, err, 2)
+
+		assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
+		assert.IsType(t, &sema.NotDeclaredMemberError{}, errs[1])
+	})
+
+	t.Run("later global variable", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `
+          fun f(): Int {
+              return 1
+          }
+
+          let x = 1
+        `)
+
+		require.NoError(t, err)
+
+		elaboration := checker.Elaboration
+
+		err = checker.RecheckDeclaration(
+			parseDeclaration(t, `
+              fun f(): Int {
+                  return x
+              }
+            `),
+		)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.NotDeclaredError{}, errs[0])
+
+		assert.NotSame(t, elaboration, checker.Elaboration)
+	})
+}
@@ -0,0 +1,236 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2020 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package checker
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence/runtime/sema"
+)
+
+func TestCheckRepeatWhileStatement(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("valid", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+           fun test() {
+               var x = 0
+               repeat {
+                   x = x + 1
+                   if x == 5 {
+                       continue
+                   }
+               } while x < 10
+           }
+        `)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("invalid test", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+           fun test() {
+               repeat {} while 1
+           }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
+	})
+
+	t.Run("body scope", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+           fun test() {
+               repeat {
+                   let x = true
+               } while x
+           }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.NotDeclaredError{}, errs[0])
+	})
+
+	t.Run("definite return", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+           fun test(): Int {
+               repeat {
+                   return 1
+               } while true
+           }
+        `)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("potential return", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+           fun test(): Int {
+               repeat {
+                   if true {
+                       break
+                   }
+                   return 1
+               } while true
+           }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.MissingReturnStatementError{}, errs[0])
+	})
+}
+
+func TestCheckRepeatWhileStatementInitialization(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("definite", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+           struct S {
+               var x: Int
+
+               init() {
+                   repeat {
+                       self.x = 1
+                   } while false
+               }
+           }
+        `)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("potential", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+           struct S {
+               var x: Int
+
+               init() {
+                   repeat {
+                       if true {
+                           break
+                       }
+                       self.x = 1
+                   } while false
+               }
+           }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.FieldUninitializedError{}, errs[0])
+	})
+}
+
+func TestCheckRepeatWhileStatementResources(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("move in body", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+           resource R {}
+
+           fun test() {
+               let r <- create R()
+               repeat {
+                   destroy r
+               } while false
+           }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		require.IsType(t, &sema.ResourceUseAfterInvalidationError{}, errs[0])
+		assert.True(t, errs[0].(*sema.ResourceUseAfterInvalidationError).InLoop)
+	})
+
+	t.Run("potential move in body", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+           resource R {}
+
+           fun test() {
+               let r <- create R()
+               repeat {
+                   if true {
+                       continue
+                   }
+                   destroy r
+               } while false
+           }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 2)
+
+		assert.IsType(t, &sema.ResourceUseAfterInvalidationError{}, errs[0])
+		assert.IsType(t, &sema.ResourceLossError{}, errs[1])
+	})
+
+	t.Run("loss in body", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+           resource R {}
+
+           fun test() {
+               repeat {
+                   let r <- create R()
+               } while false
+           }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.ResourceLossError{}, errs[0])
+	})
+}
@@ -3539,6 +3539,45 @@ func TestCheckInvalidResourceArrayConcat(t *testing.T) {
 	assert.IsType(t, &sema.InvalidResourceArrayMemberError{}, errs[0])
 }
 
+func TestCheckResourceArrayAppendAll(t *testing.T) {
+
+	t.Parallel()
+
+	_, err := ParseAndCheck(t, `
+      resource X {}
+
+      fun test() {
+          let xs: @[X] <- [<-create X()]
+          let xs2 <- [<-create X()]
+          xs.appendAll(<-xs2)
+          destroy xs
+      }
+    `)
+
+	require.NoError(t, err)
+}
+
+func TestCheckInvalidResourceArrayAppendAllResourceLoss(t *testing.T) {
+
+	t.Parallel()
+
+	_, err := ParseAndCheck(t, `
+      resource X {}
+
+      fun test() {
+          let xs: @[X] <- [<-create X()]
+          let xs2 <- [<-create X()]
+          xs.appendAll(<-xs2)
+          destroy xs
+          destroy xs2
+      }
+    `)
+
+	errs := ExpectCheckerErrors(t, err, 1)
+
+	assert.IsType(t, &sema.ResourceUseAfterInvalidationError{}, errs[0])
+}
+
 func TestCheckResourceDictionaryRemove(t *testing.T) {
 
 	t.Parallel()
@@ -3631,6 +3670,55 @@ func TestCheckResourceDictionaryLength(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestCheckResourceDictionaryMerge(t *testing.T) {
+
+	t.Parallel()
+
+	_, err := ParseAndCheck(t, `
+      resource X {}
+
+      fun test() {
+          let xs: @{String: X} <- {"x1": <-create X()}
+          let ys: @{String: X} <- {"x2": <-create X()}
+          xs.merge(<-ys, overwrite: false)
+          destroy xs
+      }
+    `)
+
+	require.NoError(t, err)
+}
+
+func TestCheckInvalidResourceDictionaryMergeOverwrite(t *testing.T) {
+
+	t.Parallel()
+
+	for _, overwrite := range []string{"true", "1 == 1"} {
+
+		t.Run(overwrite, func(t *testing.T) {
+
+			_, err := ParseAndCheck(t,
+				fmt.Sprintf(
+					`
+                      resource X {}
+
+                      fun test() {
+                          let xs: @{String: X} <- {"x1": <-create X()}
+                          let ys: @{String: X} <- {"x1": <-create X()}
+                          xs.merge(<-ys, overwrite: %s)
+                          destroy xs
+                      }
+                    `,
+					overwrite,
+				),
+			)
+
+			errs := ExpectCheckerErrors(t, err, 1)
+
+			assert.IsType(t, &sema.InvalidResourceDictionaryMergeOverwriteError{}, errs[0])
+		})
+	}
+}
+
 func TestCheckInvalidResourceDictionaryKeys(t *testing.T) {
 
 	t.Parallel()
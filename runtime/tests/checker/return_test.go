@@ -488,3 +488,158 @@ func TestCheckNestedFunctionExits(t *testing.T) {
 		},
 	)
 }
+
+func TestCheckExplicitReturnTypesRequired(t *testing.T) {
+
+	t.Parallel()
+
+	parseAndCheck := func(t *testing.T, code string, required bool) (*sema.Checker, error) {
+		return ParseAndCheckWithOptions(t,
+			code,
+			ParseAndCheckOptions{
+				Options: []sema.Option{
+					sema.WithExplicitReturnTypesRequired(required),
+				},
+			},
+		)
+	}
+
+	t.Run("missing annotation, not required", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := parseAndCheck(t,
+			`
+              fun test() {
+                  return 1
+              }
+            `,
+			false,
+		)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.InvalidReturnValueError{}, errs[0])
+	})
+
+	t.Run("missing annotation, required", func(t *testing.T) {
+
+		t.Parallel()
+
+		code := `
+          fun test() {
+              return 1
+          }
+        `
+
+		checker, err := parseAndCheck(t, code, true)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		require.IsType(t, &sema.MissingReturnTypeAnnotationError{}, errs[0])
+
+		assert.Equal(t,
+			&sema.IntType{},
+			errs[0].(*sema.MissingReturnTypeAnnotationError).ValueType,
+		)
+
+		// Applying the suggested fix adds the return type annotation
+
+		diagnostics := checker.Diagnostics()
+		require.Len(t, diagnostics, 1)
+		require.Len(t, diagnostics[0].SuggestedFixes, 1)
+
+		fixedCode := diagnostics[0].SuggestedFixes[0].ApplyTo(code)
+
+		assert.Equal(t,
+			`
+          fun test(): Int {
+              return 1
+          }
+        `,
+			fixedCode,
+		)
+
+		_, err = parseAndCheck(t, fixedCode, true)
+		require.NoError(t, err)
+	})
+
+	t.Run("annotated, required", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := parseAndCheck(t,
+			`
+              fun test(): Int {
+                  return 1
+              }
+
+              fun test2() {
+                  return
+              }
+            `,
+			true,
+		)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("function expression, required", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := parseAndCheck(t,
+			`
+              let test = fun () {
+                  return "test"
+              }
+            `,
+			true,
+		)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.MissingReturnTypeAnnotationError{}, errs[0])
+	})
+
+	t.Run("composite function, required", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := parseAndCheck(t,
+			`
+              struct S {
+                  fun test() {
+                      return true
+                  }
+              }
+            `,
+			true,
+		)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.MissingReturnTypeAnnotationError{}, errs[0])
+	})
+
+	t.Run("interface function requirement, required", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := parseAndCheck(t,
+			`
+              struct interface SI {
+                  fun test() {
+                      return true
+                  }
+              }
+            `,
+			true,
+		)
+
+		errs := ExpectCheckerErrors(t, err, 2)
+
+		assert.IsType(t, &sema.MissingReturnTypeAnnotationError{}, errs[0])
+		assert.IsType(t, &sema.InvalidImplementationError{}, errs[1])
+	})
+}
@@ -19,6 +19,7 @@
 package checker
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -272,3 +273,268 @@ func TestCheckInvalidStringIndexingAssignmentWithCharacterLiteral(t *testing.T)
 
 	assert.IsType(t, &sema.NotIndexingAssignableTypeError{}, errs[0])
 }
+
+func TestCheckStringToLowerAndToUpper(t *testing.T) {
+
+	t.Parallel()
+
+	checker, err := ParseAndCheck(t, `
+      let lower = "Abc".toLower()
+      let upper = "Abc".toUpper()
+    `)
+
+	require.NoError(t, err)
+
+	assert.Equal(t,
+		sema.StringType,
+		RequireGlobalValue(t, checker.Elaboration, "lower"),
+	)
+
+	assert.Equal(t,
+		sema.StringType,
+		RequireGlobalValue(t, checker.Elaboration, "upper"),
+	)
+}
+
+func TestCheckStringTrim(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("without cutset", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `
+          let result = " abc ".trim()
+        `)
+
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			sema.StringType,
+			RequireGlobalValue(t, checker.Elaboration, "result"),
+		)
+	})
+
+	t.Run("with cutset", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `
+          let result = "xabcx".trim("x")
+        `)
+
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			sema.StringType,
+			RequireGlobalValue(t, checker.Elaboration, "result"),
+		)
+	})
+
+	t.Run("invalid cutset type", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          let result = "xabcx".trim(1)
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
+	})
+
+	t.Run("too many arguments", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          let result = "xabcx".trim("x", "y")
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.ArgumentCountError{}, errs[0])
+	})
+}
+
+func TestCheckStringPredicates(t *testing.T) {
+
+	t.Parallel()
+
+	for _, name := range []string{"contains", "startsWith", "endsWith"} {
+
+		name := name
+
+		t.Run(name, func(t *testing.T) {
+
+			t.Parallel()
+
+			checker, err := ParseAndCheck(t,
+				fmt.Sprintf(
+					`
+                      let result = "abc".%s("a")
+                    `,
+					name,
+				),
+			)
+
+			require.NoError(t, err)
+
+			assert.Equal(t,
+				sema.BoolType,
+				RequireGlobalValue(t, checker.Elaboration, "result"),
+			)
+		})
+	}
+}
+
+func TestCheckStringBase64(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("encode", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `
+          let data = "010203".decodeHex()
+          let standard = String.encodeBase64(data)
+          let urlSafe = String.encodeBase64(data, urlSafe: true)
+        `)
+
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			sema.StringType,
+			RequireGlobalValue(t, checker.Elaboration, "standard"),
+		)
+
+		assert.Equal(t,
+			sema.StringType,
+			RequireGlobalValue(t, checker.Elaboration, "urlSafe"),
+		)
+	})
+
+	t.Run("decode", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `
+          let standard = String.decodeBase64("AQID")
+          let urlSafe = String.decodeBase64("AQID", urlSafe: true)
+        `)
+
+		require.NoError(t, err)
+
+		expectedType := &sema.OptionalType{
+			Type: &sema.VariableSizedType{
+				Type: &sema.UInt8Type{},
+			},
+		}
+
+		assert.Equal(t,
+			expectedType,
+			RequireGlobalValue(t, checker.Elaboration, "standard"),
+		)
+
+		assert.Equal(t,
+			expectedType,
+			RequireGlobalValue(t, checker.Elaboration, "urlSafe"),
+		)
+	})
+
+	t.Run("invalid data type", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          let result = String.encodeBase64("AQID")
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
+	})
+
+	t.Run("missing argument label", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          let result = String.decodeBase64("AQID", true)
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.MissingArgumentLabelError{}, errs[0])
+	})
+
+	t.Run("too many arguments", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          let result = String.decodeBase64("AQID", urlSafe: true, true)
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.ArgumentCountError{}, errs[0])
+	})
+}
+
+func TestCheckStringUTF8(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("utf8", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `
+          let bytes = "abc".utf8
+        `)
+
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			&sema.VariableSizedType{
+				Type: &sema.UInt8Type{},
+			},
+			RequireGlobalValue(t, checker.Elaboration, "bytes"),
+		)
+	})
+
+	t.Run("fromUTF8", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `
+          let string = String.fromUTF8("616263".decodeHex())
+        `)
+
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			&sema.OptionalType{
+				Type: sema.StringType,
+			},
+			RequireGlobalValue(t, checker.Elaboration, "string"),
+		)
+	})
+
+	t.Run("invalid argument type", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          let string = String.fromUTF8("abc")
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
+	})
+}
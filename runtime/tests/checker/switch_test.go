@@ -25,6 +25,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/onflow/cadence/runtime/sema"
+	"github.com/onflow/cadence/runtime/stdlib"
 )
 
 func TestCheckSwitchStatementTest(t *testing.T) {
@@ -356,3 +357,547 @@ func TestCheckInvalidSwitchStatementMissingStatements(t *testing.T) {
 
 	assert.IsType(t, &sema.MissingSwitchCaseStatementsError{}, errs[0])
 }
+
+func TestCheckSwitchStatementEnumExhaustiveness(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("all cases", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+            enum E: UInt8 {
+                case a
+                case b
+            }
+
+            fun test(e: E) {
+                switch e {
+                case E.a:
+                    return
+                case E.b:
+                    return
+                }
+            }
+        `)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("missing cases, not reported", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+            enum E: UInt8 {
+                case a
+                case b
+                case c
+            }
+
+            fun test(e: E) {
+                switch e {
+                case E.b:
+                    return
+                }
+            }
+        `)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("missing cases", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheckWithOptions(t,
+			`
+              enum E: UInt8 {
+                  case a
+                  case b
+                  case c
+              }
+
+              fun test(e: E) {
+                  switch e {
+                  case E.b:
+                      return
+                  }
+              }
+            `,
+			ParseAndCheckOptions{
+				Options: []sema.Option{
+					sema.WithNonExhaustiveSwitchReported(true),
+				},
+			},
+		)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		require.IsType(t, &sema.NonExhaustiveSwitchError{}, errs[0])
+
+		assert.Equal(t,
+			[]string{"a", "c"},
+			errs[0].(*sema.NonExhaustiveSwitchError).MissingCases,
+		)
+	})
+
+	t.Run("missing cases, default", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+            enum E: UInt8 {
+                case a
+                case b
+            }
+
+            fun test(e: E) {
+                switch e {
+                case E.a:
+                    return
+                default:
+                    return
+                }
+            }
+        `)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("built-in enum, missing cases", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheckWithOptions(t,
+			`
+              fun test(algo: HashAlgorithm) {
+                  switch algo {
+                  case HashAlgorithm.SHA2_256:
+                      return
+                  case HashAlgorithm.SHA3_256:
+                      return
+                  }
+              }
+            `,
+			ParseAndCheckOptions{
+				Options: []sema.Option{
+					sema.WithPredeclaredValues(
+						stdlib.BuiltinValues.ToSemaValueDeclarations(),
+					),
+					sema.WithNonExhaustiveSwitchReported(true),
+				},
+			},
+		)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		require.IsType(t, &sema.NonExhaustiveSwitchError{}, errs[0])
+
+		assert.Equal(t,
+			[]string{"SHA2_384", "SHA3_384"},
+			errs[0].(*sema.NonExhaustiveSwitchError).MissingCases,
+		)
+	})
+
+	t.Run("constants", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheckWithOptions(t,
+			`
+              enum E: UInt8 {
+                  case a
+                  case b
+                  case c
+              }
+
+              let a = E.a
+
+              fun test(e: E) {
+                  let b = E.b
+                  let c = b
+                  switch e {
+                  case a:
+                      return
+                  case c:
+                      return
+                  }
+              }
+            `,
+			ParseAndCheckOptions{
+				Options: []sema.Option{
+					sema.WithNonExhaustiveSwitchReported(true),
+				},
+			},
+		)
+
+		// The case expressions are resolved to the enum cases `a` and `b`
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		require.IsType(t, &sema.NonExhaustiveSwitchError{}, errs[0])
+
+		assert.Equal(t,
+			[]string{"c"},
+			errs[0].(*sema.NonExhaustiveSwitchError).MissingCases,
+		)
+	})
+
+	t.Run("variables and other expressions", func(t *testing.T) {
+
+		t.Parallel()
+
+		// Variables and e.g. function calls have no constant value,
+		// so they do not cover any case
+
+		_, err := ParseAndCheckWithOptions(t,
+			`
+              enum E: UInt8 {
+                  case a
+                  case b
+              }
+
+              fun getB(): E {
+                  return E.b
+              }
+
+              fun test(e: E) {
+                  var a = E.a
+                  switch e {
+                  case a:
+                      return
+                  case getB():
+                      return
+                  }
+              }
+            `,
+			ParseAndCheckOptions{
+				Options: []sema.Option{
+					sema.WithNonExhaustiveSwitchReported(true),
+				},
+			},
+		)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		require.IsType(t, &sema.NonExhaustiveSwitchError{}, errs[0])
+
+		assert.Equal(t,
+			[]string{"a", "b"},
+			errs[0].(*sema.NonExhaustiveSwitchError).MissingCases,
+		)
+	})
+}
+
+func TestCheckSwitchStatementEnumRedundantDefault(t *testing.T) {
+
+	t.Parallel()
+
+	const code = `
+      enum E: UInt8 {
+          case a
+          case b
+      }
+
+      fun test(e: E) {
+          switch e {
+          case E.a:
+              return
+          case E.b:
+              return
+          default:
+              return
+          }
+      }
+    `
+
+	t.Run("warning", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, code)
+
+		require.NoError(t, err)
+
+		warnings := checker.Warnings()
+
+		require.Len(t, warnings, 1)
+		require.IsType(t, &sema.RedundantDefaultCaseError{}, warnings[0])
+	})
+
+	t.Run("error", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheckWithOptions(t,
+			code,
+			ParseAndCheckOptions{
+				Options: []sema.Option{
+					sema.WithRedundantDefaultCaseReportedAsError(true),
+				},
+			},
+		)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		require.IsType(t, &sema.RedundantDefaultCaseError{}, errs[0])
+	})
+
+	t.Run("not all cases covered", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheckWithOptions(t,
+			`
+              enum E: UInt8 {
+                  case a
+                  case b
+              }
+
+              fun test(e: E) {
+                  switch e {
+                  case E.a:
+                      return
+                  default:
+                      return
+                  }
+              }
+            `,
+			ParseAndCheckOptions{
+				Options: []sema.Option{
+					sema.WithRedundantDefaultCaseReportedAsError(true),
+				},
+			},
+		)
+
+		require.NoError(t, err)
+		require.Empty(t, checker.Warnings())
+	})
+}
+
+func TestCheckSwitchExpression(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("same result types", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `
+            let y = 2
+            let x = switch y { case 1: "one"; case 2: "two"; default: "many" }
+        `)
+
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			sema.StringType,
+			RequireGlobalValue(t, checker.Elaboration, "x"),
+		)
+	})
+
+	t.Run("optional result type", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `
+            let y = 2
+            let x = switch y { case 1: 1; default: nil }
+        `)
+
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			&sema.OptionalType{Type: &sema.IntType{}},
+			RequireGlobalValue(t, checker.Elaboration, "x"),
+		)
+	})
+
+	t.Run("common super type", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `
+            let y = 2
+            let x = switch y { case 1: 1; default: "many" }
+        `)
+
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			sema.AnyStructType,
+			RequireGlobalValue(t, checker.Elaboration, "x"),
+		)
+	})
+
+	t.Run("missing default", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+            let y = 2
+            let x = switch y { case 1: "one" }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.MissingSwitchExpressionDefaultCaseError{}, errs[0])
+	})
+
+	t.Run("default not last", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+            let y = 2
+            let x = switch y { default: "many"; case 1: "one" }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.SwitchDefaultPositionError{}, errs[0])
+	})
+
+	t.Run("invalid case type", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+            let y = 2
+            let x = switch y { case "1": "one"; default: "many" }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.InvalidBinaryOperandsError{}, errs[0])
+	})
+
+	t.Run("enum, all cases", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+            enum E: UInt8 {
+                case a
+                case b
+            }
+
+            fun test(e: E): String {
+                return switch e {
+                    case E.a: "a"
+                    case E.b: "b"
+                }
+            }
+        `)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("enum, missing cases", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+            enum E: UInt8 {
+                case a
+                case b
+            }
+
+            fun test(e: E): String {
+                return switch e { case E.a: "a" }
+            }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		require.IsType(t, &sema.NonExhaustiveSwitchError{}, errs[0])
+
+		assert.Equal(t,
+			[]string{"b"},
+			errs[0].(*sema.NonExhaustiveSwitchError).MissingCases,
+		)
+	})
+}
+
+func TestCheckSwitchExpressionResources(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("moved results", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+            resource R {}
+
+            fun test(y: Int): @R {
+                let r1 <- create R()
+                let r2 <- create R()
+                let r <- switch y { case 1: <-r1; default: <-r2 }
+                destroy r1
+                destroy r2
+                return <-r
+            }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 2)
+
+		assert.IsType(t, &sema.ResourceUseAfterInvalidationError{}, errs[0])
+		assert.IsType(t, &sema.ResourceUseAfterInvalidationError{}, errs[1])
+	})
+
+	t.Run("created results", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+            resource R {}
+
+            fun test(y: Int): @R {
+                return <-switch y { case 1: <-create R(); default: <-create R() }
+            }
+        `)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("missing move", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+            resource R {}
+
+            fun test(y: Int): @R {
+                let r <- create R()
+                return <-switch y { case 1: r; default: <-create R() }
+            }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 3)
+
+		assert.IsType(t, &sema.MissingMoveOperationError{}, errs[0])
+		assert.IsType(t, &sema.ResourceLossError{}, errs[1])
+		assert.IsType(t, &sema.ResourceLossError{}, errs[2])
+	})
+
+	t.Run("resource and non-resource results", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+            resource R {}
+
+            fun test(y: Int) {
+                let r <- switch y { case 1: <-create R(); default: 1 }
+                destroy r
+            }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
+	})
+}
@@ -22,6 +22,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/onflow/cadence/runtime/sema"
 )
@@ -136,3 +137,429 @@ func TestCheckInvalidWhileContinueStatement(t *testing.T) {
 
 	assert.IsType(t, &sema.ControlStatementError{}, errs[0])
 }
+
+func TestCheckUnreachableStatementAfterJump(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("after break", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test() {
+              while true {
+                  break
+                  let x = 1
+              }
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.UnreachableStatementError{}, errs[0])
+	})
+
+	t.Run("after continue", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test() {
+              while true {
+                  continue
+                  let x = 1
+              }
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.UnreachableStatementError{}, errs[0])
+	})
+
+	t.Run("after if with break and return", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test(x: Int) {
+              while true {
+                  if x > 1 {
+                      break
+                  } else {
+                      return
+                  }
+                  let y = 1
+              }
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.UnreachableStatementError{}, errs[0])
+	})
+
+	t.Run("after loop with break", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test(): Int {
+              while true {
+                  break
+              }
+              return 1
+          }
+        `)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("after if with break in one branch", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test(x: Int) {
+              while true {
+                  if x > 1 {
+                      break
+                  }
+                  let y = 1
+              }
+          }
+        `)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("after switch with break in every case", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test(x: Int): Int {
+              switch x {
+              case 1:
+                  break
+              default:
+                  break
+              }
+              return x
+          }
+        `)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("after switch with continue in every case", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test(x: Int) {
+              while true {
+                  switch x {
+                  case 1:
+                      continue
+                  default:
+                      continue
+                  }
+                  let y = 1
+              }
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.UnreachableStatementError{}, errs[0])
+	})
+
+	t.Run("after switch with break in some case", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test(x: Int) {
+              while true {
+                  switch x {
+                  case 1:
+                      break
+                  default:
+                      continue
+                  }
+                  let y = 1
+              }
+          }
+        `)
+
+		require.NoError(t, err)
+	})
+}
+
+func TestCheckLabeledBreakAndContinue(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("valid", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+           fun test() {
+               outer: while true {
+                   for x in [1, 2, 3] {
+                       if x == 1 {
+                           continue outer
+                       }
+                       break outer
+                   }
+               }
+           }
+        `)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("in switch", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+           fun test(x: Int) {
+               outer: while true {
+                   switch x {
+                   case 1:
+                       break outer
+                   default:
+                       break
+                   }
+               }
+           }
+        `)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("undefined label", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+           fun test() {
+               outer: while true {
+                   break inner
+               }
+               while true {
+                   continue outer
+               }
+           }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 2)
+
+		assert.IsType(t, &sema.UndefinedLabelError{}, errs[0])
+		assert.IsType(t, &sema.UndefinedLabelError{}, errs[1])
+	})
+
+	t.Run("label in enclosing function", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+           fun test() {
+               outer: while true {
+                   fun () {
+                       while true {
+                           break outer
+                       }
+                   }
+               }
+           }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.UndefinedLabelError{}, errs[0])
+	})
+
+	t.Run("shadowing", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+           fun test() {
+               outer: while true {
+                   outer: while true {
+                       break outer
+                   }
+               }
+           }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.RedeclaredLabelError{}, errs[0])
+	})
+
+	t.Run("sequential loops", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+           fun test() {
+               loop: while true {
+                   break loop
+               }
+               loop: while true {
+                   break loop
+               }
+           }
+        `)
+
+		require.NoError(t, err)
+	})
+}
+
+func TestCheckConstantWhileConditions(t *testing.T) {
+
+	t.Parallel()
+
+	check := func(t *testing.T, code string) []sema.Warning {
+		checker, err := ParseAndCheckWithOptions(t,
+			code,
+			ParseAndCheckOptions{
+				Options: []sema.Option{
+					sema.WithConstantConditionsReported(true),
+				},
+			},
+		)
+		require.NoError(t, err)
+
+		return checker.Warnings()
+	}
+
+	requireConstantCondition := func(t *testing.T, warnings []sema.Warning, value bool) {
+		require.Len(t, warnings, 1)
+		require.IsType(t, &sema.ConstantConditionWarning{}, warnings[0])
+		assert.Equal(t, value, warnings[0].(*sema.ConstantConditionWarning).Value)
+	}
+
+	t.Run("always true, no break", func(t *testing.T) {
+
+		t.Parallel()
+
+		warnings := check(t, `
+          fun test(x: Int) {
+              var i = 0
+              while true {
+                  i = i + 1
+              }
+          }
+        `)
+
+		requireConstantCondition(t, warnings, true)
+	})
+
+	t.Run("always false", func(t *testing.T) {
+
+		t.Parallel()
+
+		warnings := check(t, `
+          fun test(x: Int) {
+              while x != x {}
+          }
+        `)
+
+		requireConstantCondition(t, warnings, false)
+	})
+
+	t.Run("always true, break", func(t *testing.T) {
+
+		t.Parallel()
+
+		warnings := check(t, `
+          fun test(x: Int) {
+              var i = 0
+              while true {
+                  if i > x {
+                      break
+                  }
+                  i = i + 1
+              }
+          }
+        `)
+
+		assert.Empty(t, warnings)
+	})
+
+	t.Run("always true, break in nested loop", func(t *testing.T) {
+
+		t.Parallel()
+
+		warnings := check(t, `
+          fun test(x: Int) {
+              while true {
+                  while x > 0 {
+                      break
+                  }
+              }
+          }
+        `)
+
+		requireConstantCondition(t, warnings, true)
+	})
+
+	t.Run("always true, break in switch", func(t *testing.T) {
+
+		t.Parallel()
+
+		warnings := check(t, `
+          fun test(x: Int) {
+              while true {
+                  switch x {
+                  case 1:
+                      break
+                  }
+              }
+          }
+        `)
+
+		requireConstantCondition(t, warnings, true)
+	})
+
+	t.Run("always true, labeled break in nested loop", func(t *testing.T) {
+
+		t.Parallel()
+
+		warnings := check(t, `
+          fun test(x: Int) {
+              outer: while true {
+                  while true {
+                      break outer
+                  }
+              }
+          }
+        `)
+
+		assert.Empty(t, warnings)
+	})
+
+	t.Run("always true, labeled break of nested loop", func(t *testing.T) {
+
+		t.Parallel()
+
+		warnings := check(t, `
+          fun test(x: Int) {
+              while true {
+                  inner: while x > 0 {
+                      break inner
+                  }
+              }
+          }
+        `)
+
+		requireConstantCondition(t, warnings, true)
+	})
+}
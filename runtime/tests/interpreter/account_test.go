@@ -33,7 +33,15 @@ import (
 	"github.com/onflow/cadence/runtime/tests/checker"
 )
 
-func testAccount(t *testing.T, auth bool, code string) (*interpreter.Interpreter, map[string]interpreter.OptionalValue) {
+func testAccount(
+	t *testing.T,
+	auth bool,
+	code string,
+	options ...interpreter.Option,
+) (
+	*interpreter.Interpreter,
+	map[string]interpreter.OptionalValue,
+) {
 
 	address := interpreter.NewAddressValueFromBytes([]byte{42})
 
@@ -126,12 +134,12 @@ func testAccount(t *testing.T, auth bool, code string) (*interpreter.Interpreter
 			CheckerOptions: []sema.Option{
 				sema.WithPredeclaredValues(valueDeclarations.ToSemaValueDeclarations()),
 			},
-			Options: []interpreter.Option{
+			Options: append([]interpreter.Option{
 				interpreter.WithPredeclaredValues(valueDeclarations.ToInterpreterValueDeclarations()),
 				interpreter.WithStorageExistenceHandler(storageChecker),
 				interpreter.WithStorageReadHandler(storageGetter),
 				interpreter.WithStorageWriteHandler(storageSetter),
-			},
+			}, options...),
 		},
 	)
 
@@ -1406,3 +1414,171 @@ func TestCheckAccount_StorageFields(t *testing.T) {
 		}
 	}
 }
+
+func TestInterpretInventoryCapabilities(t *testing.T) {
+
+	t.Parallel()
+
+	inter, storedValues := testAccount(
+		t,
+		true,
+		`
+          resource R {}
+
+          fun test() {
+              account.save(<-create R(), to: /storage/r)
+              account.link<&R>(/public/r, target: /storage/r)
+
+              let capability = account.getCapability<&R>(/public/r)
+              account.save([capability], to: /storage/capabilities)
+          }
+        `,
+	)
+
+	account := inter.Globals["account"].GetValue().(interpreter.AuthAccountValue)
+
+	// Without a stored keys handler, no capabilities can be found
+
+	require.Empty(t, interpreter.InventoryCapabilities(inter, account))
+
+	_, err := inter.Invoke("test")
+	require.NoError(t, err)
+
+	inter.SetStoredKeysHandler(func(_ *interpreter.Interpreter, _ common.Address) []string {
+		keys := make([]string, 0, len(storedValues))
+		for key := range storedValues {
+			keys = append(keys, key)
+		}
+		return keys
+	})
+
+	rType := checker.RequireGlobalType(t, inter.Program.Elaboration, "R")
+
+	expectedBorrowType := interpreter.ConvertSemaToStaticType(
+		&sema.ReferenceType{
+			Type: rType,
+		},
+	)
+
+	assert.Equal(t,
+		[]interpreter.CapabilityInfo{
+			{
+				Path: interpreter.PathValue{
+					Domain:     common.PathDomainStorage,
+					Identifier: "capabilities",
+				},
+				BorrowType:    expectedBorrowType,
+				TargetAddress: account.Address,
+				TargetPath: interpreter.PathValue{
+					Domain:     common.PathDomainPublic,
+					Identifier: "r",
+				},
+			},
+			{
+				Path: interpreter.PathValue{
+					Domain:     common.PathDomainPublic,
+					Identifier: "r",
+				},
+				IsLink:        true,
+				BorrowType:    expectedBorrowType,
+				TargetAddress: account.Address,
+				TargetPath: interpreter.PathValue{
+					Domain:     common.PathDomainStorage,
+					Identifier: "r",
+				},
+			},
+		},
+		interpreter.InventoryCapabilities(inter, account),
+	)
+}
+
+func TestInterpretAuthAccount_dryRun(t *testing.T) {
+
+	t.Parallel()
+
+	inter, storedValues := testAccount(
+		t,
+		true,
+		`
+          struct S {}
+
+          fun setup() {
+              account.save(S(), to: /storage/s)
+          }
+
+          fun test() {
+              account.save(S(), to: /storage/s2)
+              account.link<&S>(/public/s, target: /storage/s)
+              let s = account.load<S>(from: /storage/s)!
+              account.unlink(/public/s)
+          }
+        `,
+	)
+
+	_, err := inter.Invoke("setup")
+	require.NoError(t, err)
+
+	require.Len(t, storedValues, 1)
+	require.Contains(t, storedValues, "storage\x1Fs")
+
+	inter.SetDryRun(true)
+
+	_, err = inter.Invoke("test")
+	require.NoError(t, err)
+
+	// Storage is unchanged, e.g. the value is not removed by the load
+
+	require.Len(t, storedValues, 1)
+	require.Contains(t, storedValues, "storage\x1Fs")
+
+	effects := inter.EffectsLog()
+	require.Len(t, effects, 4)
+
+	address := common.BytesToAddress([]byte{42})
+	storagePath := func(identifier string) interpreter.PathValue {
+		return interpreter.PathValue{
+			Domain:     common.PathDomainStorage,
+			Identifier: identifier,
+		}
+	}
+	publicPath := interpreter.PathValue{
+		Domain:     common.PathDomainPublic,
+		Identifier: "s",
+	}
+
+	type effect struct {
+		path      interpreter.PathValue
+		operation interpreter.StorageOperation
+		typeID    sema.TypeID
+	}
+
+	expected := []effect{
+		{storagePath("s2"), interpreter.StorageOperationSave, "S.test.S"},
+		{publicPath, interpreter.StorageOperationLink, "&S.test.S"},
+		{storagePath("s"), interpreter.StorageOperationLoad, "S.test.S"},
+		{publicPath, interpreter.StorageOperationUnlink, ""},
+	}
+
+	for i, expectedEffect := range expected {
+		actualEffect := effects[i]
+
+		assert.Equal(t, address, actualEffect.Address)
+		assert.Equal(t, expectedEffect.path, actualEffect.Path)
+		assert.Equal(t, expectedEffect.operation, actualEffect.Operation)
+
+		if expectedEffect.typeID == "" {
+			assert.Nil(t, actualEffect.ValueType)
+		} else {
+			assert.Equal(t, expectedEffect.typeID, actualEffect.ValueType.ID())
+		}
+	}
+
+	// The log is reset per top-level execution.
+	// Saving to an existing path fails before any effect is recorded
+
+	_, err = inter.Invoke("setup")
+	require.Error(t, err)
+	require.ErrorAs(t, err, &interpreter.OverwriteError{})
+
+	assert.Empty(t, inter.EffectsLog())
+}
@@ -20,9 +20,11 @@ package interpreter_test
 
 import (
 	"fmt"
+	"math/big"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/onflow/cadence/runtime/interpreter"
 	"github.com/onflow/cadence/runtime/sema"
@@ -200,3 +202,277 @@ func TestInterpretModOperator(t *testing.T) {
 		})
 	}
 }
+
+func TestInterpretSaturatedArithmeticFunctions(t *testing.T) {
+
+	t.Parallel()
+
+	for _, ty := range sema.AllIntegerTypes {
+
+		if !sema.SupportsSaturatingArithmetic(ty) {
+			continue
+		}
+
+		rangedType := ty.(sema.IntegerRangedType)
+		signed := rangedType.MinInt().Sign() < 0
+
+		t.Run(ty.String(), func(t *testing.T) {
+
+			checks := map[string]string{
+				"addOverflow":  "max.saturatingAdd(one) == max",
+				"addBoundary":  "(max - one).saturatingAdd(one) == max",
+				"addRegular":   "one.saturatingAdd(one) == two",
+				"subUnderflow": "min.saturatingSub(one) == min",
+				"subBoundary":  "(min + one).saturatingSub(one) == min",
+				"subRegular":   "two.saturatingSub(one) == one",
+				"mulOverflow":  "max.saturatingMul(two) == max",
+				"mulBoundary":  "max.saturatingMul(one) == max",
+				"mulRegular":   "one.saturatingMul(two) == two",
+			}
+
+			if signed {
+				checks["addUnderflow"] = "min.saturatingAdd(-one) == min"
+				checks["subOverflow"] = "max.saturatingSub(-one) == max"
+				checks["mulUnderflow"] = "min.saturatingMul(two) == min"
+				checks["mulNegatedOverflow"] = "min.saturatingMul(-one) == max"
+			}
+
+			for name, check := range checks {
+
+				inter := parseCheckAndInterpret(t,
+					fmt.Sprintf(
+						`
+                          let min: %[1]s = %[2]s
+                          let max: %[1]s = %[3]s
+                          let one: %[1]s = 1
+                          let two: %[1]s = 2
+
+                          let result = %[4]s
+                        `,
+						ty,
+						rangedType.MinInt(),
+						rangedType.MaxInt(),
+						check,
+					),
+				)
+
+				assert.Equal(t,
+					interpreter.BoolValue(true),
+					inter.Globals["result"].GetValue(),
+					name,
+				)
+			}
+		})
+	}
+}
+
+func TestInterpretIntegerOverflowMode(t *testing.T) {
+
+	t.Parallel()
+
+	interpretWithMode := func(
+		t *testing.T,
+		ty sema.Type,
+		mode interpreter.IntegerOverflowMode,
+		check string,
+	) *interpreter.Interpreter {
+
+		rangedType := ty.(sema.IntegerRangedType)
+
+		return parseCheckAndInterpretWithOptions(t,
+			fmt.Sprintf(
+				`
+                  let min: %[1]s = %[2]s
+                  let max: %[1]s = %[3]s
+                  let zero: %[1]s = 0
+                  let one: %[1]s = 1
+                  let two: %[1]s = 2
+
+                  fun test(): Bool {
+                      return %[4]s
+                  }
+                `,
+				ty,
+				rangedType.MinInt(),
+				rangedType.MaxInt(),
+				check,
+			),
+			ParseCheckAndInterpretOptions{
+				Options: []interpreter.Option{
+					interpreter.WithIntegerOverflowMode(mode),
+				},
+			},
+		)
+	}
+
+	for _, ty := range sema.AllIntegerTypes {
+
+		if !sema.IsFixedWidthIntegerType(ty) {
+			continue
+		}
+
+		signed := ty.(sema.IntegerRangedType).MinInt().Sign() < 0
+
+		t.Run(ty.String(), func(t *testing.T) {
+
+			t.Run("panic", func(t *testing.T) {
+
+				checks := map[string]string{
+					"addOverflow":  "max + one == max",
+					"subUnderflow": "min - one == min",
+					"mulOverflow":  "max * two == max",
+				}
+
+				for name, check := range checks {
+
+					inter := interpretWithMode(t, ty, interpreter.IntegerOverflowModePanic, check)
+
+					_, err := inter.Invoke("test")
+					require.Error(t, err, name)
+				}
+			})
+
+			t.Run("saturate", func(t *testing.T) {
+
+				checks := map[string]string{
+					"addOverflow":  "max + one == max",
+					"addBoundary":  "(max - one) + one == max",
+					"addRegular":   "one + one == two",
+					"subUnderflow": "min - one == min",
+					"subBoundary":  "(min + one) - one == min",
+					"mulOverflow":  "max * two == max",
+					"mulRegular":   "one * two == two",
+					"divRegular":   "two / two == one",
+				}
+
+				if signed {
+					checks["addUnderflow"] = "min + -one == min"
+					checks["subOverflow"] = "max - -one == max"
+					checks["mulUnderflow"] = "min * two == min"
+					checks["divOverflow"] = "min / -one == max"
+					checks["negateOverflow"] = "-min == max"
+				}
+
+				for name, check := range checks {
+
+					inter := interpretWithMode(t, ty, interpreter.IntegerOverflowModeSaturate, check)
+
+					result, err := inter.Invoke("test")
+					require.NoError(t, err, name)
+
+					assert.Equal(t,
+						interpreter.BoolValue(true),
+						result,
+						name,
+					)
+				}
+			})
+
+			t.Run("wrap", func(t *testing.T) {
+
+				checks := map[string]string{
+					"addOverflow":  "max + one == min",
+					"addRegular":   "one + one == two",
+					"subUnderflow": "min - one == max",
+					"subRegular":   "two - one == one",
+					"mulRegular":   "one * two == two",
+				}
+
+				if signed {
+					checks["addUnderflow"] = "min + -one == max"
+					checks["subOverflow"] = "max - -one == min"
+					checks["divOverflow"] = "min / -one == min"
+					checks["negateOverflow"] = "-min == min"
+					checks["mulOverflow"] = "max * two == -two"
+				} else {
+					checks["mulOverflow"] = "max * two == max - one"
+				}
+
+				for name, check := range checks {
+
+					inter := interpretWithMode(t, ty, interpreter.IntegerOverflowModeWrap, check)
+
+					result, err := inter.Invoke("test")
+					require.NoError(t, err, name)
+
+					assert.Equal(t,
+						interpreter.BoolValue(true),
+						result,
+						name,
+					)
+				}
+			})
+
+			t.Run("division by zero", func(t *testing.T) {
+
+				for _, mode := range []interpreter.IntegerOverflowMode{
+					interpreter.IntegerOverflowModeSaturate,
+					interpreter.IntegerOverflowModeWrap,
+				} {
+					inter := interpretWithMode(t, ty, mode, "one / zero == zero")
+
+					_, err := inter.Invoke("test")
+					require.ErrorAs(t, err, &interpreter.DivisionByZeroError{})
+				}
+			})
+		})
+	}
+
+	t.Run("Word8", func(t *testing.T) {
+
+		inter := interpretWithMode(t, &sema.Word8Type{}, interpreter.IntegerOverflowModeSaturate, "max + one == zero")
+
+		result, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			interpreter.BoolValue(true),
+			result,
+		)
+	})
+}
+
+func TestInterpretCheckedConversionFunctions(t *testing.T) {
+
+	t.Parallel()
+
+	for _, ty := range []sema.Type{&sema.Int128Type{}, &sema.Int256Type{}} {
+
+		ty := ty
+
+		t.Run(ty.String(), func(t *testing.T) {
+
+			t.Parallel()
+
+			for name, targetType := range sema.CheckedConversionTargetTypes(ty) { //nolint:maprangecheck
+
+				min := targetType.MinInt()
+				max := targetType.MaxInt()
+
+				belowMin := new(big.Int).Sub(min, big.NewInt(1))
+				aboveMax := new(big.Int).Add(max, big.NewInt(1))
+
+				checks := map[string]string{
+					"min":      fmt.Sprintf("(%s as %s).%s()! == (%s as %s)", min, ty, name, min, targetType),
+					"max":      fmt.Sprintf("(%s as %s).%s()! == (%s as %s)", max, ty, name, max, targetType),
+					"zero":     fmt.Sprintf("(0 as %s).%s()! == (0 as %s)", ty, name, targetType),
+					"belowMin": fmt.Sprintf("(%s as %s).%s() == nil", belowMin, ty, name),
+					"aboveMax": fmt.Sprintf("(%s as %s).%s() == nil", aboveMax, ty, name),
+				}
+
+				for checkName, check := range checks { //nolint:maprangecheck
+
+					inter := parseCheckAndInterpret(t,
+						fmt.Sprintf("let result = %s", check),
+					)
+
+					assert.Equal(t,
+						interpreter.BoolValue(true),
+						inter.Globals["result"].GetValue(),
+						fmt.Sprintf("%s %s", name, checkName),
+					)
+				}
+			}
+		})
+	}
+}
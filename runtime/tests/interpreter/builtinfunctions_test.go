@@ -20,6 +20,7 @@ package interpreter_test
 
 import (
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -59,7 +60,33 @@ func TestInterpretToString(t *testing.T) {
         `)
 
 		assert.Equal(t,
-			interpreter.NewStringValue("0x42"),
+			interpreter.NewStringValue("0x0000000000000042"),
+			inter.Globals["y"].GetValue(),
+		)
+	})
+
+	t.Run("Address, zero", func(t *testing.T) {
+
+		inter := parseCheckAndInterpret(t, `
+          let x: Address = 0x0
+          let y = x.toString()
+        `)
+
+		assert.Equal(t,
+			interpreter.NewStringValue("0x0000000000000000"),
+			inter.Globals["y"].GetValue(),
+		)
+	})
+
+	t.Run("Address, max", func(t *testing.T) {
+
+		inter := parseCheckAndInterpret(t, `
+          let x: Address = 0xffffffffffffffff
+          let y = x.toString()
+        `)
+
+		assert.Equal(t,
+			interpreter.NewStringValue("0xffffffffffffffff"),
 			inter.Globals["y"].GetValue(),
 		)
 	})
@@ -158,24 +185,28 @@ func TestInterpretToBigEndianBytes(t *testing.T) {
 			"-9223372036854775808": {128, 0, 0, 0, 0, 0, 0, 0},
 		},
 		"Int128": {
-			"0":                  {0},
-			"42":                 {42},
-			"127":                {127},
-			"128":                {0, 128},
-			"200":                {0, 200},
-			"-1":                 {255},
-			"-200":               {255, 56},
-			"-10000000000000000": {220, 121, 13, 144, 63, 0, 0},
+			"0":                  {0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0},
+			"42":                 {0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 42},
+			"127":                {0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 127},
+			"128":                {0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 128},
+			"200":                {0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 200},
+			"-1":                 {255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255},
+			"-200":               {255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 56},
+			"-10000000000000000": {255, 255, 255, 255, 255, 255, 255, 255, 255, 220, 121, 13, 144, 63, 0, 0},
+			"170141183460469231731687303715884105727":  {127, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255},
+			"-170141183460469231731687303715884105728": {128, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0},
 		},
 		"Int256": {
-			"0":                  {0},
-			"42":                 {42},
-			"127":                {127},
-			"128":                {0, 128},
-			"200":                {0, 200},
-			"-1":                 {255},
-			"-200":               {255, 56},
-			"-10000000000000000": {220, 121, 13, 144, 63, 0, 0},
+			"0":                  {0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0},
+			"42":                 {0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 42},
+			"127":                {0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 127},
+			"128":                {0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 128},
+			"200":                {0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 200},
+			"-1":                 {255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255},
+			"-200":               {255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 56},
+			"-10000000000000000": {255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 220, 121, 13, 144, 63, 0, 0},
+			"57896044618658097711785492504343953926634992332820282019728792003956564819967":  {127, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255},
+			"-57896044618658097711785492504343953926634992332820282019728792003956564819968": {128, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0},
 		},
 		// UInt*
 		"UInt": {
@@ -214,18 +245,20 @@ func TestInterpretToBigEndianBytes(t *testing.T) {
 			"18446744073709551615": {255, 255, 255, 255, 255, 255, 255, 255},
 		},
 		"UInt128": {
-			"0":   {0},
-			"42":  {42},
-			"127": {127},
-			"128": {128},
-			"200": {200},
+			"0":   {0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0},
+			"42":  {0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 42},
+			"127": {0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 127},
+			"128": {0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 128},
+			"200": {0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 200},
+			"340282366920938463463374607431768211455": {255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255},
 		},
 		"UInt256": {
-			"0":   {0},
-			"42":  {42},
-			"127": {127},
-			"128": {128},
-			"200": {200},
+			"0":   {0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0},
+			"42":  {0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 42},
+			"127": {0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 127},
+			"128": {0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 128},
+			"200": {0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 200},
+			"115792089237316195423570985008687907853269984665640564039457584007913129639935": {255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255},
 		},
 		// Word*
 		"Word8": {
@@ -311,3 +344,355 @@ func TestInterpretToBigEndianBytes(t *testing.T) {
 		}
 	}
 }
+
+func TestInterpretToLittleEndianBytes(t *testing.T) {
+
+	typeTests := map[string]map[string][]byte{
+		"Int8": {
+			"-1":  {255},
+			"127": {127},
+		},
+		"Int16": {
+			"42":     {42, 0},
+			"-2":     {254, 255},
+			"-32768": {0, 128},
+		},
+		"Int64": {
+			"1":  {1, 0, 0, 0, 0, 0, 0, 0},
+			"-1": {255, 255, 255, 255, 255, 255, 255, 255},
+		},
+		"Int128": {
+			"-200": {56, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255},
+		},
+		"UInt32": {
+			"2147483648": {0, 0, 0, 128},
+		},
+		"UInt128": {
+			"200": {200, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0},
+		},
+	}
+
+	for ty, tests := range typeTests {
+
+		for value, expected := range tests {
+
+			t.Run(fmt.Sprintf("%s: %s", ty, value), func(t *testing.T) {
+
+				inter := parseCheckAndInterpret(t,
+					fmt.Sprintf(
+						`
+	                      let value: %s = %s
+	                      let result = value.toLittleEndianBytes()
+	                    `,
+						ty,
+						value,
+					),
+				)
+
+				assert.Equal(t,
+					interpreter.ByteSliceToByteArrayValue(expected),
+					inter.Globals["result"].GetValue(),
+				)
+			})
+		}
+	}
+}
+
+func TestInterpretFromBytes(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("golden", func(t *testing.T) {
+
+		type test struct {
+			ty       string
+			function string
+			bytes    []byte
+			expected string
+		}
+
+		tests := []test{
+			{"Int8", "fromBigEndianBytes", []byte{255}, "-1"},
+			{"Int16", "fromBigEndianBytes", []byte{255, 254}, "-2"},
+			{"Int16", "fromLittleEndianBytes", []byte{254, 255}, "-2"},
+			{"Int16", "fromBigEndianBytes", []byte{128, 0}, "-32768"},
+			{"UInt16", "fromBigEndianBytes", []byte{0, 42}, "42"},
+			{"UInt16", "fromLittleEndianBytes", []byte{42, 0}, "42"},
+			{"UInt32", "fromBigEndianBytes", []byte{255, 255, 255, 255}, "4294967295"},
+			{"Int64", "fromLittleEndianBytes", []byte{0, 0, 0, 0, 0, 0, 0, 128}, "-9223372036854775808"},
+			{"UInt64", "fromBigEndianBytes", []byte{128, 0, 0, 0, 0, 0, 0, 0}, "9223372036854775808"},
+			{
+				"Int128",
+				"fromLittleEndianBytes",
+				[]byte{56, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255},
+				"-200",
+			},
+		}
+
+		for _, test := range tests {
+
+			byteLiterals := make([]string, len(test.bytes))
+			for i, b := range test.bytes {
+				byteLiterals[i] = fmt.Sprintf("%d as UInt8", b)
+			}
+
+			inter := parseCheckAndInterpret(t,
+				fmt.Sprintf(
+					`
+                      let bytes: [UInt8] = [%[3]s]
+                      let expected: %[1]s = %[4]s
+                      let result = %[1]s.%[2]s(bytes)! == expected
+                    `,
+					test.ty,
+					test.function,
+					strings.Join(byteLiterals, ", "),
+					test.expected,
+				),
+			)
+
+			assert.Equal(t,
+				interpreter.BoolValue(true),
+				inter.Globals["result"].GetValue(),
+				fmt.Sprintf("%s.%s(%v)", test.ty, test.function, test.bytes),
+			)
+		}
+	})
+
+	for _, ty := range sema.AllIntegerTypes {
+
+		if !sema.IsFixedWidthIntegerType(ty) {
+			continue
+		}
+
+		rangedType := ty.(sema.IntegerRangedType)
+
+		values := []string{
+			"0",
+			"1",
+			"42",
+			rangedType.MinInt().String(),
+			rangedType.MaxInt().String(),
+		}
+
+		if rangedType.MinInt().Sign() < 0 {
+			values = append(values, "-1", "-42")
+		}
+
+		t.Run(ty.String(), func(t *testing.T) {
+
+			for _, value := range values {
+
+				inter := parseCheckAndInterpret(t,
+					fmt.Sprintf(
+						`
+                          fun dropFirst(_ bytes: [UInt8]): [UInt8] {
+                              var remaining = bytes
+                              remaining.removeFirst()
+                              return remaining
+                          }
+
+                          let value: %[1]s = %[2]s
+                          let bigEndian = %[1]s.fromBigEndianBytes(value.toBigEndianBytes())! == value
+                          let littleEndian = %[1]s.fromLittleEndianBytes(value.toLittleEndianBytes())! == value
+                          let tooShort = %[1]s.fromBigEndianBytes(dropFirst(value.toBigEndianBytes())) == nil
+                          let tooLong = %[1]s.fromLittleEndianBytes(value.toLittleEndianBytes().concat([0 as UInt8])) == nil
+                          let empty = %[1]s.fromBigEndianBytes([]) == nil
+                        `,
+						ty,
+						value,
+					),
+				)
+
+				for _, name := range []string{"bigEndian", "littleEndian", "tooShort", "tooLong", "empty"} {
+					assert.Equal(t,
+						interpreter.BoolValue(true),
+						inter.Globals[name].GetValue(),
+						fmt.Sprintf("%s: %s", value, name),
+					)
+				}
+			}
+		})
+	}
+}
+
+func TestInterpretAddressFromBytes(t *testing.T) {
+
+	t.Parallel()
+
+	inter := parseCheckAndInterpret(t, `
+      let valid = Address.fromBytes([
+          0x00 as UInt8, 0x00 as UInt8, 0x00 as UInt8, 0x00 as UInt8,
+          0x00 as UInt8, 0x12 as UInt8, 0x34 as UInt8, 0x56 as UInt8
+      ])
+      let roundTrip = Address.fromBytes((0xffffffffffffffff as Address).toBytes())
+      let tooShort = Address.fromBytes([0x12 as UInt8, 0x34 as UInt8])
+      let tooLong = Address.fromBytes([
+          0x00 as UInt8, 0x00 as UInt8, 0x00 as UInt8, 0x00 as UInt8,
+          0x00 as UInt8, 0x00 as UInt8, 0x00 as UInt8, 0x00 as UInt8,
+          0x01 as UInt8
+      ])
+      let empty = Address.fromBytes([])
+    `)
+
+	assert.Equal(t,
+		interpreter.NewSomeValueOwningNonCopying(
+			interpreter.NewAddressValueFromBytes([]byte{0x12, 0x34, 0x56}),
+		),
+		inter.Globals["valid"].GetValue(),
+	)
+
+	assert.Equal(t,
+		interpreter.NewSomeValueOwningNonCopying(
+			interpreter.NewAddressValueFromBytes([]byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}),
+		),
+		inter.Globals["roundTrip"].GetValue(),
+	)
+
+	for _, name := range []string{"tooShort", "tooLong", "empty"} {
+		assert.Equal(t,
+			interpreter.NilValue{},
+			inter.Globals[name].GetValue(),
+			name,
+		)
+	}
+}
+
+func TestInterpretAddressFromString(t *testing.T) {
+
+	t.Parallel()
+
+	type test struct {
+		string   string
+		expected []byte
+	}
+
+	tests := []test{
+		{"0x0000000000000000", []byte{}},
+		{"0x0000000000000001", []byte{0x1}},
+		{"0x0000000000123456", []byte{0x12, 0x34, 0x56}},
+		{"0xffffffffffffffff", []byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}},
+		{"0xFFFFFFFFFFFFFFFF", []byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}},
+		{"", nil},
+		{"0x", nil},
+		{"0x1", nil},
+		{"0000000000000001", nil},
+		{"0x00000000000000001", nil},
+		{"0x000000000000000g", nil},
+		{" 0x0000000000000001", nil},
+		{"0x0000000000000001 ", nil},
+		{"0X0000000000000001", nil},
+	}
+
+	for _, test := range tests {
+
+		inter := parseCheckAndInterpret(t,
+			fmt.Sprintf(
+				`
+                  let result = Address.fromString("%s")
+                `,
+				test.string,
+			),
+		)
+
+		var expected interpreter.Value = interpreter.NilValue{}
+		if test.expected != nil {
+			expected = interpreter.NewSomeValueOwningNonCopying(
+				interpreter.NewAddressValueFromBytes(test.expected),
+			)
+		}
+
+		assert.Equal(t,
+			expected,
+			inter.Globals["result"].GetValue(),
+			test.string,
+		)
+	}
+
+	t.Run("round trip", func(t *testing.T) {
+
+		inter := parseCheckAndInterpret(t, `
+          let address: Address = 0x42
+          let result = Address.fromString(address.toString())! == address
+        `)
+
+		assert.Equal(t,
+			interpreter.BoolValue(true),
+			inter.Globals["result"].GetValue(),
+		)
+	})
+}
+
+func TestInterpretArbitraryPrecisionFromBigEndianBytes(t *testing.T) {
+
+	t.Parallel()
+
+	typeValues := map[string][]string{
+		"Int": {
+			"0",
+			"1",
+			"127",
+			"128",
+			"-1",
+			"-128",
+			"-129",
+			"340282366920938463463374607431768211456",
+			"-340282366920938463463374607431768211456",
+		},
+		"UInt": {
+			"0",
+			"1",
+			"127",
+			"128",
+			"255",
+			"256",
+			"340282366920938463463374607431768211456",
+		},
+	}
+
+	for ty, values := range typeValues {
+
+		for _, value := range values {
+
+			t.Run(fmt.Sprintf("%s: %s", ty, value), func(t *testing.T) {
+
+				inter := parseCheckAndInterpret(t,
+					fmt.Sprintf(
+						`
+                          let value: %[1]s = %[2]s
+                          let result = %[1]s.fromBigEndianBytes(value.toBigEndianBytes())! == value
+                        `,
+						ty,
+						value,
+					),
+				)
+
+				assert.Equal(t,
+					interpreter.BoolValue(true),
+					inter.Globals["result"].GetValue(),
+				)
+			})
+		}
+	}
+
+	t.Run("leading bytes", func(t *testing.T) {
+
+		inter := parseCheckAndInterpret(t, `
+          let signExtended: [UInt8] = [255 as UInt8, 255 as UInt8, 254 as UInt8]
+          let zeroExtended: [UInt8] = [0 as UInt8, 0 as UInt8, 42 as UInt8]
+          let empty: [UInt8] = []
+
+          let negative = Int.fromBigEndianBytes(signExtended)! == -2
+          let positive = Int.fromBigEndianBytes(zeroExtended)! == 42
+          let unsigned = UInt.fromBigEndianBytes(signExtended)! == 16777214 as UInt
+          let zero = Int.fromBigEndianBytes(empty)! == 0
+        `)
+
+		for _, name := range []string{"negative", "positive", "unsigned", "zero"} {
+			assert.Equal(t,
+				interpreter.BoolValue(true),
+				inter.Globals[name].GetValue(),
+				name,
+			)
+		}
+	})
+}
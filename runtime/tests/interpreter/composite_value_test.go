@@ -125,3 +125,118 @@ func testCompositeValue(t *testing.T, code string) *interpreter.Interpreter {
 
 	return inter
 }
+
+func TestCompositeValueFieldOrder(t *testing.T) {
+
+	t.Parallel()
+
+	inter := parseCheckAndInterpretWithOptions(t,
+		`
+          resource R {
+              let a: Int
+              let b: Int
+              let c: Int
+
+              init() {
+                  self.c = 3
+                  self.b = 2
+                  self.a = 1
+              }
+          }
+
+          let r <- create R()
+        `,
+		ParseCheckAndInterpretOptions{
+			Options: []interpreter.Option{
+				interpreter.WithUUIDHandler(func() (uint64, error) {
+					return 42, nil
+				}),
+			},
+		},
+	)
+
+	r := inter.Globals["r"].GetValue().(*interpreter.CompositeValue)
+
+	require.Equal(t,
+		[]string{sema.ResourceUUIDFieldName, "a", "b", "c"},
+		r.FieldNamesInOrder(inter),
+	)
+
+	var visited []interpreter.Value
+
+	r.Accept(
+		inter,
+		interpreter.EmptyVisitor{
+			IntValueVisitor: func(_ *interpreter.Interpreter, value interpreter.IntValue) {
+				visited = append(visited, value)
+			},
+			UInt64ValueVisitor: func(_ *interpreter.Interpreter, value interpreter.UInt64Value) {
+				visited = append(visited, value)
+			},
+		},
+	)
+
+	require.Equal(t,
+		[]interpreter.Value{
+			interpreter.UInt64Value(42),
+			interpreter.NewIntValueFromInt64(1),
+			interpreter.NewIntValueFromInt64(2),
+			interpreter.NewIntValueFromInt64(3),
+		},
+		visited,
+	)
+
+	// Without an interpreter, the fields are in the order in which they were set
+
+	require.Equal(t,
+		[]string{sema.ResourceUUIDFieldName, "c", "b", "a"},
+		r.FieldNamesInOrder(nil),
+	)
+}
+
+func TestEncodeDecodedCompositeValueJSON(t *testing.T) {
+
+	t.Parallel()
+
+	inter := parseCheckAndInterpret(t, `
+      struct S {
+          let a: Int
+          let c: Int
+          let b: Int
+
+          init() {
+              self.b = 2
+              self.c = 3
+              self.a = 1
+          }
+      }
+
+      fun test(): S {
+          return S()
+      }
+    `)
+
+	value, err := inter.Invoke("test")
+	require.NoError(t, err)
+
+	encoded, _, err := interpreter.EncodeValue(value, nil, false, nil)
+	require.NoError(t, err)
+
+	decoded, err := interpreter.DecodeValue(encoded, nil, nil, interpreter.CurrentEncodingVersion, nil)
+	require.NoError(t, err)
+
+	// The decoded composite has its fields in a different insertion order
+	// than the fresh composite, but the fields are encoded in declaration order
+
+	encodedJSON, err := interpreter.EncodeValueJSON(inter, value)
+	require.NoError(t, err)
+
+	decodedJSON, err := interpreter.EncodeValueJSON(inter, decoded)
+	require.NoError(t, err)
+
+	require.Equal(t, string(encodedJSON), string(decodedJSON))
+	require.Contains(t,
+		string(encodedJSON),
+		`"fields":[{"name":"a","value":{"type":"Int","value":"1"}},{"name":"c",`,
+	)
+}
@@ -0,0 +1,167 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2020 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+
+package interpreter_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence/runtime/ast"
+	"github.com/onflow/cadence/runtime/interpreter"
+)
+
+func TestInterpretDebugHandler(t *testing.T) {
+
+	t.Parallel()
+
+	const code = `
+      fun test(): Int {
+          var x = 1
+          let y = 2
+          x = x + y
+          return x
+      }
+    `
+
+	t.Run("continue", func(t *testing.T) {
+
+		t.Parallel()
+
+		var lines []int
+		var visible [][]string
+
+		inter := parseCheckAndInterpretWithOptions(t,
+			code,
+			ParseCheckAndInterpretOptions{
+				Options: []interpreter.Option{
+					interpreter.WithDebugHandler(
+						func(
+							_ *interpreter.Interpreter,
+							locationRange interpreter.LocationRange,
+							_ ast.Statement,
+							activation *interpreter.VariableActivation,
+						) interpreter.ControlAction {
+							lines = append(lines, locationRange.StartPos.Line)
+
+							var names []string
+							for _, name := range []string{"x", "y"} {
+								if _, ok := activation.Variables()[name]; ok {
+									names = append(names, name)
+								}
+							}
+							visible = append(visible, names)
+
+							return interpreter.ControlActionContinue
+						},
+					),
+				},
+			},
+		)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		assert.Equal(t, interpreter.NewIntValueFromInt64(3), value)
+
+		assert.Equal(t, []int{3, 4, 5, 6}, lines)
+		assert.Equal(t,
+			[][]string{
+				nil,
+				{"x"},
+				{"x", "y"},
+				{"x", "y"},
+			},
+			visible,
+		)
+	})
+
+	t.Run("pause", func(t *testing.T) {
+
+		t.Parallel()
+
+		type stop struct {
+			inter *interpreter.Interpreter
+			line  int
+			x     interpreter.Value
+		}
+
+		stops := make(chan stop)
+
+		inter := parseCheckAndInterpretWithOptions(t,
+			code,
+			ParseCheckAndInterpretOptions{
+				Options: []interpreter.Option{
+					interpreter.WithDebugHandler(
+						func(
+							inter *interpreter.Interpreter,
+							locationRange interpreter.LocationRange,
+							_ ast.Statement,
+							activation *interpreter.VariableActivation,
+						) interpreter.ControlAction {
+							line := locationRange.StartPos.Line
+
+							// Break before the assignment and before the return
+
+							if line < 5 {
+								return interpreter.ControlActionContinue
+							}
+
+							stops <- stop{
+								inter: inter,
+								line:  line,
+								x:     activation.Find("x").GetValue(),
+							}
+
+							return interpreter.ControlActionPause
+						},
+					),
+				},
+			},
+		)
+
+		type result struct {
+			value interpreter.Value
+			err   error
+		}
+
+		results := make(chan result)
+
+		go func() {
+			value, err := inter.Invoke("test")
+			results <- result{value, err}
+		}()
+
+		first := <-stops
+		assert.Equal(t, 5, first.line)
+		assert.Equal(t, interpreter.NewIntValueFromInt64(1), first.x)
+		first.inter.Resume()
+
+		second := <-stops
+		assert.Equal(t, 6, second.line)
+		assert.Equal(t, interpreter.NewIntValueFromInt64(3), second.x)
+		second.inter.Resume()
+
+		res := <-results
+		require.NoError(t, res.err)
+		assert.Equal(t, interpreter.NewIntValueFromInt64(3), res.value)
+	})
+}
@@ -44,6 +44,26 @@ func TestInterpretNegativeZeroFixedPoint(t *testing.T) {
 	)
 }
 
+func TestInterpretFixedPointExponent(t *testing.T) {
+
+	t.Parallel()
+
+	inter := parseCheckAndInterpret(t, `
+      let x: UFix64 = 1.5e3
+      let y = -1.25e-2
+    `)
+
+	assert.Equal(t,
+		interpreter.UFix64Value(150000000000),
+		inter.Globals["x"].GetValue(),
+	)
+
+	assert.Equal(t,
+		interpreter.Fix64Value(-1250000),
+		inter.Globals["y"].GetValue(),
+	)
+}
+
 func TestInterpretFixedPointConversionAndAddition(t *testing.T) {
 
 	t.Parallel()
@@ -151,3 +151,277 @@ func TestInterpretForStatementEmpty(t *testing.T) {
 		value,
 	)
 }
+
+func TestInterpretForStatementMutation(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("append", func(t *testing.T) {
+
+		// The loop iterates over the elements the array has when the loop starts
+
+		inter := parseCheckAndInterpret(t, `
+          fun test(): [Int] {
+              let xs = [1, 2, 3]
+              var count = 0
+              for x in xs {
+                  if x == 1 {
+                      xs.append(4)
+                  }
+                  count = count + 1
+              }
+              xs.append(count)
+              return xs
+          }
+        `)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			interpreter.NewArrayValueUnownedNonCopying(
+				interpreter.NewIntValueFromInt64(1),
+				interpreter.NewIntValueFromInt64(2),
+				interpreter.NewIntValueFromInt64(3),
+				interpreter.NewIntValueFromInt64(4),
+				interpreter.NewIntValueFromInt64(3),
+			),
+			value,
+		)
+	})
+
+	t.Run("index assignment", func(t *testing.T) {
+
+		inter := parseCheckAndInterpret(t, `
+          fun test(): [Int] {
+              let xs = [1, 2, 3, 4]
+              var i = 0
+              for x in xs {
+                  xs[i] = x * 2
+                  i = i + 1
+              }
+              return xs
+          }
+        `)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			interpreter.NewArrayValueUnownedNonCopying(
+				interpreter.NewIntValueFromInt64(2),
+				interpreter.NewIntValueFromInt64(4),
+				interpreter.NewIntValueFromInt64(6),
+				interpreter.NewIntValueFromInt64(8),
+			),
+			value,
+		)
+	})
+
+	t.Run("after loop", func(t *testing.T) {
+
+		inter := parseCheckAndInterpret(t, `
+          fun test(): Int {
+              let xs = [1, 2, 3, 4]
+              for x in xs {
+                  if x == 2 {
+                      break
+                  }
+              }
+              xs.append(5)
+              return xs.length
+          }
+        `)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			interpreter.NewIntValueFromInt64(5),
+			value,
+		)
+	})
+}
+
+func TestInterpretForStatementDictionary(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("key and value", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          fun test(): [String] {
+              let xs = {"c": 3, "a": 1, "b": 2}
+              let entries: [String] = []
+              for key, value in xs {
+                  entries.append(key.concat(value.toString()))
+              }
+              return entries
+          }
+        `)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			interpreter.NewArrayValueUnownedNonCopying(
+				interpreter.NewStringValue("c3"),
+				interpreter.NewStringValue("a1"),
+				interpreter.NewStringValue("b2"),
+			),
+			value,
+		)
+	})
+
+	t.Run("nested", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          fun test(): Int {
+              let xs = {1: {10: 100, 20: 200}, 2: {30: 300}}
+              var sum = 0
+              for key, inner in xs {
+                  for innerKey, innerValue in inner {
+                      sum = sum + key * (innerKey + innerValue)
+                  }
+              }
+              return sum
+          }
+        `)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			interpreter.NewIntValueFromInt64(1*(110+220)+2*330),
+			value,
+		)
+	})
+
+	t.Run("empty", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          fun test(): Int {
+              let xs: {String: Int} = {}
+              var count = 0
+              for key, value in xs {
+                  count = count + 1
+              }
+              return count
+          }
+        `)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			interpreter.NewIntValueFromInt64(0),
+			value,
+		)
+	})
+
+	t.Run("break", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          fun test(): String {
+              let xs = {"a": 1, "b": 2, "c": 3}
+              for key, value in xs {
+                  if value == 2 {
+                      return key
+                  }
+              }
+              return ""
+          }
+        `)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			interpreter.NewStringValue("b"),
+			value,
+		)
+	})
+
+	t.Run("mutation", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          fun test() {
+              let xs = {"a": 1}
+              for key, value in xs {
+                  xs["b"] = 2
+              }
+          }
+        `)
+
+		_, err := inter.Invoke("test")
+		require.ErrorAs(t, err, &interpreter.ContainerMutatedDuringIterationError{})
+	})
+}
+
+func TestInterpretForStatementIndex(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("index and element", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          fun test(): [String] {
+              let entries: [String] = []
+              for index, element in ["a", "b", "c"] {
+                  entries.append(index.toString().concat(element))
+              }
+              return entries
+          }
+        `)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			interpreter.NewArrayValueUnownedNonCopying(
+				interpreter.NewStringValue("0a"),
+				interpreter.NewStringValue("1b"),
+				interpreter.NewStringValue("2c"),
+			),
+			value,
+		)
+	})
+
+	t.Run("continue", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          fun test(): Int {
+              var sum = 0
+              for index, element in [10, 20, 30, 40] {
+                  if index % 2 == 0 {
+                      continue
+                  }
+                  sum = sum + element
+              }
+              return sum
+          }
+        `)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			interpreter.NewIntValueFromInt64(60),
+			value,
+		)
+	})
+}
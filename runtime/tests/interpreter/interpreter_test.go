@@ -1836,9 +1836,12 @@ func TestInterpretStructureFieldAssignment(t *testing.T) {
 
 	assert.True(t, test.IsModified())
 
+	foo, ok := test.GetField(inter, "foo")
+	require.True(t, ok)
+
 	assert.Equal(t,
 		interpreter.NewIntValueFromInt64(1),
-		test.GetField("foo"),
+		foo,
 	)
 
 	value, err := inter.Invoke("callTest")
@@ -1851,9 +1854,12 @@ func TestInterpretStructureFieldAssignment(t *testing.T) {
 		value,
 	)
 
+	foo, ok = test.GetField(inter, "foo")
+	require.True(t, ok)
+
 	assert.Equal(t,
 		interpreter.NewIntValueFromInt64(3),
-		test.GetField("foo"),
+		foo,
 	)
 }
 
@@ -1970,6 +1976,62 @@ func TestInterpretFunctionPostCondition(t *testing.T) {
 	assert.Equal(t, zero, value)
 }
 
+func TestInterpretConditionStats(t *testing.T) {
+
+	t.Parallel()
+
+	inter := parseCheckAndInterpret(t, `
+      fun check(_ x: Int): Int {
+          pre {
+              x >= 0
+              x < 100: "x must be less than 100"
+          }
+          post {
+              result == x
+          }
+          return x
+      }
+
+      fun test(x: Int): Int {
+          check(1)
+          return check(x)
+      }
+    `)
+
+	_, err := inter.Invoke("test", interpreter.NewIntValueFromInt64(2))
+	require.NoError(t, err)
+
+	assert.Equal(t,
+		interpreter.ConditionStats{
+			PreChecked:  4,
+			PostChecked: 2,
+			Failed:      0,
+		},
+		inter.ConditionStats(),
+	)
+
+	// The counters are reset for each invocation
+
+	_, err = inter.Invoke("test", interpreter.NewIntValueFromInt64(200))
+	require.Error(t, err)
+
+	assert.Equal(t,
+		interpreter.ConditionStats{
+			PreChecked:  4,
+			PostChecked: 1,
+			Failed:      1,
+		},
+		inter.ConditionStats(),
+	)
+
+	var conditionErr interpreter.ConditionError
+	require.ErrorAs(t, err, &conditionErr)
+
+	assert.Equal(t, ast.ConditionKindPre, conditionErr.ConditionKind)
+	assert.Equal(t, "x must be less than 100", conditionErr.Message)
+	assert.Equal(t, 5, conditionErr.StartPos.Line)
+}
+
 func TestInterpretFunctionWithResultAndPostConditionWithResult(t *testing.T) {
 
 	t.Parallel()
@@ -4666,6 +4728,213 @@ func TestInterpretArrayConcatBound(t *testing.T) {
 	)
 }
 
+func TestInterpretArrayAppendAll(t *testing.T) {
+
+	t.Parallel()
+
+	inter := parseCheckAndInterpret(t, `
+      fun test(): [Int] {
+          let a = [1, 2]
+          let b = [3, 4]
+          a.appendAll(b)
+          b.append(5)
+          return a
+      }
+    `)
+
+	value, err := inter.Invoke("test")
+	require.NoError(t, err)
+
+	assert.Equal(t,
+		interpreter.NewArrayValueUnownedNonCopying(
+			interpreter.NewIntValueFromInt64(1),
+			interpreter.NewIntValueFromInt64(2),
+			interpreter.NewIntValueFromInt64(3),
+			interpreter.NewIntValueFromInt64(4),
+		),
+		value,
+	)
+}
+
+func TestInterpretResourceArrayAppendAll(t *testing.T) {
+
+	t.Parallel()
+
+	inter := parseCheckAndInterpret(t, `
+      resource R {
+          let id: Int
+
+          init(id: Int) {
+              self.id = id
+          }
+      }
+
+      fun test(): [Int] {
+          let rs <- [<-create R(id: 1)]
+          let rs2 <- [<-create R(id: 2), <-create R(id: 3)]
+          rs.appendAll(<-rs2)
+          let ids = [rs[0].id, rs[1].id, rs[2].id]
+          destroy rs
+          return ids
+      }
+    `)
+
+	value, err := inter.Invoke("test")
+	require.NoError(t, err)
+
+	assert.Equal(t,
+		interpreter.NewArrayValueUnownedNonCopying(
+			interpreter.NewIntValueFromInt64(1),
+			interpreter.NewIntValueFromInt64(2),
+			interpreter.NewIntValueFromInt64(3),
+		),
+		value,
+	)
+}
+
+func TestInterpretArrayReverse(t *testing.T) {
+
+	t.Parallel()
+
+	inter := parseCheckAndInterpret(t, `
+      let x = [1, 2, 3]
+      let y = x.reverse()
+      let z = [UInt8(1)].reverse()
+      let empty: [Int] = []
+      let emptyReversed = empty.reverse()
+    `)
+
+	assert.Equal(t,
+		interpreter.NewArrayValueUnownedNonCopying(
+			interpreter.NewIntValueFromInt64(3),
+			interpreter.NewIntValueFromInt64(2),
+			interpreter.NewIntValueFromInt64(1),
+		),
+		inter.Globals["y"].GetValue(),
+	)
+
+	// The receiver is unchanged
+
+	assert.Equal(t,
+		interpreter.NewArrayValueUnownedNonCopying(
+			interpreter.NewIntValueFromInt64(1),
+			interpreter.NewIntValueFromInt64(2),
+			interpreter.NewIntValueFromInt64(3),
+		),
+		inter.Globals["x"].GetValue(),
+	)
+
+	assert.Equal(t,
+		interpreter.NewArrayValueUnownedNonCopying(
+			interpreter.UInt8Value(1),
+		),
+		inter.Globals["z"].GetValue(),
+	)
+
+	assert.Equal(t,
+		interpreter.NewArrayValueUnownedNonCopying(),
+		inter.Globals["emptyReversed"].GetValue(),
+	)
+}
+
+func TestInterpretArraySorted(t *testing.T) {
+
+	t.Parallel()
+
+	inter := parseCheckAndInterpret(t, `
+      let x = [3, -1, 2, 0, 2]
+      let y = x.sorted()
+      let z = [Fix64(1.5), -0.5, Fix64(0.25)].sorted()
+    `)
+
+	assert.Equal(t,
+		interpreter.NewArrayValueUnownedNonCopying(
+			interpreter.NewIntValueFromInt64(-1),
+			interpreter.NewIntValueFromInt64(0),
+			interpreter.NewIntValueFromInt64(2),
+			interpreter.NewIntValueFromInt64(2),
+			interpreter.NewIntValueFromInt64(3),
+		),
+		inter.Globals["y"].GetValue(),
+	)
+
+	// The receiver is unchanged
+
+	assert.Equal(t,
+		interpreter.NewArrayValueUnownedNonCopying(
+			interpreter.NewIntValueFromInt64(3),
+			interpreter.NewIntValueFromInt64(-1),
+			interpreter.NewIntValueFromInt64(2),
+			interpreter.NewIntValueFromInt64(0),
+			interpreter.NewIntValueFromInt64(2),
+		),
+		inter.Globals["x"].GetValue(),
+	)
+
+	assert.Equal(t,
+		interpreter.NewArrayValueUnownedNonCopying(
+			interpreter.Fix64Value(-50_000_000),
+			interpreter.Fix64Value(25_000_000),
+			interpreter.Fix64Value(150_000_000),
+		),
+		inter.Globals["z"].GetValue(),
+	)
+}
+
+func TestInterpretArraySlice(t *testing.T) {
+
+	t.Parallel()
+
+	inter := parseCheckAndInterpret(t, `
+      let x = [1, 2, 3, 4]
+      let y = x.slice(from: 1, upTo: 3)
+      let z = x.slice(from: 2, upTo: 2)
+      let w = x.slice(from: 0, upTo: 4)
+
+      fun test(from: Int, upTo: Int): [Int] {
+          return x.slice(from: from, upTo: upTo)
+      }
+    `)
+
+	assert.Equal(t,
+		interpreter.NewArrayValueUnownedNonCopying(
+			interpreter.NewIntValueFromInt64(2),
+			interpreter.NewIntValueFromInt64(3),
+		),
+		inter.Globals["y"].GetValue(),
+	)
+
+	assert.Equal(t,
+		interpreter.NewArrayValueUnownedNonCopying(),
+		inter.Globals["z"].GetValue(),
+	)
+
+	assert.Equal(t,
+		inter.Globals["x"].GetValue(),
+		inter.Globals["w"].GetValue(),
+	)
+
+	for _, indices := range [][2]int{
+		{-1, 2},
+		{0, 5},
+		{3, 1},
+	} {
+
+		_, err := inter.Invoke(
+			"test",
+			interpreter.NewIntValueFromInt64(int64(indices[0])),
+			interpreter.NewIntValueFromInt64(int64(indices[1])),
+		)
+
+		var sliceErr interpreter.ArraySliceIndicesError
+		require.ErrorAs(t, err, &sliceErr)
+
+		assert.Equal(t, indices[0], sliceErr.FromIndex)
+		assert.Equal(t, indices[1], sliceErr.UpToIndex)
+		assert.Equal(t, 4, sliceErr.Length)
+	}
+}
+
 func TestInterpretArrayInsert(t *testing.T) {
 
 	t.Parallel()
@@ -5039,6 +5308,97 @@ func TestInterpretDictionaryInsert(t *testing.T) {
 	)
 }
 
+func TestInterpretDictionaryMerge(t *testing.T) {
+
+	t.Parallel()
+
+	inter := parseCheckAndInterpret(t, `
+      fun test(overwrite: Bool): {String: Int} {
+          let xs = {"abc": 1, "def": 2}
+          let ys = {"def": 3, "ghi": 4}
+          xs.merge(ys, overwrite: overwrite)
+          return xs
+      }
+    `)
+
+	for _, overwrite := range []bool{false, true} {
+
+		value, err := inter.Invoke("test", interpreter.BoolValue(overwrite))
+		require.NoError(t, err)
+
+		actualDict := value.(*interpreter.DictionaryValue)
+
+		expectedDef := interpreter.NewIntValueFromInt64(2)
+		if overwrite {
+			expectedDef = interpreter.NewIntValueFromInt64(3)
+		}
+
+		expectedEntries := interpreter.NewStringValueOrderedMap()
+		expectedEntries.Set("abc", interpreter.NewIntValueFromInt64(1))
+		expectedEntries.Set("def", expectedDef)
+		expectedEntries.Set("ghi", interpreter.NewIntValueFromInt64(4))
+
+		expectedEntries.Foreach(func(key string, expectedValue interpreter.Value) {
+			actualValue, ok := actualDict.Entries.Get(key)
+			require.True(t, ok)
+			assert.Equal(t, expectedValue, actualValue)
+		})
+
+		assert.Equal(t,
+			[]interpreter.Value{
+				interpreter.NewStringValue("abc"),
+				interpreter.NewStringValue("def"),
+				interpreter.NewStringValue("ghi"),
+			},
+			actualDict.Keys.Values,
+		)
+	}
+}
+
+func TestInterpretResourceDictionaryMerge(t *testing.T) {
+
+	t.Parallel()
+
+	inter := parseCheckAndInterpret(t, `
+      resource R {}
+
+      fun test(): Int {
+          let xs <- {"a": <-create R()}
+          let ys <- {"b": <-create R(), "c": <-create R()}
+          xs.merge(<-ys, overwrite: false)
+          let length = xs.length
+          destroy xs
+          return length
+      }
+
+      fun testExisting() {
+          let xs <- {"a": <-create R()}
+          let ys <- {"b": <-create R(), "a": <-create R()}
+          xs.merge(<-ys, overwrite: false)
+          destroy xs
+      }
+    `)
+
+	value, err := inter.Invoke("test")
+	require.NoError(t, err)
+
+	assert.Equal(t,
+		interpreter.NewIntValueFromInt64(3),
+		value,
+	)
+
+	_, err = inter.Invoke("testExisting")
+	require.Error(t, err)
+
+	var lossErr interpreter.DictionaryMergeResourceLossError
+	require.ErrorAs(t, err, &lossErr)
+
+	assert.Equal(t,
+		interpreter.NewStringValue("a"),
+		lossErr.Key,
+	)
+}
+
 func TestInterpretDictionaryKeys(t *testing.T) {
 
 	t.Parallel()
@@ -5089,6 +5449,150 @@ func TestInterpretDictionaryValues(t *testing.T) {
 	)
 }
 
+func TestInterpretDictionaryForEachKey(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("all keys", func(t *testing.T) {
+
+		inter := parseCheckAndInterpret(t, `
+          fun test(): [String] {
+              let dict = {"def": 2, "abc": 1}
+              dict.insert(key: "a", 3)
+              let keys: [String] = []
+              dict.forEachKey(fun (key: String): Bool {
+                  keys.append(key)
+                  return true
+              })
+              return keys
+          }
+        `)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			interpreter.NewArrayValueUnownedNonCopying(
+				interpreter.NewStringValue("def"),
+				interpreter.NewStringValue("abc"),
+				interpreter.NewStringValue("a"),
+			),
+			value,
+		)
+	})
+
+	t.Run("early termination", func(t *testing.T) {
+
+		inter := parseCheckAndInterpret(t, `
+          fun test(): [Int] {
+              let dict = {1: "a", 2: "b", 3: "c", 4: "d"}
+              let keys: [Int] = []
+              dict.forEachKey(fun (key: Int): Bool {
+                  keys.append(key)
+                  return key < 2
+              })
+              return keys
+          }
+        `)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			interpreter.NewArrayValueUnownedNonCopying(
+				interpreter.NewIntValueFromInt64(1),
+				interpreter.NewIntValueFromInt64(2),
+			),
+			value,
+		)
+	})
+
+	t.Run("resource values", func(t *testing.T) {
+
+		inter := parseCheckAndInterpret(t, `
+          resource R {}
+
+          var count = 0
+
+          fun countKey(_ key: String): Bool {
+              count = count + 1
+              return true
+          }
+
+          fun test(): Int {
+              let rs <- {"a": <-create R(), "b": <-create R()}
+              rs.forEachKey(countKey)
+              destroy rs
+              return count
+          }
+        `)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			interpreter.NewIntValueFromInt64(2),
+			value,
+		)
+	})
+
+	t.Run("insert during iteration", func(t *testing.T) {
+
+		inter := parseCheckAndInterpret(t, `
+          fun test() {
+              let dict = {"a": 1}
+              dict.forEachKey(fun (key: String): Bool {
+                  dict.insert(key: key.concat("!"), 2)
+                  return true
+              })
+          }
+        `)
+
+		_, err := inter.Invoke("test")
+		require.ErrorAs(t, err, &interpreter.ContainerMutatedDuringIterationError{})
+	})
+
+	t.Run("remove during iteration", func(t *testing.T) {
+
+		inter := parseCheckAndInterpret(t, `
+          fun test() {
+              let dict = {"a": 1, "b": 2}
+              dict.forEachKey(fun (key: String): Bool {
+                  dict.remove(key: "b")
+                  return true
+              })
+          }
+        `)
+
+		_, err := inter.Invoke("test")
+		require.ErrorAs(t, err, &interpreter.ContainerMutatedDuringIterationError{})
+	})
+
+	t.Run("update during iteration", func(t *testing.T) {
+
+		inter := parseCheckAndInterpret(t, `
+          fun test(): Int? {
+              let dict = {"a": 1, "b": 2}
+              dict.forEachKey(fun (key: String): Bool {
+                  dict[key] = 3
+                  return true
+              })
+              return dict["b"]
+          }
+        `)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			interpreter.NewSomeValueOwningNonCopying(
+				interpreter.NewIntValueFromInt64(3),
+			),
+			value,
+		)
+	})
+}
+
 func TestInterpretDictionaryKeyTypes(t *testing.T) {
 
 	t.Parallel()
@@ -5851,6 +6355,26 @@ func TestInterpretEmitEvent(t *testing.T) {
 	assert.Equal(t, expectedEvents, actualEvents)
 }
 
+func TestInterpretEmitEventWithoutHandler(t *testing.T) {
+
+	t.Parallel()
+
+	inter := parseCheckAndInterpret(t,
+		`
+          event Transfer(to: Int, from: Int)
+
+          fun test() {
+              emit Transfer(to: 1, from: 2)
+          }
+        `,
+	)
+
+	_, err := inter.Invoke("test")
+	require.Error(t, err)
+
+	require.ErrorAs(t, err, &interpreter.EventEmissionUnavailableError{})
+}
+
 type testValue struct {
 	value              interpreter.Value
 	literal            string
@@ -6341,8 +6865,11 @@ func TestInterpretVariableDeclarationSecondValue(t *testing.T) {
 
 	firstResource := firstValue.(*interpreter.CompositeValue)
 
+	firstResourceID, ok := firstResource.GetField(inter, "id")
+	require.True(t, ok)
+
 	assert.Equal(t,
-		firstResource.GetField("id"),
+		firstResourceID,
 		interpreter.NewIntValueFromInt64(2),
 	)
 
@@ -6360,8 +6887,11 @@ func TestInterpretVariableDeclarationSecondValue(t *testing.T) {
 
 	secondResource := secondValue.(*interpreter.CompositeValue)
 
+	secondResourceID, ok := secondResource.GetField(inter, "id")
+	require.True(t, ok)
+
 	assert.Equal(t,
-		secondResource.GetField("id"),
+		secondResourceID,
 		interpreter.NewIntValueFromInt64(1),
 	)
 }
@@ -7062,6 +7592,92 @@ func TestInterpretFunctionPostConditionWithBeforeInInterface(t *testing.T) {
 	)
 }
 
+func TestInterpretResourceInterfacePostConditionWithBefore(t *testing.T) {
+
+	t.Parallel()
+
+	inter := parseCheckAndInterpret(t, `
+      resource interface Vault {
+          balance: Int
+
+          fun withdraw(amount: Int): @AnyResource{Vault} {
+              post {
+                  self.balance == before(self.balance) - amount
+              }
+          }
+
+          destroy() {
+              post {
+                  before(self.balance) >= 0: "negative balance"
+              }
+          }
+      }
+
+      resource V: Vault {
+          var balance: Int
+
+          init(balance: Int) {
+              self.balance = balance
+          }
+
+          fun withdraw(amount: Int): @AnyResource{Vault} {
+              self.balance = self.balance - amount
+              return <-create V(balance: amount)
+          }
+      }
+
+      resource V2: Vault {
+          var balance: Int
+
+          init(balance: Int) {
+              self.balance = balance
+          }
+
+          fun withdraw(amount: Int): @AnyResource{Vault} {
+              // incorrect: the balance is not decreased
+              return <-create V2(balance: amount)
+          }
+      }
+
+      fun test() {
+          let v <- create V(balance: 3)
+          let w <- v.withdraw(amount: 3)
+          destroy v
+          destroy w
+      }
+
+      fun testWithdraw() {
+          let v <- create V2(balance: 3)
+          let w <- v.withdraw(amount: 3)
+          destroy v
+          destroy w
+      }
+
+      fun testDestroy() {
+          let v <- create V(balance: -1)
+          destroy v
+      }
+    `)
+
+	_, err := inter.Invoke("test")
+	require.NoError(t, err)
+
+	for _, name := range []string{"testWithdraw", "testDestroy"} {
+
+		_, err = inter.Invoke(name)
+		require.IsType(t,
+			interpreter.Error{},
+			err,
+		)
+		interpreterErr := err.(interpreter.Error)
+
+		require.IsType(t,
+			interpreter.ConditionError{},
+			interpreterErr.Err,
+		)
+	}
+}
+
 func TestInterpretContractUseInNestedDeclaration(t *testing.T) {
 
 	t.Parallel()
@@ -7574,6 +8190,96 @@ func TestInterpretOptionalChainingOptionalFieldRead(t *testing.T) {
 	)
 }
 
+func TestInterpretOptionalChainingDictionaryAccess(t *testing.T) {
+
+	t.Parallel()
+
+	const declarations = `
+      struct Test {
+          let x: Int
+
+          init(x: Int) {
+              self.x = x
+          }
+
+          fun y(): Int {
+              return self.x * 2
+          }
+      }
+    `
+
+	t.Run("non-optional values", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, declarations+`
+          let tests: {String: Test} = {"a": Test(x: 21)}
+
+          let x1 = tests["a"]?.x
+          let x2 = tests["b"]?.x
+          let y1 = tests["a"]?.y()
+          let y2 = tests["b"]?.y()
+          let z = tests["b"]?.x ?? 0
+        `)
+
+		twentyOne := interpreter.NewSomeValueOwningNonCopying(
+			interpreter.NewIntValueFromInt64(21),
+		)
+
+		assert.Equal(t, twentyOne, inter.Globals["x1"].GetValue())
+		assert.Equal(t, interpreter.NilValue{}, inter.Globals["x2"].GetValue())
+		assert.Equal(t,
+			interpreter.NewSomeValueOwningNonCopying(
+				interpreter.NewIntValueFromInt64(42),
+			),
+			inter.Globals["y1"].GetValue(),
+		)
+		assert.Equal(t, interpreter.NilValue{}, inter.Globals["y2"].GetValue())
+		assert.Equal(t,
+			interpreter.NewIntValueFromInt64(0),
+			inter.Globals["z"].GetValue(),
+		)
+	})
+
+	t.Run("optional values", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, declarations+`
+          let a: Test? = Test(x: 21)
+          let b: Test? = nil
+          let tests: {String: Test?} = {"a": a, "b": b}
+
+          let x1 = tests["a"]?.x
+          let x2 = tests["b"]?.x
+          let x3 = tests["c"]?.x
+          let y1 = tests["a"]?.y()
+          let y2 = tests["b"]?.y()
+          let y3 = tests["c"]?.y()
+        `)
+
+		assert.Equal(t,
+			interpreter.NewSomeValueOwningNonCopying(
+				interpreter.NewIntValueFromInt64(21),
+			),
+			inter.Globals["x1"].GetValue(),
+		)
+		assert.Equal(t,
+			interpreter.NewSomeValueOwningNonCopying(
+				interpreter.NewIntValueFromInt64(42),
+			),
+			inter.Globals["y1"].GetValue(),
+		)
+
+		for _, name := range []string{"x2", "x3", "y2", "y3"} {
+			assert.Equal(t,
+				interpreter.NilValue{},
+				inter.Globals[name].GetValue(),
+			)
+		}
+	})
+}
+
 func TestInterpretResourceOwnerFieldUse(t *testing.T) {
 
 	t.Parallel()
@@ -22,6 +22,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/onflow/cadence/runtime/common"
 	"github.com/onflow/cadence/runtime/interpreter"
@@ -225,6 +226,52 @@ func TestInterpretMetaTypeIdentifier(t *testing.T) {
 		)
 	})
 
+	t.Run("identifier of value type", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          struct S {}
+
+          resource R {}
+
+          let int = (1).getType().identifier
+          let fix = (1.5).getType().identifier
+          let string = "abc".getType().identifier
+          let address = (0x1 as Address).getType().identifier
+          let structure = S().getType().identifier
+
+          fun resourceIdentifier(): String {
+              let r <- create R()
+              let identifier = r.getType().identifier
+              destroy r
+              return identifier
+          }
+        `)
+
+		for name, expected := range map[string]string{
+			"int":       "Int",
+			"fix":       "UFix64",
+			"string":    "String",
+			"address":   "Address",
+			"structure": "S.test.S",
+		} {
+			assert.Equal(t,
+				interpreter.NewStringValue(expected),
+				inter.Globals[name].GetValue(),
+				name,
+			)
+		}
+
+		result, err := inter.Invoke("resourceIdentifier")
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			interpreter.NewStringValue("S.test.R"),
+			result,
+		)
+	})
+
 	t.Run("unknown", func(t *testing.T) {
 
 		t.Parallel()
@@ -451,3 +498,48 @@ func TestInterpretGetType(t *testing.T) {
 		})
 	}
 }
+
+func TestInterpretMetaTypeIsSubtype(t *testing.T) {
+
+	t.Parallel()
+
+	inter := parseCheckAndInterpret(t, `
+      struct interface I {}
+
+      struct interface J {}
+
+      struct S: I {}
+
+      struct T {}
+
+      let intIsInteger = Type<Int>().isSubtype(of: Type<Integer>())
+      let integerIsInt = Type<Integer>().isSubtype(of: Type<Int>())
+      let intIsOptionalInt = Type<Int>().isSubtype(of: Type<Int?>())
+      let sIsI = Type<S>().isSubtype(of: Type<{I}>())
+      let sIsJ = Type<S>().isSubtype(of: Type<{J}>())
+      let tIsI = Type<T>().isSubtype(of: Type<{I}>())
+      let sIsAnyStruct = Type<S>().isSubtype(of: Type<AnyStruct>())
+      let sIsSelf = Type<S>().isSubtype(of: Type<S>())
+
+      let dynamicAndStaticAgree =
+          S().getType().isSubtype(of: Type<{I}>()) == ((S() as AnyStruct) as? {I} != nil)
+    `)
+
+	for name, expected := range map[string]bool{
+		"intIsInteger":          true,
+		"integerIsInt":          false,
+		"intIsOptionalInt":      true,
+		"sIsI":                  true,
+		"sIsJ":                  false,
+		"tIsI":                  false,
+		"sIsAnyStruct":          true,
+		"sIsSelf":               true,
+		"dynamicAndStaticAgree": true,
+	} {
+		assert.Equal(t,
+			interpreter.BoolValue(expected),
+			inter.Globals[name].GetValue(),
+			name,
+		)
+	}
+}
@@ -28,6 +28,7 @@ import (
 	"github.com/onflow/cadence/runtime/common"
 	"github.com/onflow/cadence/runtime/interpreter"
 	"github.com/onflow/cadence/runtime/sema"
+	"github.com/onflow/cadence/runtime/stdlib"
 	"github.com/onflow/cadence/runtime/tests/checker"
 	"github.com/onflow/cadence/runtime/tests/utils"
 )
@@ -415,3 +416,517 @@ func TestInterpretFunctionInvocationHandler(t *testing.T) {
 		occurrences,
 	)
 }
+
+func TestInterpretFunctionEntryAndReturnHandlers(t *testing.T) {
+
+	t.Parallel()
+
+	type event struct {
+		kind   string
+		name   string
+		values []interpreter.Value
+	}
+
+	var events []event
+
+	inter := parseCheckAndInterpretWithOptions(t,
+		`
+          struct S {
+              let x: Int
+
+              init(x: Int) {
+                  self.x = x
+              }
+
+              fun double(): Int {
+                  return self.x * 2
+              }
+          }
+
+          fun test(_ x: Int): Int {
+              let s = S(x: x)
+              let inc = fun (_ y: Int): Int {
+                  return y + 1
+              }
+              return inc(s.double())
+          }
+        `,
+		ParseCheckAndInterpretOptions{
+			Options: []interpreter.Option{
+				interpreter.WithOnFunctionEntryHandler(
+					func(_ *interpreter.Interpreter, name string, arguments []interpreter.Value) {
+						events = append(events, event{"entry", name, arguments})
+					},
+				),
+				interpreter.WithOnFunctionReturnHandler(
+					func(_ *interpreter.Interpreter, name string, result interpreter.Value) {
+						events = append(events, event{"return", name, []interpreter.Value{result}})
+					},
+				),
+			},
+		},
+	)
+
+	value, err := inter.Invoke("test", interpreter.NewIntValueFromInt64(3))
+	require.NoError(t, err)
+
+	assert.Equal(t, interpreter.NewIntValueFromInt64(7), value)
+
+	newInt := interpreter.NewIntValueFromInt64
+
+	assert.Equal(t,
+		[]event{
+			{"entry", "test", []interpreter.Value{newInt(3)}},
+			{"entry", "init", []interpreter.Value{newInt(3)}},
+			{"return", "init", []interpreter.Value{interpreter.VoidValue{}}},
+			{"entry", "double", []interpreter.Value{}},
+			{"return", "double", []interpreter.Value{newInt(6)}},
+			{"entry", "", []interpreter.Value{newInt(6)}},
+			{"return", "", []interpreter.Value{newInt(7)}},
+			{"return", "test", []interpreter.Value{newInt(7)}},
+		},
+		events,
+	)
+}
+
+func TestInterpretMaxLoopIterations(t *testing.T) {
+
+	t.Parallel()
+
+	const code = `
+       fun test(n: Int): Int {
+           var i = 0
+           while i < n {
+               i = i + 1
+           }
+           for x in [1, 2] {
+               i = i + x
+           }
+           return i
+       }
+    `
+
+	newInterpreter := func(t *testing.T) *interpreter.Interpreter {
+		return parseCheckAndInterpretWithOptions(t,
+			code,
+			ParseCheckAndInterpretOptions{
+				Options: []interpreter.Option{
+					interpreter.WithMaxLoopIterations(5),
+				},
+			},
+		)
+	}
+
+	t.Run("within limit", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := newInterpreter(t)
+
+		value, err := inter.Invoke("test", interpreter.NewIntValueFromInt64(3))
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			interpreter.NewIntValueFromInt64(6),
+			value,
+		)
+	})
+
+	t.Run("exceeded", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := newInterpreter(t)
+
+		_, err := inter.Invoke("test", interpreter.NewIntValueFromInt64(4))
+		require.Error(t, err)
+
+		var loopLimitErr interpreter.LoopLimitExceededError
+		require.ErrorAs(t, err, &loopLimitErr)
+		assert.Equal(t, uint64(5), loopLimitErr.Limit)
+	})
+
+	t.Run("reset per invocation", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := newInterpreter(t)
+
+		for i := 0; i < 3; i++ {
+			_, err := inter.Invoke("test", interpreter.NewIntValueFromInt64(3))
+			require.NoError(t, err)
+		}
+	})
+}
+
+func TestInterpretComputationWeights(t *testing.T) {
+
+	t.Parallel()
+
+	const code = `
+       fun f() {}
+
+       fun test(n: Int) {
+           var i = 0
+           while i < n {
+               i = i + 1
+           }
+           let xs = [1, 2]
+           f()
+       }
+    `
+
+	newInterpreter := func(t *testing.T, weights map[interpreter.ComputationKind]uint64) *interpreter.Interpreter {
+		return parseCheckAndInterpretWithOptions(t,
+			code,
+			ParseCheckAndInterpretOptions{
+				Options: []interpreter.Option{
+					interpreter.WithComputationWeights(weights),
+				},
+			},
+		)
+	}
+
+	t.Run("default weights", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := newInterpreter(t, nil)
+
+		_, err := inter.Invoke("test", interpreter.NewIntValueFromInt64(3))
+		require.NoError(t, err)
+
+		// 3 loop iterations, 1 array allocation, 1 function invocation
+		assert.Equal(t, uint64(5), inter.ComputationUsed())
+	})
+
+	t.Run("configured weights", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := newInterpreter(t, map[interpreter.ComputationKind]uint64{
+			interpreter.ComputationKindLoopIteration:      10,
+			interpreter.ComputationKindFunctionInvocation: 100,
+		})
+
+		_, err := inter.Invoke("test", interpreter.NewIntValueFromInt64(3))
+		require.NoError(t, err)
+
+		assert.Equal(t, uint64(3*10+1+100), inter.ComputationUsed())
+	})
+
+	t.Run("reset per invocation", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := newInterpreter(t, nil)
+
+		for i := 0; i < 3; i++ {
+			_, err := inter.Invoke("test", interpreter.NewIntValueFromInt64(2))
+			require.NoError(t, err)
+
+			assert.Equal(t, uint64(4), inter.ComputationUsed())
+		}
+	})
+}
+
+func TestInterpretComputationUsedPerTopLevelExecution(t *testing.T) {
+
+	t.Parallel()
+
+	// callFunction invokes the given function value from the host environment,
+	// so the invocation is nested in the current top-level execution
+
+	callFunction := stdlib.NewStandardLibraryFunction(
+		"call",
+		&sema.FunctionType{
+			Parameters: []*sema.Parameter{
+				{
+					Label:      sema.ArgumentLabelNotRequired,
+					Identifier: "f",
+					TypeAnnotation: sema.NewTypeAnnotation(
+						&sema.FunctionType{
+							ReturnTypeAnnotation: sema.NewTypeAnnotation(sema.VoidType),
+						},
+					),
+				},
+			},
+			ReturnTypeAnnotation: sema.NewTypeAnnotation(sema.VoidType),
+		},
+		func(invocation interpreter.Invocation) interpreter.Value {
+			_, err := invocation.Interpreter.InvokeFunctionValue(
+				invocation.Arguments[0].(interpreter.FunctionValue),
+				nil,
+				nil,
+				nil,
+				ast.Range{},
+			)
+			if err != nil {
+				panic(err)
+			}
+			return interpreter.VoidValue{}
+		},
+	)
+
+	const code = `
+       let xs = [1, 2]
+
+       fun f() {
+           let ys = [3]
+       }
+
+       fun test() {
+           let zs = [4]
+           call(f)
+       }
+    `
+
+	newInterpreter := func(t *testing.T) *interpreter.Interpreter {
+		return parseCheckAndInterpretWithOptions(t,
+			code,
+			ParseCheckAndInterpretOptions{
+				CheckerOptions: []sema.Option{
+					sema.WithPredeclaredValues(
+						[]sema.ValueDeclaration{
+							callFunction,
+						},
+					),
+				},
+				Options: []interpreter.Option{
+					interpreter.WithPredeclaredValues(
+						[]interpreter.ValueDeclaration{
+							callFunction,
+						},
+					),
+				},
+			},
+		)
+	}
+
+	t.Run("global declarations", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := newInterpreter(t)
+
+		// 1 array allocation
+		assert.Equal(t, uint64(1), inter.ComputationUsed())
+
+		// The first invocation continues the interpretation of the global declarations,
+		// and the nested invocation of the function value does not reset the counter.
+		// 2 array allocations, 1 function invocation
+
+		_, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		assert.Equal(t, uint64(1+3), inter.ComputationUsed())
+
+		// The following invocations are new top-level executions
+
+		_, err = inter.Invoke("test")
+		require.NoError(t, err)
+
+		assert.Equal(t, uint64(3), inter.ComputationUsed())
+	})
+
+	t.Run("function value invocation", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := newInterpreter(t)
+
+		_, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		// The invocation of a function value by the host environment is a new top-level execution.
+		// 1 array allocation
+
+		f := inter.Globals["f"].GetValue().(interpreter.FunctionValue)
+
+		_, err = inter.InvokeFunctionValue(f, nil, nil, nil, ast.Range{})
+		require.NoError(t, err)
+
+		assert.Equal(t, uint64(1), inter.ComputationUsed())
+	})
+}
+
+func TestInterpretOnResourceDestroyed(t *testing.T) {
+
+	t.Parallel()
+
+	const code = `
+       resource Inner {
+           let id: Int
+
+           init(id: Int) {
+               self.id = id
+           }
+       }
+
+       resource Outer {
+           let inner: @Inner
+           let inners: @[Inner]
+
+           init() {
+               self.inner <- create Inner(id: 1)
+               self.inners <- [<-create Inner(id: 2), <-create Inner(id: 3)]
+           }
+
+           destroy() {
+               destroy self.inner
+               destroy self.inners
+           }
+       }
+
+       fun test() {
+           let outer <- create Outer()
+           destroy outer
+       }
+    `
+
+	type destruction struct {
+		identifier string
+		id         interpreter.Value
+		line       int
+	}
+
+	var destructions []destruction
+
+	inter := parseCheckAndInterpretWithOptions(t,
+		code,
+		ParseCheckAndInterpretOptions{
+			Options: []interpreter.Option{
+				interpreter.WithOnResourceDestroyedHandler(
+					func(_ *interpreter.Interpreter, value *interpreter.CompositeValue, pos ast.Position) {
+						id, _ := value.Fields.Get("id")
+
+						destructions = append(destructions, destruction{
+							identifier: value.QualifiedIdentifier,
+							id:         id,
+							line:       pos.Line,
+						})
+					},
+				),
+			},
+		},
+	)
+
+	_, err := inter.Invoke("test")
+	require.NoError(t, err)
+
+	assert.Equal(t,
+		[]destruction{
+			{"Inner", interpreter.NewIntValueFromInt64(1), 20},
+			{"Inner", interpreter.NewIntValueFromInt64(2), 21},
+			{"Inner", interpreter.NewIntValueFromInt64(3), 21},
+			{"Outer", nil, 27},
+		},
+		destructions,
+	)
+}
+
+func TestInterpretMaxCallDepth(t *testing.T) {
+
+	t.Parallel()
+
+	const code = `
+       fun recurse(_ n: Int): Int {
+           if n == 0 {
+               return 0
+           }
+           return recurse(n - 1) + 1
+       }
+
+       fun isEven(_ n: Int): Bool {
+           if n == 0 {
+               return true
+           }
+           return isOdd(n - 1)
+       }
+
+       fun isOdd(_ n: Int): Bool {
+           if n == 0 {
+               return false
+           }
+           return isEven(n - 1)
+       }
+
+       struct Counter {
+           fun count(_ n: Int): Int {
+               if n == 0 {
+                   return 0
+               }
+               return self.count(n - 1) + 1
+           }
+       }
+
+       fun testRecursion(_ n: Int): Int {
+           return recurse(n)
+       }
+
+       fun testMutualRecursion(_ n: Int): Bool {
+           return isEven(n)
+       }
+
+       fun testMethodRecursion(_ n: Int): Int {
+           return Counter().count(n)
+       }
+    `
+
+	newInterpreter := func(t *testing.T, maxCallDepth uint64) *interpreter.Interpreter {
+		return parseCheckAndInterpretWithOptions(t,
+			code,
+			ParseCheckAndInterpretOptions{
+				Options: []interpreter.Option{
+					interpreter.WithMaxCallDepth(maxCallDepth),
+				},
+			},
+		)
+	}
+
+	// The test function is at depth 1,
+	// and the recursion with argument n adds n + 1 invocations,
+	// so the maximum depth is n + 2
+
+	for _, name := range []string{
+		"testRecursion",
+		"testMutualRecursion",
+		"testMethodRecursion",
+	} {
+		name := name
+
+		t.Run(name, func(t *testing.T) {
+
+			t.Parallel()
+
+			inter := newInterpreter(t, 10)
+
+			_, err := inter.Invoke(name, interpreter.NewIntValueFromInt64(8))
+			require.NoError(t, err)
+
+			_, err = inter.Invoke(name, interpreter.NewIntValueFromInt64(9))
+			require.Error(t, err)
+
+			var callStackErr interpreter.CallStackLimitExceededError
+			require.ErrorAs(t, err, &callStackErr)
+			assert.Equal(t, uint64(10), callStackErr.Limit)
+
+			// The depth is reset per invocation
+
+			_, err = inter.Invoke(name, interpreter.NewIntValueFromInt64(8))
+			require.NoError(t, err)
+		})
+	}
+
+	t.Run("unlimited", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := newInterpreter(t, 0)
+
+		value, err := inter.Invoke("testRecursion", interpreter.NewIntValueFromInt64(100))
+		require.NoError(t, err)
+
+		assert.Equal(t, interpreter.NewIntValueFromInt64(100), value)
+	})
+}
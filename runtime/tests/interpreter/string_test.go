@@ -0,0 +1,666 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2020 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package interpreter_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence/runtime/interpreter"
+)
+
+func TestInterpretStringToLowerAndToUpper(t *testing.T) {
+
+	t.Parallel()
+
+	type test struct {
+		string string
+		lower  string
+		upper  string
+	}
+
+	tests := []test{
+		{"", "", ""},
+		{"Hello, World!", "hello, world!", "HELLO, WORLD!"},
+		{"ÄÖÜ äöü", "äöü äöü", "ÄÖÜ ÄÖÜ"},
+		// Full case mappings, independent of the locale
+		{"İ", "i̇", "İ"},
+		{"ß", "ß", "SS"},
+		{"Straße", "straße", "STRASSE"},
+		{"ﬁ", "ﬁ", "FI"},
+		// Final sigma
+		{"ΣΑΣ", "σας", "ΣΑΣ"},
+		{"😀", "😀", "😀"},
+	}
+
+	for _, test := range tests {
+
+		t.Run(test.string, func(t *testing.T) {
+
+			inter := parseCheckAndInterpret(t,
+				fmt.Sprintf(
+					`
+                      let s = %q
+                      let lower = s.toLower()
+                      let upper = s.toUpper()
+                    `,
+					test.string,
+				),
+			)
+
+			assert.Equal(t,
+				interpreter.NewStringValue(test.lower),
+				inter.Globals["lower"].GetValue(),
+			)
+
+			assert.Equal(t,
+				interpreter.NewStringValue(test.upper),
+				inter.Globals["upper"].GetValue(),
+			)
+
+			// The original string is not modified
+
+			assert.Equal(t,
+				interpreter.NewStringValue(test.string),
+				inter.Globals["s"].GetValue(),
+			)
+		})
+	}
+}
+
+func TestInterpretStringTrim(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("whitespace", func(t *testing.T) {
+
+		t.Parallel()
+
+		type test struct {
+			literal  string
+			expected string
+		}
+
+		tests := []test{
+			{`""`, ""},
+			{`"abc"`, "abc"},
+			{`"  abc  "`, "abc"},
+			{`"\t\n abc \r\n"`, "abc"},
+			{`" a b c "`, "a b c"},
+			// U+00A0 NO-BREAK SPACE
+			{`"\u{A0}abc\u{A0}"`, "abc"},
+			// U+3000 IDEOGRAPHIC SPACE
+			{`"\u{3000}äöü\u{3000}"`, "äöü"},
+			{`"   "`, ""},
+		}
+
+		for _, test := range tests {
+
+			inter := parseCheckAndInterpret(t,
+				fmt.Sprintf(
+					`
+                      let result = %s.trim()
+                    `,
+					test.literal,
+				),
+			)
+
+			assert.Equal(t,
+				interpreter.NewStringValue(test.expected),
+				inter.Globals["result"].GetValue(),
+				test.literal,
+			)
+		}
+	})
+
+	t.Run("cutset", func(t *testing.T) {
+
+		t.Parallel()
+
+		type test struct {
+			string   string
+			cutset   string
+			expected string
+		}
+
+		tests := []test{
+			{"", "x", ""},
+			{"xxabcxx", "x", "abc"},
+			{"xyabcyx", "xy", "abc"},
+			{"  abc  ", "", "  abc  "},
+			{"€€abc€", "€", "abc"},
+			{"😀ab😀c😀", "😀", "ab😀c"},
+			{"äbcä", "ä", "bc"},
+			{"abc", "abc", ""},
+		}
+
+		for _, test := range tests {
+
+			inter := parseCheckAndInterpret(t,
+				fmt.Sprintf(
+					`
+                      let result = %q.trim(%q)
+                    `,
+					test.string,
+					test.cutset,
+				),
+			)
+
+			assert.Equal(t,
+				interpreter.NewStringValue(test.expected),
+				inter.Globals["result"].GetValue(),
+				fmt.Sprintf("%q.trim(%q)", test.string, test.cutset),
+			)
+		}
+	})
+}
+
+func TestInterpretStringPredicates(t *testing.T) {
+
+	t.Parallel()
+
+	type test struct {
+		string     string
+		argument   string
+		contains   bool
+		startsWith bool
+		endsWith   bool
+	}
+
+	tests := []test{
+		{"", "", true, true, true},
+		{"abc", "", true, true, true},
+		{"", "a", false, false, false},
+		{"abc", "abc", true, true, true},
+		{"abc", "a", true, true, false},
+		{"abc", "b", true, false, false},
+		{"abc", "c", true, false, true},
+		{"abc", "abcd", false, false, false},
+		{"abc", "ac", false, false, false},
+		{"äöü", "ö", true, false, false},
+		{"äöü", "äö", true, true, false},
+		{"äöü", "öü", true, false, true},
+		// The encodings of ä (0xC3 0xA4) and ₤ (0xE2 0x82 0xA4) share the last byte,
+		// and the encodings of ä and Ä (0xC3 0x84) share the first byte
+		{"₤", "ä", false, false, false},
+		{"ä", "Ä", false, false, false},
+		{"₤", "\u00a4", false, false, false},
+		{"😀😁", "😁", true, false, true},
+		{"😀😁", "😀", true, true, false},
+		{"😀", "😁", false, false, false},
+	}
+
+	for _, test := range tests {
+
+		inter := parseCheckAndInterpret(t,
+			fmt.Sprintf(
+				`
+                  let s = %[1]q
+                  let contains = s.contains(%[2]q)
+                  let startsWith = s.startsWith(%[2]q)
+                  let endsWith = s.endsWith(%[2]q)
+                `,
+				test.string,
+				test.argument,
+			),
+		)
+
+		message := fmt.Sprintf("%q, %q", test.string, test.argument)
+
+		assert.Equal(t,
+			interpreter.BoolValue(test.contains),
+			inter.Globals["contains"].GetValue(),
+			message,
+		)
+
+		assert.Equal(t,
+			interpreter.BoolValue(test.startsWith),
+			inter.Globals["startsWith"].GetValue(),
+			message,
+		)
+
+		assert.Equal(t,
+			interpreter.BoolValue(test.endsWith),
+			inter.Globals["endsWith"].GetValue(),
+			message,
+		)
+	}
+}
+
+func TestInterpretStringDecodeHex(t *testing.T) {
+
+	t.Parallel()
+
+	type test struct {
+		string   string
+		expected []byte
+	}
+
+	tests := []test{
+		{"", []byte{}},
+		{"00", []byte{0x0}},
+		{"0123456789abcdef", []byte{0x1, 0x23, 0x45, 0x67, 0x89, 0xab, 0xcd, 0xef}},
+		{"0123456789ABCDEF", []byte{0x1, 0x23, 0x45, 0x67, 0x89, 0xab, 0xcd, 0xef}},
+		{"436164656e636521", []byte("Cadence!")},
+	}
+
+	for _, test := range tests {
+
+		inter := parseCheckAndInterpret(t,
+			fmt.Sprintf(
+				`
+                  fun test(): [UInt8] {
+                      return %q.decodeHex()
+                  }
+                `,
+				test.string,
+			),
+		)
+
+		result, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			interpreter.ByteSliceToByteArrayValue(test.expected),
+			result,
+			test.string,
+		)
+	}
+
+	for _, invalid := range []string{"0", "abc", "0g", "zz", " 00", "0x00", "ä0"} {
+
+		inter := parseCheckAndInterpret(t,
+			fmt.Sprintf(
+				`
+                  fun test(): [UInt8] {
+                      return %q.decodeHex()
+                  }
+                `,
+				invalid,
+			),
+		)
+
+		_, err := inter.Invoke("test")
+		require.Error(t, err, invalid)
+
+		require.ErrorAs(t, err, &interpreter.InvalidHexError{}, invalid)
+	}
+}
+
+func TestInterpretStringBase64(t *testing.T) {
+
+	t.Parallel()
+
+	type test struct {
+		data     []byte
+		standard string
+		urlSafe  string
+	}
+
+	// Test vectors from RFC 4648, section 10,
+	// and bytes which are encoded differently in the URL-safe alphabet
+
+	tests := []test{
+		{[]byte(""), "", ""},
+		{[]byte("f"), "Zg==", "Zg=="},
+		{[]byte("fo"), "Zm8=", "Zm8="},
+		{[]byte("foo"), "Zm9v", "Zm9v"},
+		{[]byte("foob"), "Zm9vYg==", "Zm9vYg=="},
+		{[]byte("fooba"), "Zm9vYmE=", "Zm9vYmE="},
+		{[]byte("foobar"), "Zm9vYmFy", "Zm9vYmFy"},
+		{[]byte{0xfb, 0xff, 0xbf}, "+/+/", "-_-_"},
+	}
+
+	for _, test := range tests {
+
+		test := test
+
+		t.Run(test.standard, func(t *testing.T) {
+
+			t.Parallel()
+
+			inter := parseCheckAndInterpret(t,
+				fmt.Sprintf(
+					`
+                      let data = "%x".decodeHex()
+                      let standard = String.encodeBase64(data)
+                      let urlSafe = String.encodeBase64(data, urlSafe: true)
+                      let decodedStandard = String.decodeBase64("%s")
+                      let decodedURLSafe = String.decodeBase64("%s", urlSafe: true)
+                    `,
+					test.data,
+					test.standard,
+					test.urlSafe,
+				),
+			)
+
+			assert.Equal(t,
+				interpreter.NewStringValue(test.standard),
+				inter.Globals["standard"].GetValue(),
+			)
+
+			assert.Equal(t,
+				interpreter.NewStringValue(test.urlSafe),
+				inter.Globals["urlSafe"].GetValue(),
+			)
+
+			expectedData := interpreter.NewSomeValueOwningNonCopying(
+				interpreter.ByteSliceToByteArrayValue(test.data),
+			)
+
+			assert.Equal(t,
+				expectedData,
+				inter.Globals["decodedStandard"].GetValue(),
+			)
+
+			assert.Equal(t,
+				expectedData,
+				inter.Globals["decodedURLSafe"].GetValue(),
+			)
+		})
+	}
+
+	t.Run("malformed", func(t *testing.T) {
+
+		t.Parallel()
+
+		for _, code := range []string{
+			// invalid characters
+			`String.decodeBase64("Zm9v!")`,
+			// missing padding
+			`String.decodeBase64("Zg")`,
+			// URL-safe alphabet in standard encoding
+			`String.decodeBase64("-_-_")`,
+			// standard alphabet in URL-safe encoding
+			`String.decodeBase64("+/+/", urlSafe: true)`,
+		} {
+			inter := parseCheckAndInterpret(t,
+				fmt.Sprintf(
+					`
+                      let result = %s
+                    `,
+					code,
+				),
+			)
+
+			assert.Equal(t,
+				interpreter.NilValue{},
+				inter.Globals["result"].GetValue(),
+				code,
+			)
+		}
+	})
+
+	t.Run("empty string", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          let result = String()
+        `)
+
+		assert.Equal(t,
+			interpreter.NewStringValue(""),
+			inter.Globals["result"].GetValue(),
+		)
+	})
+}
+
+func TestInterpretRawString(t *testing.T) {
+
+	t.Parallel()
+
+	inter := parseCheckAndInterpret(t, `
+      fun test(): String {
+          let json = """
+              {
+                "name": "Cadence",
+                "escaped": "\n"
+              }
+              """
+          return json
+      }
+
+      let length = """a\nb""".length
+    `)
+
+	value, err := inter.Invoke("test")
+	require.NoError(t, err)
+
+	assert.Equal(t,
+		interpreter.NewStringValue("{\n  \"name\": \"Cadence\",\n  \"escaped\": \"\\n\"\n}"),
+		value,
+	)
+
+	assert.Equal(t,
+		interpreter.NewIntValueFromInt64(4),
+		inter.Globals["length"].GetValue(),
+	)
+}
+
+func TestInterpretStringLength(t *testing.T) {
+
+	t.Parallel()
+
+	tests := map[string]struct {
+		code   string
+		length int64
+	}{
+		"ASCII":                      {`"abc"`, 3},
+		"empty":                      {`""`, 0},
+		"skin tone modifier":         {`"\u{1F44D}\u{1F3FD}"`, 1},
+		"zero width joiner":          {`"\u{1F468}\u{200D}\u{1F469}\u{200D}\u{1F467}"`, 1},
+		"flag":                       {`"\u{1F1E8}\u{1F1E6}"`, 1},
+		"combining marks":            {`"e\u{301}\u{302}"`, 1},
+		"mixed":                      {`"a\u{1F44D}\u{1F3FD}e\u{301}"`, 3},
+		"keycap":                     {`"1\u{FE0F}\u{20E3}"`, 1},
+		"precomposed and decomposed": {`"\u{E9}e\u{301}"`, 2},
+	}
+
+	for name, test := range tests {
+
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+
+			t.Parallel()
+
+			inter := parseCheckAndInterpret(t,
+				fmt.Sprintf(
+					`
+                      let length = %s.length
+                    `,
+					test.code,
+				),
+			)
+
+			assert.Equal(t,
+				interpreter.NewIntValueFromInt64(test.length),
+				inter.Globals["length"].GetValue(),
+			)
+		})
+	}
+}
+
+func TestInterpretStringUTF8(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("valid", func(t *testing.T) {
+
+		t.Parallel()
+
+		for _, str := range []string{
+			"",
+			"abc",
+			"é",
+			"☃",
+			"\U0001F44D\U0001F3FD",
+		} {
+			inter := parseCheckAndInterpret(t,
+				fmt.Sprintf(
+					`
+                      let data = "%x".decodeHex()
+                      let string = String.fromUTF8(data)
+                      let bytes = string!.utf8
+                    `,
+					str,
+				),
+			)
+
+			assert.Equal(t,
+				interpreter.NewSomeValueOwningNonCopying(
+					interpreter.NewStringValue(str),
+				),
+				inter.Globals["string"].GetValue(),
+				str,
+			)
+
+			assert.Equal(t,
+				interpreter.ByteSliceToByteArrayValue([]byte(str)),
+				inter.Globals["bytes"].GetValue(),
+				str,
+			)
+		}
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+
+		t.Parallel()
+
+		for name, data := range map[string][]byte{
+			"truncated":                 {0xe2, 0x98},
+			"unexpected continuation":   {0x80},
+			"invalid byte":              {0xff},
+			"overlong slash":            {0xc0, 0xaf},
+			"overlong NUL":              {0xe0, 0x80, 0x80},
+			"overlong four bytes":       {0xf0, 0x82, 0x82, 0xac},
+			"lone high surrogate":       {0xed, 0xa0, 0x80},
+			"lone low surrogate":        {0xed, 0xbf, 0xbf},
+			"surrogate pair":            {0xed, 0xa0, 0xbd, 0xed, 0xb2, 0xa9},
+			"above maximum code point":  {0xf4, 0x90, 0x80, 0x80},
+			"valid prefix, invalid end": {0x61, 0x62, 0xc3},
+		} {
+			inter := parseCheckAndInterpret(t,
+				fmt.Sprintf(
+					`
+                      let data = "%x".decodeHex()
+                      let string = String.fromUTF8(data)
+                    `,
+					data,
+				),
+			)
+
+			assert.Equal(t,
+				interpreter.NilValue{},
+				inter.Globals["string"].GetValue(),
+				name,
+			)
+		}
+	})
+}
+
+func TestInterpretInternedStrings(t *testing.T) {
+
+	t.Parallel()
+
+	inter := parseCheckAndInterpretWithOptions(t,
+		`
+          let a = "test"
+          let b = "test"
+          let c = "other"
+          let equal = a == b
+          let unequal = a == c
+          let concatenated = a.concat(b)
+        `,
+		ParseCheckAndInterpretOptions{
+			Options: []interpreter.Option{
+				interpreter.WithStringsInterned(true),
+			},
+		},
+	)
+
+	assert.Equal(t,
+		interpreter.NewStringValue("test"),
+		inter.Globals["a"].GetValue(),
+	)
+
+	assert.Equal(t,
+		interpreter.BoolValue(true),
+		inter.Globals["equal"].GetValue(),
+	)
+
+	assert.Equal(t,
+		interpreter.BoolValue(false),
+		inter.Globals["unequal"].GetValue(),
+	)
+
+	assert.Equal(t,
+		interpreter.NewStringValue("testtest"),
+		inter.Globals["concatenated"].GetValue(),
+	)
+}
+
+func BenchmarkInterpretStringLiterals(b *testing.B) {
+
+	const code = `
+      fun test(): Int {
+          let names = ["alice", "bob", "charlie"]
+          var count = 0
+          var i = 0
+          while i < 100 {
+              let name = names[i % 3]
+              if name == "alice" || name == "bob" {
+                  count = count + 1
+              }
+              i = i + 1
+          }
+          return count
+      }
+    `
+
+	for _, interned := range []bool{false, true} {
+
+		b.Run(fmt.Sprintf("interned: %t", interned), func(b *testing.B) {
+
+			inter := parseCheckAndInterpretWithOptions(b,
+				code,
+				ParseCheckAndInterpretOptions{
+					Options: []interpreter.Option{
+						interpreter.WithStringsInterned(interned),
+					},
+				},
+			)
+
+			expected := interpreter.NewIntValueFromInt64(67)
+
+			b.ReportAllocs()
+			b.ResetTimer()
+
+			for i := 0; i < b.N; i++ {
+				result, err := inter.Invoke("test")
+				require.NoError(b, err)
+				require.Equal(b, expected, result)
+			}
+		})
+	}
+}
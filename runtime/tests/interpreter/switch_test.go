@@ -188,3 +188,102 @@ func TestInterpretSwitchStatement(t *testing.T) {
 		}
 	})
 }
+
+func TestInterpretSwitchExpression(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("Int", func(t *testing.T) {
+
+		inter := parseCheckAndInterpret(t, `
+          fun test(_ x: Int): String {
+              return switch x { case 1: "one"; case 2: "two"; default: "many" }
+          }
+        `)
+
+		for argument, expected := range map[int64]string{
+			1: "one",
+			2: "two",
+			3: "many",
+		} {
+
+			actual, err := inter.Invoke("test", interpreter.NewIntValueFromInt64(argument))
+			require.NoError(t, err)
+
+			assert.Equal(t, interpreter.NewStringValue(expected), actual)
+		}
+	})
+
+	t.Run("optional", func(t *testing.T) {
+
+		inter := parseCheckAndInterpret(t, `
+          let x = 1
+          let y = switch x { case 1: 2; default: nil }
+        `)
+
+		assert.Equal(t,
+			interpreter.NewSomeValueOwningNonCopying(
+				interpreter.NewIntValueFromInt64(2),
+			),
+			inter.Globals["y"].GetValue(),
+		)
+	})
+
+	t.Run("enum", func(t *testing.T) {
+
+		inter := parseCheckAndInterpret(t, `
+          enum E: UInt8 {
+              case a
+              case b
+          }
+
+          fun test(_ e: E): Int {
+              return switch e {
+                  case E.a: 1
+                  case E.b: 2
+              }
+          }
+
+          let a = test(E.a)
+          let b = test(E.b)
+        `)
+
+		assert.Equal(t,
+			interpreter.NewIntValueFromInt64(1),
+			inter.Globals["a"].GetValue(),
+		)
+
+		assert.Equal(t,
+			interpreter.NewIntValueFromInt64(2),
+			inter.Globals["b"].GetValue(),
+		)
+	})
+
+	t.Run("resource", func(t *testing.T) {
+
+		inter := parseCheckAndInterpret(t, `
+          resource R {
+              let id: Int
+
+              init(id: Int) {
+                  self.id = id
+              }
+          }
+
+          fun test(_ x: Int): Int {
+              let r <- switch x {
+                  case 1: <-create R(id: 1)
+                  default: <-create R(id: 2)
+              }
+              let id = r.id
+              destroy r
+              return id
+          }
+        `)
+
+		actual, err := inter.Invoke("test", interpreter.NewIntValueFromInt64(2))
+		require.NoError(t, err)
+
+		assert.Equal(t, interpreter.NewIntValueFromInt64(2), actual)
+	})
+}
@@ -0,0 +1,142 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2020 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package interpreter_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence/runtime/interpreter"
+)
+
+func TestInterpretTupleVariableDeclaration(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("function result", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          fun divMod(_ a: Int, _ b: Int): (Int, Int) {
+              return (a / b, a % b)
+          }
+
+          fun test(): [Int] {
+              let (quotient, remainder) = divMod(7, 2)
+              return [quotient, remainder]
+          }
+        `)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			interpreter.NewArrayValueUnownedNonCopying(
+				interpreter.NewIntValueFromInt64(3),
+				interpreter.NewIntValueFromInt64(1),
+			),
+			value,
+		)
+	})
+
+	t.Run("literal with type annotation", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          fun test(): String? {
+              let (a, b): (UInt8, String?) = (1, "a")
+              let c: UInt8 = a
+              return b
+          }
+
+          fun testElement(): UInt8 {
+              let (a, b): (UInt8, String?) = (1, "a")
+              return a
+          }
+        `)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			interpreter.NewSomeValueOwningNonCopying(
+				interpreter.NewStringValue("a"),
+			),
+			value,
+		)
+
+		value, err = inter.Invoke("testElement")
+		require.NoError(t, err)
+
+		assert.Equal(t, interpreter.UInt8Value(1), value)
+	})
+
+	t.Run("variable elements", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          fun test(): Int {
+              var (a, b) = (1, 2)
+              a = a + 10
+              return a + b
+          }
+        `)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		assert.Equal(t, interpreter.NewIntValueFromInt64(13), value)
+	})
+}
+
+func TestInterpretTupleResource(t *testing.T) {
+
+	t.Parallel()
+
+	inter := parseCheckAndInterpret(t, `
+      resource R {
+          let id: Int
+
+          init(id: Int) {
+              self.id = id
+          }
+      }
+
+      fun make(): @(@R, Int) {
+          return <-(<-create R(id: 1), 2)
+      }
+
+      fun test(): Int {
+          let (r, n) <- make()
+          let id = r.id
+          destroy r
+          return id + n
+      }
+    `)
+
+	value, err := inter.Invoke("test")
+	require.NoError(t, err)
+
+	assert.Equal(t, interpreter.NewIntValueFromInt64(3), value)
+}
@@ -130,3 +130,233 @@ func TestInterpretWhileStatementWithBreak(t *testing.T) {
 		value,
 	)
 }
+
+func TestInterpretLabeledBreakAndContinue(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("break", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+           fun test(): Int {
+               var count = 0
+               outer: while true {
+                   while true {
+                       count = count + 1
+                       break outer
+                   }
+                   count = count + 10
+               }
+               return count
+           }
+        `)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			interpreter.NewIntValueFromInt64(1),
+			value,
+		)
+	})
+
+	t.Run("continue", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+           fun test(): Int {
+               var count = 0
+               outer: for x in [1, 2, 3] {
+                   for y in [1, 2, 3] {
+                       if y == 2 {
+                           continue outer
+                       }
+                       count = count + 1
+                   }
+                   count = count + 10
+               }
+               return count
+           }
+        `)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			interpreter.NewIntValueFromInt64(3),
+			value,
+		)
+	})
+
+	t.Run("break in switch", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+           fun test(): Int {
+               var count = 0
+               outer: while true {
+                   count = count + 1
+                   switch count {
+                   case 1:
+                       break
+                   case 2:
+                       break outer
+                   }
+               }
+               return count
+           }
+        `)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			interpreter.NewIntValueFromInt64(2),
+			value,
+		)
+	})
+
+	t.Run("unlabeled in labeled", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+           fun test(): Int {
+               var count = 0
+               outer: for x in [1, 2] {
+                   inner: while true {
+                       count = count + 1
+                       break
+                   }
+                   continue
+               }
+               return count
+           }
+        `)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			interpreter.NewIntValueFromInt64(2),
+			value,
+		)
+	})
+}
+
+func TestInterpretRepeatWhileStatement(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("body evaluated once", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+           fun test(): Int {
+               var x = 0
+               repeat {
+                   x = x + 1
+               } while false
+               return x
+           }
+        `)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			interpreter.NewIntValueFromInt64(1),
+			value,
+		)
+	})
+
+	t.Run("loop", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+           fun test(): Int {
+               var x = 0
+               var sum = 0
+               repeat {
+                   x = x + 1
+                   if x == 2 {
+                       continue
+                   }
+                   if x == 5 {
+                       break
+                   }
+                   sum = sum + x
+               } while x < 10
+               return sum
+           }
+        `)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		// 1 + 3 + 4
+		assert.Equal(t,
+			interpreter.NewIntValueFromInt64(8),
+			value,
+		)
+	})
+
+	t.Run("return", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+           fun test(): Int {
+               var x = 0
+               repeat {
+                   x = x + 1
+                   if x == 3 {
+                       return x * 10
+                   }
+               } while true
+               return x
+           }
+        `)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			interpreter.NewIntValueFromInt64(30),
+			value,
+		)
+	})
+
+	t.Run("repeat as identifier", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+           var count = 0
+
+           fun repeat(_ n: Int) {
+               count = count + n
+           }
+
+           fun test(): Int {
+               repeat(3)
+               repeat(4)
+               return count
+           }
+        `)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			interpreter.NewIntValueFromInt64(7),
+			value,
+		)
+	})
+}
@@ -397,7 +397,7 @@ func (v Int128) Big() *big.Int {
 }
 
 func (v Int128) ToBigEndianBytes() []byte {
-	return interpreter.SignedBigIntToBigEndianBytes(v.Value)
+	return interpreter.SignedBigIntToSizedBigEndianBytes(v.Value, 16)
 }
 
 func (v Int128) String() string {
@@ -438,7 +438,7 @@ func (v Int256) Big() *big.Int {
 }
 
 func (v Int256) ToBigEndianBytes() []byte {
-	return interpreter.SignedBigIntToBigEndianBytes(v.Value)
+	return interpreter.SignedBigIntToSizedBigEndianBytes(v.Value, 32)
 }
 
 func (v Int256) String() string {
@@ -634,7 +634,7 @@ func (v UInt128) Big() *big.Int {
 }
 
 func (v UInt128) ToBigEndianBytes() []byte {
-	return interpreter.UnsignedBigIntToBigEndianBytes(v.Value)
+	return interpreter.UnsignedBigIntToSizedBigEndianBytes(v.Value, 16)
 }
 
 func (v UInt128) String() string {
@@ -678,7 +678,7 @@ func (v UInt256) Big() *big.Int {
 }
 
 func (v UInt256) ToBigEndianBytes() []byte {
-	return interpreter.UnsignedBigIntToBigEndianBytes(v.Value)
+	return interpreter.UnsignedBigIntToSizedBigEndianBytes(v.Value, 32)
 }
 
 func (v UInt256) String() string {
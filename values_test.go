@@ -323,24 +323,24 @@ func TestToBigEndianBytes(t *testing.T) {
 			NewInt64(-9223372036854775808): {128, 0, 0, 0, 0, 0, 0, 0},
 		},
 		"Int128": {
-			NewInt128(0):                  {0},
-			NewInt128(42):                 {42},
-			NewInt128(127):                {127},
-			NewInt128(128):                {0, 128},
-			NewInt128(200):                {0, 200},
-			NewInt128(-1):                 {255},
-			NewInt128(-200):               {255, 56},
-			NewInt128(-10000000000000000): {220, 121, 13, 144, 63, 0, 0},
+			NewInt128(0):                  {0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0},
+			NewInt128(42):                 {0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 42},
+			NewInt128(127):                {0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 127},
+			NewInt128(128):                {0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 128},
+			NewInt128(200):                {0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 200},
+			NewInt128(-1):                 {255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255},
+			NewInt128(-200):               {255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 56},
+			NewInt128(-10000000000000000): {255, 255, 255, 255, 255, 255, 255, 255, 255, 220, 121, 13, 144, 63, 0, 0},
 		},
 		"Int256": {
-			NewInt256(0):                  {0},
-			NewInt256(42):                 {42},
-			NewInt256(127):                {127},
-			NewInt256(128):                {0, 128},
-			NewInt256(200):                {0, 200},
-			NewInt256(-1):                 {255},
-			NewInt256(-200):               {255, 56},
-			NewInt256(-10000000000000000): {220, 121, 13, 144, 63, 0, 0},
+			NewInt256(0):                  {0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0},
+			NewInt256(42):                 {0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 42},
+			NewInt256(127):                {0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 127},
+			NewInt256(128):                {0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 128},
+			NewInt256(200):                {0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 200},
+			NewInt256(-1):                 {255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255},
+			NewInt256(-200):               {255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 56},
+			NewInt256(-10000000000000000): {255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 220, 121, 13, 144, 63, 0, 0},
 		},
 		// UInt*
 		"UInt": {
@@ -379,18 +379,18 @@ func TestToBigEndianBytes(t *testing.T) {
 			NewUInt64(18446744073709551615): {255, 255, 255, 255, 255, 255, 255, 255},
 		},
 		"UInt128": {
-			NewUInt128(0):   {0},
-			NewUInt128(42):  {42},
-			NewUInt128(127): {127},
-			NewUInt128(128): {128},
-			NewUInt128(200): {200},
+			NewUInt128(0):   {0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0},
+			NewUInt128(42):  {0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 42},
+			NewUInt128(127): {0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 127},
+			NewUInt128(128): {0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 128},
+			NewUInt128(200): {0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 200},
 		},
 		"UInt256": {
-			NewUInt256(0):   {0},
-			NewUInt256(42):  {42},
-			NewUInt256(127): {127},
-			NewUInt256(128): {128},
-			NewUInt256(200): {200},
+			NewUInt256(0):   {0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0},
+			NewUInt256(42):  {0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 42},
+			NewUInt256(127): {0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 127},
+			NewUInt256(128): {0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 128},
+			NewUInt256(200): {0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 200},
 		},
 		// Word*
 		"Word8": {